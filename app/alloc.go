@@ -0,0 +1,237 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ExportGenesisAlloc returns the EVM state of every Ethereum account as a
+// go-ethereum compatible genesis alloc. Balances are expressed in the EVM denom.
+func (app *EthermintApp) ExportGenesisAlloc(ctx sdk.Context) (core.GenesisAlloc, error) {
+	alloc := make(core.GenesisAlloc)
+
+	var err error
+	app.AccountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		ethAccount, ok := account.(ethermint.EthAccountI)
+		if !ok {
+			// ignore non EthAccounts
+			return false
+		}
+
+		address := ethAccount.EthAddress()
+		acct := app.EvmKeeper.GetAccount(ctx, address)
+		if acct == nil {
+			err = fmt.Errorf("account not found for address %s", address)
+			return true
+		}
+
+		genAccount := core.GenesisAccount{
+			Balance: acct.Balance,
+			Nonce:   acct.Nonce,
+		}
+
+		if acct.IsContract() {
+			genAccount.Code = app.EvmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		}
+
+		app.EvmKeeper.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			if genAccount.Storage == nil {
+				genAccount.Storage = make(map[common.Hash]common.Hash)
+			}
+			genAccount.Storage[key] = value
+			return true
+		})
+
+		alloc[address] = genAccount
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return alloc, nil
+}
+
+// ImportGenesisAlloc adds the accounts of a go-ethereum genesis alloc to the
+// auth, bank and evm genesis states of appState. Balances are credited in the
+// EVM denom defined by the evm genesis params: if it has less than 18 decimals,
+// the integer part is credited as bank coins and the remainder as a fractional
+// balance, backed by the EVM module account reserve. Accounts that already exist
+// in the auth genesis state are rejected.
+func ImportGenesisAlloc(cdc codec.Codec, appState map[string]json.RawMessage, alloc core.GenesisAlloc) error {
+	var evmGenState evmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+	}
+
+	denom := evmGenState.Params.EvmDenom
+	if denom == "" {
+		return errors.New("evm denom not set in evm genesis params")
+	}
+
+	scale := evmGenState.Params.DenomScale()
+	fractionalTotal := new(big.Int)
+	for _, fb := range evmGenState.FractionalBalances {
+		fractionalTotal.Add(fractionalTotal, fb.Amount.BigInt())
+	}
+	reserve := evmkeeper.FractionalReserve(fractionalTotal, scale)
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	// iterate in a deterministic order so the resulting genesis is reproducible
+	addresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+
+	for _, address := range addresses {
+		genAccount := alloc[address]
+		addr := sdk.AccAddress(address.Bytes())
+
+		if accs.Contains(addr) {
+			return fmt.Errorf("cannot add account at existing address %s", address)
+		}
+
+		codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+		if len(genAccount.Code) != 0 {
+			codeHash = crypto.Keccak256Hash(genAccount.Code)
+		}
+
+		account := &ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, genAccount.Nonce),
+			CodeHash:    codeHash.Hex(),
+		}
+		if err := account.Validate(); err != nil {
+			return fmt.Errorf("failed to validate genesis account %s: %w", address, err)
+		}
+		accs = append(accs, account)
+
+		balance := genAccount.Balance
+		if balance == nil {
+			balance = new(big.Int)
+		}
+		if balance.Sign() < 0 {
+			return fmt.Errorf("negative balance for account %s", address)
+		}
+		integer, fractional := new(big.Int).QuoRem(balance, scale, new(big.Int))
+		if integer.Sign() > 0 {
+			addGenesisBalance(bankGenState, addr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(integer))))
+		}
+		if fractional.Sign() > 0 {
+			evmGenState.FractionalBalances = append(evmGenState.FractionalBalances, evmtypes.FractionalBalance{
+				Address: address.Hex(),
+				Amount:  sdk.NewIntFromBigInt(fractional),
+			})
+			fractionalTotal.Add(fractionalTotal, fractional)
+		}
+
+		if len(genAccount.Code) == 0 && len(genAccount.Storage) == 0 {
+			continue
+		}
+
+		storage := make(evmtypes.Storage, 0, len(genAccount.Storage))
+		for key, value := range genAccount.Storage {
+			storage = append(storage, evmtypes.NewState(key, value))
+		}
+		sort.Slice(storage, func(i, j int) bool {
+			return storage[i].Key < storage[j].Key
+		})
+
+		evmGenState.Accounts = append(evmGenState.Accounts, evmtypes.GenesisAccount{
+			Address: address.Hex(),
+			Code:    common.Bytes2Hex(genAccount.Code),
+			Storage: storage,
+		})
+	}
+
+	// mint the reserve backing the imported fractional balances to the EVM module account
+	if added := new(big.Int).Sub(evmkeeper.FractionalReserve(fractionalTotal, scale), reserve); added.Sign() > 0 {
+		moduleAddr := authtypes.NewModuleAddress(evmtypes.ModuleName)
+		addGenesisBalance(bankGenState, moduleAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(added))))
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	if err := evmGenState.Validate(); err != nil {
+		return fmt.Errorf("invalid evm genesis state: %w", err)
+	}
+	evmGenStateBz, err := cdc.MarshalJSON(&evmGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+	}
+	appState[evmtypes.ModuleName] = evmGenStateBz
+
+	return nil
+}
+
+// addGenesisBalance credits coins to the bank genesis balance of addr and to the
+// total supply.
+func addGenesisBalance(bankGenState *banktypes.GenesisState, addr sdk.AccAddress, coins sdk.Coins) {
+	bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	for i, balance := range bankGenState.Balances {
+		if balance.Address == addr.String() {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			return
+		}
+	}
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/tests"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestGenesisAllocRoundTrip(t *testing.T) {
+	eoa := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+
+	alloc := core.GenesisAlloc{
+		eoa: {
+			Balance: big.NewInt(1000),
+			Nonce:   3,
+		},
+		contract: {
+			Balance: big.NewInt(0),
+			Nonce:   1,
+			Code:    common.Hex2Bytes("6080604052"),
+			Storage: map[common.Hash]common.Hash{
+				common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(42)),
+			},
+		},
+	}
+
+	app := Setup(false, func(app *EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		require.NoError(t, ImportGenesisAlloc(app.AppCodec(), genesis, alloc))
+		return genesis
+	})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+	exported, err := app.ExportGenesisAlloc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, alloc, exported)
+
+	// importing an alloc with an existing account must fail
+	genesis := NewTestGenesisState(app.AppCodec())
+	require.NoError(t, ImportGenesisAlloc(app.AppCodec(), genesis, alloc))
+	require.Error(t, ImportGenesisAlloc(app.AppCodec(), genesis, alloc))
+}
+
+func TestGenesisAllocRoundTripScaledDenom(t *testing.T) {
+	scale := big.NewInt(1e10)
+	whole := tests.GenerateAddress()
+	mixed := tests.GenerateAddress()
+	fractional := tests.GenerateAddress()
+
+	alloc := core.GenesisAlloc{
+		whole:      {Balance: new(big.Int).Mul(big.NewInt(2), scale)},
+		mixed:      {Balance: new(big.Int).Add(new(big.Int).Mul(big.NewInt(3), scale), big.NewInt(5))},
+		fractional: {Balance: big.NewInt(7)},
+	}
+
+	var denom string
+	app := Setup(false, func(app *EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		var evmGenState evmtypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesis[evmtypes.ModuleName], &evmGenState)
+		evmGenState.Params.EvmDenomDecimals = 8
+		denom = evmGenState.Params.EvmDenom
+		genesis[evmtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&evmGenState)
+
+		require.NoError(t, ImportGenesisAlloc(app.AppCodec(), genesis, alloc))
+		return genesis
+	})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+	exported, err := app.ExportGenesisAlloc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, alloc, exported)
+
+	// the integer part of the balances is credited as bank coins
+	require.Equal(t, int64(2), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(whole.Bytes()), denom).Amount.Int64())
+	require.Equal(t, int64(3), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(mixed.Bytes()), denom).Amount.Int64())
+	require.True(t, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(fractional.Bytes()), denom).IsZero())
+
+	// the remainders are backed by the EVM module account reserve
+	require.Equal(t, big.NewInt(12), app.EvmKeeper.GetFractionalBalanceTotal(ctx))
+	moduleAddr := authtypes.NewModuleAddress(evmtypes.ModuleName)
+	require.Equal(t, int64(1), app.BankKeeper.GetBalance(ctx, moduleAddr, denom).Amount.Int64())
+	_, broken := evmkeeper.FractionalBalancesInvariant(app.EvmKeeper)(ctx)
+	require.False(t, broken)
+}
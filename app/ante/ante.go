@@ -30,10 +30,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
 )
 
 const (
 	secp256k1VerifyCost uint64 = 21000
+	schnorrVerifyCost   uint64 = 21000
 )
 
 // NewAnteHandler returns an ante handler responsible for attempting to route an
@@ -122,6 +124,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(secp256k1VerifyCost, "ante verify: eth_secp256k1")
 		return nil
 
+	case *schnorr.PubKey:
+		meter.ConsumeGas(schnorrVerifyCost, "ante verify: schnorr")
+		return nil
+
 	case multisig.PubKey:
 		// Multisig keys
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
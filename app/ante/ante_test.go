@@ -29,6 +29,7 @@ import (
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
@@ -622,6 +623,35 @@ func (suite AnteTestSuite) TestAnteHandler() {
 				return txBuilder.GetTx()
 			}, false, false, true,
 		},
+		{
+			"passes - Single-signer schnorr",
+			func() sdk.Tx {
+				schnorrKey, err := schnorr.GenerateKey()
+				suite.Require().NoError(err)
+
+				msg := banktypes.NewMsgSend(
+					sdk.AccAddress(schnorrKey.PubKey().Address()),
+					addr[:],
+					sdk.NewCoins(
+						sdk.NewCoin(
+							"photon",
+							sdk.NewInt(1),
+						),
+					),
+				)
+
+				txBuilder := suite.CreateTestSingleSignedTx(
+					schnorrKey,
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+					msg,
+					"ethermint_9000-1",
+					2000000,
+					"Standard",
+				)
+
+				return txBuilder.GetTx()
+			}, false, false, true,
+		},
 		{
 			"passes - EIP-712 multi-key",
 			func() sdk.Tx {
@@ -1353,6 +1383,8 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 
 	p := authtypes.DefaultParams()
 	skR1, _ := secp256r1.GenPrivKey()
+	schnorrKey, err := schnorr.GenerateKey()
+	suite.Require().NoError(err)
 	pkSet1, sigSet1, err := generatePubKeysAndSignatures(5, msg, false)
 	suite.Require().NoError(err)
 
@@ -1382,6 +1414,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeyEthSecp256k1", args{sdk.NewInfiniteGasMeter(), nil, pkSet1[0], params}, 21_000, false},
+		{"PubKeySchnorr", args{sdk.NewInfiniteGasMeter(), nil, schnorrKey.PubKey(), params}, 21_000, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -54,6 +54,7 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper)
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+// - contract creation initcode exceeds the max initcode size
 func (avd EthAccountVerificationDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
@@ -64,6 +65,8 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	evmParams := avd.evmKeeper.GetParams(ctx)
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -97,6 +100,12 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
+
+		if txData.GetTo() == nil {
+			if err := evmParams.ValidateInitcode(txData.GetData()); err != nil {
+				return ctx, errorsmod.Wrap(err, "failed to check contract creation initcode")
+			}
+		}
 	}
 	return next(ctx, tx, simulate)
 }
@@ -184,9 +193,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			gasWanted += txData.GetGas()
 		}
 
-		evmDenom := evmParams.GetEvmDenom()
-
-		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx())
+		fees, err := keeper.VerifyFee(txData, evmParams, baseFee, homestead, istanbul, ctx.IsCheckTx())
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
@@ -0,0 +1,59 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// EthCallNonceDecorator reserves the nonce of the senders of the MsgEthereumCall contract creations
+// before the IncrementSequenceDecorator increments the sequence of the tx signers, so the contracts
+// are created at the address derived from the nonce the tx was signed with, like an ethereum
+// transaction, instead of the incremented one.
+type EthCallNonceDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthCallNonceDecorator creates a new EthCallNonceDecorator
+func NewEthCallNonceDecorator(ek EVMKeeper) EthCallNonceDecorator {
+	return EthCallNonceDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle stores the current nonce of the sender of every MsgEthereumCall contract creation of the
+// tx in the EVM transient store. The sender signs its own message, so its sequence is incremented by
+// the next decorators. The messages nested in other ones, e.g. authz MsgExec, are signed by another
+// account and use the current nonce of the sender.
+func (ecnd EthCallNonceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgCall, ok := msg.(*evmtypes.MsgEthereumCall)
+		if !ok || msgCall.GetTo() != nil {
+			continue
+		}
+
+		from := msgCall.GetFrom()
+		var nonce uint64
+		if acct := ecnd.evmKeeper.GetAccount(ctx, from); acct != nil {
+			nonce = acct.Nonce
+		}
+		ecnd.evmKeeper.SetReservedNonceTransient(ctx, from, nonce)
+	}
+
+	return next(ctx, tx, simulate)
+}
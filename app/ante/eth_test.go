@@ -26,6 +26,10 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
 	tx.From = addr.Hex()
 
+	initcode := make([]byte, evmtypes.DefaultMaxInitcodeSize+1)
+	oversizeTx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, initcode, nil)
+	oversizeTx.From = addr.Hex()
+
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
@@ -73,6 +77,15 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			true,
 		},
+		{
+			"initcode exceeds max initcode size",
+			oversizeTx,
+			func() {
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+			},
+			true,
+			false,
+		},
 		{
 			"success existing account",
 			tx,
@@ -49,6 +49,7 @@ type EVMKeeper interface {
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetReservedNonceTransient(ctx sdk.Context, addr common.Address, nonce uint64)
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
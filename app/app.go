@@ -122,9 +122,21 @@ import (
 	"github.com/evmos/ethermint/ethereum/eip712"
 	srvflags "github.com/evmos/ethermint/server/flags"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/batch"
+	batchkeeper "github.com/evmos/ethermint/x/batch/keeper"
+	batchtypes "github.com/evmos/ethermint/x/batch/types"
+	"github.com/evmos/ethermint/x/btcbridge"
+	btcbridgekeeper "github.com/evmos/ethermint/x/btcbridge/keeper"
+	bridgeprecompile "github.com/evmos/ethermint/x/btcbridge/precompile"
+	btcbridgetypes "github.com/evmos/ethermint/x/btcbridge/types"
+	"github.com/evmos/ethermint/x/btclightclient"
+	btclightclientkeeper "github.com/evmos/ethermint/x/btclightclient/keeper"
+	btcprecompile "github.com/evmos/ethermint/x/btclightclient/precompile"
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
 	"github.com/evmos/ethermint/x/evm"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evmvm "github.com/evmos/ethermint/x/evm/vm"
 	"github.com/evmos/ethermint/x/evm/vm/geth"
 	"github.com/evmos/ethermint/x/feemarket"
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
@@ -178,6 +190,9 @@ var (
 		// Ethermint modules
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		btclightclient.AppModuleBasic{},
+		btcbridge.AppModuleBasic{},
+		batch.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -190,6 +205,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		btcbridgetypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -242,6 +258,10 @@ type EthermintApp struct {
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
 
+	BTCLightClientKeeper btclightclientkeeper.Keeper
+	BTCBridgeKeeper      btcbridgekeeper.Keeper
+	BatchKeeper          batchkeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -290,7 +310,8 @@ func NewEthermintApp(
 		// ibc keys
 		ibchost.StoreKey, ibctransfertypes.StoreKey,
 		// ethermint keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, btclightclienttypes.StoreKey,
+		btcbridgetypes.StoreKey, batchtypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -416,6 +437,8 @@ func NewEthermintApp(
 		keys[feemarkettypes.StoreKey], tkeys[feemarkettypes.TransientKey], feeMarketSs,
 	)
 
+	app.BTCLightClientKeeper = btclightclientkeeper.NewKeeper(appCodec, keys[btclightclienttypes.StoreKey])
+
 	// Set authority to x/gov module account to only expect the module account to update params
 	evmSs := app.GetSubspace(evmtypes.ModuleName)
 	app.EvmKeeper = evmkeeper.NewKeeper(
@@ -424,6 +447,25 @@ func NewEthermintApp(
 		nil, geth.NewEVM, tracer, evmSs,
 	)
 
+	app.BTCBridgeKeeper = btcbridgekeeper.NewKeeper(
+		appCodec, keys[btcbridgetypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.EvmKeeper, app.BTCLightClientKeeper,
+	)
+
+	// the bridge precompile depends on the bridge keeper, which depends on the EVM keeper
+	app.EvmKeeper.SetPrecompiles(evmvm.PrecompiledContracts{
+		btcprecompile.Address:    btcprecompile.NewPrecompile(app.BTCLightClientKeeper),
+		bridgeprecompile.Address: bridgeprecompile.NewPrecompile(app.BTCBridgeKeeper),
+	})
+
+	app.BatchKeeper = batchkeeper.NewKeeper(
+		appCodec, keys[batchtypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BTCBridgeKeeper,
+	)
+
+	// the batches commit to the hashes of the executed EVM transactions
+	app.EvmKeeper.SetHooks(app.BatchKeeper.Hooks())
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
@@ -509,6 +551,9 @@ func NewEthermintApp(
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		btclightclient.NewAppModule(app.BTCLightClientKeeper),
+		btcbridge.NewAppModule(app.BTCBridgeKeeper),
+		batch.NewAppModule(app.BatchKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -539,6 +584,9 @@ func NewEthermintApp(
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
+		btclightclienttypes.ModuleName,
+		btcbridgetypes.ModuleName,
+		batchtypes.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
@@ -564,6 +612,9 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		btclightclienttypes.ModuleName,
+		btcbridgetypes.ModuleName,
+		batchtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -596,6 +647,10 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		btclightclienttypes.ModuleName,
+		// the bridge deposit address is validated against the light client network
+		btcbridgetypes.ModuleName,
+		batchtypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
 	)
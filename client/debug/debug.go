@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/bytes"
 
@@ -30,8 +31,12 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/ethermint/crypto/btc"
 )
 
+const flagBTCNetwork = "btc-network"
+
 // Cmd creates a main CLI command
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -55,7 +60,7 @@ func getPubKeyFromString(ctx client.Context, pkstr string) (cryptotypes.PubKey,
 }
 
 func PubkeyCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "pubkey [pubkey]",
 		Short: "Decode a pubkey from proto JSON",
 		Long:  "Decode a pubkey from proto JSON and display it's address",
@@ -75,24 +80,54 @@ func PubkeyCmd() *cobra.Command {
 			cmd.Printf("Address (EIP-55): %s\n", common.BytesToAddress(addr))
 			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(addr))
 			cmd.Println("PubKey Hex:", hex.EncodeToString(pk.Bytes()))
+
+			btcNetwork, _ := cmd.Flags().GetString(flagBTCNetwork)
+			params, err := btc.NetworkParams(btcNetwork)
+			if err != nil {
+				return err
+			}
+			for _, addressType := range []string{btc.AddressTypeP2WPKH, btc.AddressTypeP2TR} {
+				// not all the key types support both address types
+				if btcAddr, err := btc.NewAddress(pk, addressType, params); err == nil {
+					cmd.Printf("Bitcoin %s: %s\n", strings.ToUpper(addressType), btcAddr.EncodeAddress())
+				}
+			}
 			return nil
 		},
 	}
+
+	cmd.Flags().String(flagBTCNetwork, "mainnet", "Bitcoin network of the addresses of the key (mainnet|testnet|regtest)")
+	return cmd
 }
 
 func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "addr [address]",
 		Short: "Convert an address between hex and bech32",
-		Long:  "Convert an address between hex encoding and bech32.",
+		Long: `Convert an address between hex encoding and bech32.
+Bitcoin addresses are decoded into their network, type and script.`,
 		Example: fmt.Sprintf(
 			`$ %s debug addr ethm10jmp6sgh4cc6zt3e8gw05wavvejgr5pw2unfju
-$ %s debug addr 0xA588C66983a81e800Db4dF74564F09f91c026351`, version.AppName, version.AppName),
+$ %s debug addr 0xA588C66983a81e800Db4dF74564F09f91c026351
+$ %s debug addr bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu`, version.AppName, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			addrString := args[0]
 			cfg := sdk.GetConfig()
 
+			if btcAddr, params, err := btc.DecodeAddress(addrString); err == nil {
+				script, err := txscript.PayToAddrScript(btcAddr)
+				if err != nil {
+					return err
+				}
+
+				cmd.Println("Bitcoin network:", params.Name)
+				cmd.Println("Bitcoin address type:", btc.AddressTypeOf(btcAddr))
+				cmd.Printf("Bitcoin address (hex): %s\n", bytes.HexBytes(btcAddr.ScriptAddress()))
+				cmd.Printf("Bitcoin script (hex): %s\n", bytes.HexBytes(script))
+				return nil
+			}
+
 			var addr []byte
 			switch {
 			case common.IsHexAddress(addrString):
@@ -72,13 +72,24 @@ The pass backend requires GnuPG: https://gnupg.org/
 
 	addCmd.RunE = runAddCmd
 
+	// support showing the Bitcoin address of the keys
+	showCmd := keys.ShowKeysCmd()
+	clientkeys.AddBTCAddressFlags(showCmd)
+	showRunE := showCmd.RunE
+	showCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !clientkeys.IsBTCAddressSet(cmd) {
+			return showRunE(cmd, args)
+		}
+		return runShowBTCCmd(cmd, args)
+	}
+
 	cmd.AddCommand(
 		keys.MnemonicKeyCommand(),
 		addCmd,
 		keys.ExportKeyCommand(),
 		keys.ImportKeyCommand(),
 		keys.ListKeysCmd(),
-		keys.ShowKeysCmd(),
+		showCmd,
 		keys.DeleteKeyCommand(),
 		keys.RenameKeyCommand(),
 		keys.ParseKeyStringCommand(),
@@ -104,3 +115,11 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	buf := bufio.NewReader(clientCtx.Input)
 	return clientkeys.RunAddCmd(clientCtx, cmd, args, buf)
 }
+
+func runShowBTCCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	return clientkeys.RunShowBTCCmd(clientCtx, cmd, args)
+}
@@ -23,6 +23,7 @@ import (
 	"sort"
 
 	etherminthd "github.com/evmos/ethermint/crypto/hd"
+	ethermint "github.com/evmos/ethermint/types"
 
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
@@ -174,9 +175,16 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	index, _ := cmd.Flags().GetUint32(flagIndex)
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
 
-	if len(hdPath) == 0 {
+	switch {
+	case len(hdPath) == 0 && algo.Name() == etherminthd.SchnorrType:
+		// schnorr keys are derived on BIP-86 paths, using the Bitcoin coin type by default
+		if !cmd.Flags().Changed(flagCoinType) {
+			coinType = ethermint.BIP86CoinType
+		}
+		hdPath = etherminthd.CreateBIP86HDPath(coinType, account, index).String()
+	case len(hdPath) == 0:
 		hdPath = hd.CreateHDPath(coinType, account, index).String()
-	} else if useLedger {
+	case useLedger:
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
 
@@ -0,0 +1,145 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/crypto/btc"
+)
+
+const (
+	flagBTCNetwork = "btc-network"
+	flagBTCType    = "btc-type"
+)
+
+// BTCKeyOutput defines the output of a keyring key along with the Bitcoin address of its public key.
+type BTCKeyOutput struct {
+	cryptokeyring.KeyOutput
+	BTCAddress string `json:"btc_address" yaml:"btc_address"`
+}
+
+// AddBTCAddressFlags adds the flags selecting the Bitcoin address of a key to the command.
+func AddBTCAddressFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagBTCNetwork, "mainnet", "Show the Bitcoin address of the key on the given network (mainnet|testnet|regtest)")
+	cmd.Flags().String(flagBTCType, "", "Bitcoin address type of the key (p2wpkh|p2tr), defaults to p2tr for schnorr keys and p2wpkh otherwise")
+}
+
+// IsBTCAddressSet returns true if any of the Bitcoin address flags is set.
+func IsBTCAddressSet(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed(flagBTCNetwork) || cmd.Flags().Changed(flagBTCType)
+}
+
+/*
+RunShowBTCCmd
+input
+  - key name, bech32 or hex address
+  - bitcoin network and address type
+
+output
+  - key information along with the bitcoin address of its public key
+*/
+func RunShowBTCCmd(ctx client.Context, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("bitcoin addresses can only be shown for a single key")
+	}
+
+	k, err := fetchKey(ctx.Keyring, args[0])
+	if err != nil {
+		return fmt.Errorf("%s is not a valid name or address: %v", args[0], err)
+	}
+
+	btcNetwork, _ := cmd.Flags().GetString(flagBTCNetwork)
+	btcType, _ := cmd.Flags().GetString(flagBTCType)
+
+	params, err := btc.NetworkParams(btcNetwork)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	btcAddr, err := btc.NewAddress(pubKey, btcType, params)
+	if err != nil {
+		return err
+	}
+
+	if isShowAddr, _ := cmd.Flags().GetBool(keys.FlagAddress); isShowAddr {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), btcAddr.EncodeAddress())
+		return err
+	}
+
+	ko, err := cryptokeyring.MkAccKeyOutput(k)
+	if err != nil {
+		return err
+	}
+
+	outputFormat := ctx.OutputFormat
+	if flag := cmd.Flag(cli.OutputFlag); flag != nil && flag.Changed {
+		outputFormat = flag.Value.String()
+	}
+
+	out := BTCKeyOutput{
+		KeyOutput:  ko,
+		BTCAddress: btcAddr.EncodeAddress(),
+	}
+
+	var bz []byte
+	switch outputFormat {
+	case OutputFormatJSON:
+		bz, err = json.Marshal(out)
+	default:
+		bz, err = yaml.Marshal(out)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
+
+// fetchKey returns the keyring record of a key name, or of a bech32 or hex account address.
+func fetchKey(kb cryptokeyring.Keyring, keyref string) (*cryptokeyring.Record, error) {
+	k, err := kb.Key(keyref)
+	if err == nil {
+		return k, nil
+	}
+
+	var addr sdk.AccAddress
+	if common.IsHexAddress(keyref) {
+		addr = common.HexToAddress(keyref).Bytes()
+	} else if addr, err = sdk.AccAddressFromBech32(keyref); err != nil {
+		return nil, err
+	}
+
+	return kb.KeyByAddress(addr)
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/spf13/cobra"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/evmos/ethermint/app"
+)
+
+const (
+	flagAllocHeight = "height"
+	flagAllocOutput = "output"
+)
+
+// EVMCmd returns the evm cobra Command, grouping the commands that operate on
+// the local EVM state.
+func EVMCmd(defaultNodeHome string, encCfg params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm",
+		Short: "Commands to inspect and seed the local EVM state",
+	}
+
+	cmd.AddCommand(
+		ExportAllocCmd(defaultNodeHome, encCfg),
+		ImportAllocCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ExportAllocCmd returns export-alloc cobra Command.
+func ExportAllocCmd(defaultNodeHome string, encCfg params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-alloc",
+		Short: "Export the EVM accounts as a geth genesis alloc JSON",
+		Long: `Export the balance, nonce, code and storage of every Ethereum account in the
+local application state as a go-ethereum compatible genesis alloc. The node must not be
+running. Balances are expressed in the EVM denom. By default the latest committed height
+is exported; use --height to export an earlier one.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			height, err := cmd.Flags().GetInt64(flagAllocHeight)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagAllocOutput)
+			if err != nil {
+				return err
+			}
+
+			dataDir := filepath.Join(config.RootDir, "data")
+			db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ethermintApp := app.NewEthermintApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, config.RootDir, uint(1), encCfg, serverCtx.Viper,
+			)
+
+			if height != -1 {
+				err = ethermintApp.LoadHeight(height)
+			} else {
+				err = ethermintApp.LoadLatestVersion()
+			}
+			if err != nil {
+				return err
+			}
+
+			ctx := ethermintApp.NewContext(true, tmproto.Header{Height: ethermintApp.LastBlockHeight()})
+			alloc, err := ethermintApp.ExportGenesisAlloc(ctx)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(alloc, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal alloc: %w", err)
+			}
+
+			if output == "" {
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			return os.WriteFile(output, bz, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagAllocHeight, -1, "Export the state at this height (-1 for the latest height)")
+	cmd.Flags().String(flagAllocOutput, "", "Write the alloc to this file instead of stdout")
+
+	return cmd
+}
+
+// ImportAllocCmd returns import-alloc cobra Command.
+func ImportAllocCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-alloc ALLOC_FILE",
+		Short: "Add the accounts of a geth genesis alloc JSON to genesis.json",
+		Long: `Add the accounts of a go-ethereum compatible genesis alloc to genesis.json. Every
+entry becomes an EthAccount with the given nonce, a balance in the EVM denom, and an
+EVM genesis account holding its code and storage. The alloc balances are in wei: if the
+EVM denom has less than 18 decimals, the remainder below one EVM denom unit is added as
+a fractional balance. The alloc must not contain accounts that already exist in
+genesis.json.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+
+			var alloc core.GenesisAlloc
+			if err := json.Unmarshal(bz, &alloc); err != nil {
+				return fmt.Errorf("failed to unmarshal alloc: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			if err := app.ImportGenesisAlloc(clientCtx.Codec, appState, alloc); err != nil {
+				return err
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
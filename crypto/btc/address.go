@@ -0,0 +1,151 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package btc
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
+)
+
+const (
+	// AddressTypeP2WPKH defines the native SegWit v0 pay-to-witness-pubkey-hash addresses (BIP-84)
+	AddressTypeP2WPKH = "p2wpkh"
+	// AddressTypeP2TR defines the SegWit v1 pay-to-taproot addresses with no script path (BIP-86)
+	AddressTypeP2TR = "p2tr"
+	// AddressTypeP2WSH defines the native SegWit v0 pay-to-witness-script-hash addresses
+	AddressTypeP2WSH = "p2wsh"
+	// AddressTypeP2PKH defines the legacy pay-to-pubkey-hash addresses
+	AddressTypeP2PKH = "p2pkh"
+	// AddressTypeP2SH defines the legacy pay-to-script-hash addresses
+	AddressTypeP2SH = "p2sh"
+
+	// NetworkTestnet is the alias of the Bitcoin test network version 3
+	NetworkTestnet = "testnet"
+)
+
+// NetworkParams returns the btcd chain parameters of a Bitcoin network by name, i.e. mainnet,
+// testnet, regtest or signet.
+func NetworkParams(network string) (*chaincfg.Params, error) {
+	if network == NetworkTestnet {
+		return &chaincfg.TestNet3Params, nil
+	}
+
+	for _, params := range networks {
+		if params.Name == network {
+			return params, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid bitcoin network %q, expected mainnet, testnet, regtest or signet", network)
+}
+
+// networks are the Bitcoin networks supported for the addresses, by decoding priority.
+var networks = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNet3Params,
+	&chaincfg.RegressionNetParams,
+	&chaincfg.SigNetParams,
+}
+
+// DefaultAddressType returns the Bitcoin address type used for a public key: P2TR for the
+// schnorr keys and P2WPKH for the ECDSA keys.
+func DefaultAddressType(pubKey cryptotypes.PubKey) string {
+	if _, ok := pubKey.(*schnorr.PubKey); ok {
+		return AddressTypeP2TR
+	}
+	return AddressTypeP2WPKH
+}
+
+// NewAddress returns the Bitcoin address of the given type and network for a secp256k1 public
+// key. The P2TR address uses the key as the BIP-86 internal key. P2WPKH addresses aren't
+// supported for the x-only schnorr keys, as the parity of their public key is unknown. An empty
+// address type selects the default address type of the key.
+func NewAddress(pubKey cryptotypes.PubKey, addressType string, params *chaincfg.Params) (btcutil.Address, error) {
+	if addressType == "" {
+		addressType = DefaultAddressType(pubKey)
+	}
+
+	var (
+		key *btcec.PublicKey
+		err error
+	)
+
+	switch pubKey := pubKey.(type) {
+	case *ethsecp256k1.PubKey, *secp256k1.PubKey:
+		key, err = btcec.ParsePubKey(pubKey.Bytes())
+	case *schnorr.PubKey:
+		if addressType == AddressTypeP2WPKH {
+			return nil, fmt.Errorf("%s addresses are not supported by %s keys", addressType, pubKey.Type())
+		}
+		key, err = btcschnorr.ParsePubKey(pubKey.Bytes())
+	default:
+		return nil, fmt.Errorf("bitcoin addresses are not supported by %T keys", pubKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch addressType {
+	case AddressTypeP2WPKH:
+		return btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.SerializeCompressed()), params)
+	case AddressTypeP2TR:
+		outputKey := txscript.ComputeTaprootKeyNoScript(key)
+		return btcutil.NewAddressTaproot(btcschnorr.SerializePubKey(outputKey), params)
+	default:
+		return nil, fmt.Errorf("invalid bitcoin address type %q, expected %s or %s", addressType, AddressTypeP2WPKH, AddressTypeP2TR)
+	}
+}
+
+// DecodeAddress decodes a Bitcoin address of any of the supported networks. As the test network
+// and the signet share the same address encoding, their addresses are returned as test network
+// addresses.
+func DecodeAddress(address string) (btcutil.Address, *chaincfg.Params, error) {
+	for _, params := range networks {
+		addr, err := btcutil.DecodeAddress(address, params)
+		if err == nil && addr.IsForNet(params) {
+			return addr, params, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("invalid bitcoin address %q", address)
+}
+
+// AddressTypeOf returns the type of a Bitcoin address.
+func AddressTypeOf(address btcutil.Address) string {
+	switch address.(type) {
+	case *btcutil.AddressWitnessPubKeyHash:
+		return AddressTypeP2WPKH
+	case *btcutil.AddressTaproot:
+		return AddressTypeP2TR
+	case *btcutil.AddressWitnessScriptHash:
+		return AddressTypeP2WSH
+	case *btcutil.AddressPubKeyHash:
+		return AddressTypeP2PKH
+	case *btcutil.AddressScriptHash:
+		return AddressTypeP2SH
+	default:
+		return fmt.Sprintf("%T", address)
+	}
+}
@@ -0,0 +1,118 @@
+package btc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/crypto/schnorr"
+)
+
+// mnemonic of the BIP-84 and BIP-86 test vectors
+const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestNetworkParams(t *testing.T) {
+	testCases := []struct {
+		network string
+		expName string
+		expPass bool
+	}{
+		{"mainnet", chaincfg.MainNetParams.Name, true},
+		{"testnet", chaincfg.TestNet3Params.Name, true},
+		{"testnet3", chaincfg.TestNet3Params.Name, true},
+		{"regtest", chaincfg.RegressionNetParams.Name, true},
+		{"signet", chaincfg.SigNetParams.Name, true},
+		{"simnet", "", false},
+		{"", "", false},
+	}
+
+	for _, tc := range testCases {
+		params, err := NetworkParams(tc.network)
+		if tc.expPass {
+			require.NoError(t, err, tc.network)
+			require.Equal(t, tc.expName, params.Name)
+		} else {
+			require.Error(t, err, tc.network)
+		}
+	}
+}
+
+func TestNewAddress(t *testing.T) {
+	derive := func(algo keyring.SignatureAlgo, path string) []byte {
+		bz, err := algo.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, path)
+		require.NoError(t, err)
+		return bz
+	}
+
+	bip84Key := &ethsecp256k1.PrivKey{Key: derive(hd.EthSecp256k1, "m/84'/0'/0'/0/0")}
+	bip86Key := &ethsecp256k1.PrivKey{Key: derive(hd.EthSecp256k1, "m/86'/0'/0'/0/0")}
+	schnorrKey := &schnorr.PrivKey{Key: derive(hd.Schnorr, "m/86'/0'/0'/0/0")}
+
+	testCases := []struct {
+		name        string
+		privKey     cryptotypes.PrivKey
+		addressType string
+		network     *chaincfg.Params
+		expAddress  string
+		expPass     bool
+	}{
+		{"BIP-84 eth_secp256k1", bip84Key, AddressTypeP2WPKH, &chaincfg.MainNetParams, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", true},
+		{"BIP-84 eth_secp256k1 default type", bip84Key, "", &chaincfg.MainNetParams, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", true},
+		{"BIP-84 secp256k1", &secp256k1.PrivKey{Key: bip84Key.Key}, AddressTypeP2WPKH, &chaincfg.MainNetParams, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", true},
+		{"BIP-86 eth_secp256k1", bip86Key, AddressTypeP2TR, &chaincfg.MainNetParams, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", true},
+		{"BIP-86 schnorr", schnorrKey, AddressTypeP2TR, &chaincfg.MainNetParams, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", true},
+		{"BIP-86 schnorr default type", schnorrKey, "", &chaincfg.MainNetParams, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", true},
+		{"regtest", bip84Key, AddressTypeP2WPKH, &chaincfg.RegressionNetParams, "bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx", true},
+		{"schnorr p2wpkh", schnorrKey, AddressTypeP2WPKH, &chaincfg.MainNetParams, "", false},
+		{"invalid type", bip84Key, "p2pkh", &chaincfg.MainNetParams, "", false},
+		{"ed25519", ed25519.GenPrivKey(), AddressTypeP2WPKH, &chaincfg.MainNetParams, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := NewAddress(tc.privKey.PubKey(), tc.addressType, tc.network)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expAddress, address.EncodeAddress())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeAddress(t *testing.T) {
+	testCases := []struct {
+		address    string
+		expNetwork string
+		expType    string
+		expPass    bool
+	}{
+		{"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", chaincfg.MainNetParams.Name, AddressTypeP2WPKH, true},
+		{"bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", chaincfg.MainNetParams.Name, AddressTypeP2TR, true},
+		{"bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx", chaincfg.RegressionNetParams.Name, AddressTypeP2WPKH, true},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", chaincfg.MainNetParams.Name, AddressTypeP2PKH, true},
+		{"ethm1rgc2th99780u4xf54yazlevs0p8q99mxned80l", "", "", false},
+		{"0xA588C66983a81e800Db4dF74564F09f91c026351", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		addr, params, err := DecodeAddress(tc.address)
+		if tc.expPass {
+			require.NoError(t, err, tc.address)
+			require.Equal(t, tc.expNetwork, params.Name)
+			require.Equal(t, tc.expType, AddressTypeOf(addr))
+			require.Equal(t, tc.address, addr.EncodeAddress())
+		} else {
+			require.Error(t, err, tc.address)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
 )
 
 // RegisterCrypto registers all crypto dependency types with the provided Amino
@@ -32,11 +33,15 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		ethsecp256k1.PubKeyName, nil)
 	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{},
 		ethsecp256k1.PrivKeyName, nil)
+	cdc.RegisterConcrete(&schnorr.PubKey{},
+		schnorr.PubKeyName, nil)
+	cdc.RegisterConcrete(&schnorr.PrivKey{},
+		schnorr.PrivKeyName, nil)
 
 	keyring.RegisterLegacyAminoCodec(cdc)
 	cryptocodec.RegisterCrypto(cdc)
 
-	// NOTE: update SDK's amino codec to include the ethsecp256k1 keys.
+	// NOTE: update SDK's amino codec to include the ethsecp256k1 and schnorr keys.
 	// DO NOT REMOVE unless deprecated on the SDK.
 	legacy.Cdc = cdc
 	keys.KeysCdc = cdc
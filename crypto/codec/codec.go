@@ -20,10 +20,13 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
 )
 
 // RegisterInterfaces register the Ethermint key concrete types.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &ethsecp256k1.PubKey{})
 	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &ethsecp256k1.PrivKey{})
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &schnorr.PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &schnorr.PrivKey{})
 }
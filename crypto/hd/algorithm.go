@@ -16,6 +16,8 @@
 package hd
 
 import (
+	"fmt"
+
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	bip39 "github.com/tyler-smith/go-bip39"
@@ -28,18 +30,25 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/schnorr"
 )
 
 const (
 	// EthSecp256k1Type defines the ECDSA secp256k1 used on Ethereum
 	EthSecp256k1Type = hd.PubKeyType(ethsecp256k1.KeyType)
+	// SchnorrType defines the BIP-340 Schnorr secp256k1 used on Bitcoin Taproot
+	SchnorrType = hd.PubKeyType(schnorr.KeyType)
+
+	// BIP86Purpose defines the BIP-43 purpose of the BIP-86 single key Taproot derivation paths
+	BIP86Purpose uint32 = 86
 )
 
 var (
 	// SupportedAlgorithms defines the list of signing algorithms used on Ethermint:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
-	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1}
+	//  - schnorr (Bitcoin Taproot)
+	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1, Schnorr}
 	// SupportedAlgorithmsLedger defines the list of signing algorithms used on Ethermint for the Ledger device:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
@@ -47,7 +56,7 @@ var (
 )
 
 // EthSecp256k1Option defines a function keys options for the ethereum Secp256k1 curve.
-// It supports eth_secp256k1 and secp256k1 keys for accounts.
+// It supports eth_secp256k1, secp256k1 and schnorr keys for accounts.
 func EthSecp256k1Option() keyring.Option {
 	return func(options *keyring.Options) {
 		options.SupportedAlgos = SupportedAlgorithms
@@ -55,11 +64,20 @@ func EthSecp256k1Option() keyring.Option {
 	}
 }
 
+// CreateBIP86HDPath returns the BIP-86 HD path parameters of a Taproot key
+// for the given coin type, account and address index.
+func CreateBIP86HDPath(coinType, account, index uint32) *hd.BIP44Params {
+	return hd.NewParams(BIP86Purpose, coinType, account, false, index)
+}
+
 var (
 	_ keyring.SignatureAlgo = EthSecp256k1
+	_ keyring.SignatureAlgo = Schnorr
 
 	// EthSecp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	EthSecp256k1 = ethSecp256k1Algo{}
+	// Schnorr uses the Bitcoin secp256k1 BIP-340 Schnorr parameters.
+	Schnorr = schnorrAlgo{}
 )
 
 type ethSecp256k1Algo struct{}
@@ -124,3 +142,39 @@ func (s ethSecp256k1Algo) Generate() hd.GenerateFn {
 		}
 	}
 }
+
+type schnorrAlgo struct{}
+
+// Name returns schnorr
+func (s schnorrAlgo) Name() hd.PubKeyType {
+	return SchnorrType
+}
+
+// Derive derives and returns the schnorr private key for the given mnemonic and BIP-86 HD path.
+func (s schnorrAlgo) Derive() hd.DeriveFn {
+	return func(mnemonic, bip39Passphrase, path string) ([]byte, error) {
+		hdpath, err := accounts.ParseDerivationPath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hdpath) == 0 || hdpath[0] != hdkeychain.HardenedKeyStart+BIP86Purpose {
+			return nil, fmt.Errorf("invalid %s derivation path %s, expected a BIP-86 path m/%d'/...", SchnorrType, path, BIP86Purpose)
+		}
+
+		// the BIP-32 derivation of the secp256k1 scalar doesn't depend on the signature scheme
+		return EthSecp256k1.Derive()(mnemonic, bip39Passphrase, path)
+	}
+}
+
+// Generate generates a schnorr private key from the given bytes.
+func (s schnorrAlgo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		bzArr := make([]byte, schnorr.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &schnorr.PrivKey{
+			Key: bzArr,
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package hd
 
 import (
+	"encoding/hex"
 	"os"
 	"strings"
 	"testing"
@@ -128,3 +129,53 @@ func TestDerivation(t *testing.T) {
 	require.NotEqual(t, common.BytesToAddress(privkey.PubKey().Address()).String(), badAccount.Address.String())
 	require.NotEqual(t, common.BytesToAddress(badPrivKey.PubKey().Address()).String(), account.Address.Hex())
 }
+
+func TestSchnorrKeyring(t *testing.T) {
+	dir := t.TempDir()
+	mockIn := strings.NewReader("")
+	kr, err := keyring.New("ethermint", keyring.BackendTest, dir, mockIn, TestCodec, EthSecp256k1Option())
+	require.NoError(t, err)
+
+	hdPath := CreateBIP86HDPath(ethermint.BIP86CoinType, 0, 0).String()
+	require.Equal(t, ethermint.BIP86HDPath, hdPath)
+
+	info, mnemonic, err := kr.NewMnemonic("foo", keyring.English, hdPath, keyring.DefaultBIP39Passphrase, Schnorr)
+	require.NoError(t, err)
+	require.NotEmpty(t, mnemonic)
+	pubKey, err := info.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(SchnorrType), pubKey.Type())
+
+	// signatures of the keyring key are verified by its public key
+	msg := []byte("hello world")
+	sig, _, err := kr.Sign("foo", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// schnorr keys are only derived on BIP-86 paths
+	_, err = kr.NewAccount("bar", mnemonic, keyring.DefaultBIP39Passphrase, ethermint.BIP44HDPath, Schnorr)
+	require.Error(t, err)
+}
+
+func TestSchnorrDerivation(t *testing.T) {
+	// BIP-86 test vector
+	bip86Mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	bz, err := Schnorr.Derive()(bip86Mnemonic, keyring.DefaultBIP39Passphrase, ethermint.BIP86HDPath)
+	require.NoError(t, err)
+
+	privKey := Schnorr.Generate()(bz)
+	require.Equal(t, "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115", hex.EncodeToString(privKey.PubKey().Bytes()))
+
+	bz, err = Schnorr.Derive()(bip86Mnemonic, keyring.DefaultBIP39Passphrase, "m/86'/0'/0'/0/1")
+	require.NoError(t, err)
+
+	privKey = Schnorr.Generate()(bz)
+	require.Equal(t, "83dfe85a3151d2517290da461fe2815591ef69f2b18a2ce63f01697a8b313145", hex.EncodeToString(privKey.PubKey().Bytes()))
+
+	_, err = Schnorr.Derive()(bip86Mnemonic, keyring.DefaultBIP39Passphrase, ethermint.BIP44HDPath)
+	require.Error(t, err)
+
+	_, err = Schnorr.Derive()(bip86Mnemonic, keyring.DefaultBIP39Passphrase, "/wrong/hdPath")
+	require.Error(t, err)
+}
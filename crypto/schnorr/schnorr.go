@@ -0,0 +1,266 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package schnorr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// PrivKeySize defines the size of the PrivKey bytes
+	PrivKeySize = 32
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = btcschnorr.PubKeyBytesLen
+	// SignatureSize defines the size of the BIP-340 signatures
+	SignatureSize = btcschnorr.SignatureSize
+	// KeyType is the string constant for the BIP-340 Schnorr algorithm
+	KeyType = "schnorr"
+)
+
+// Amino encoding names
+const (
+	// PrivKeyName defines the amino encoding name for the Schnorr private key
+	PrivKeyName = "ethermint/PrivKeySchnorr"
+	// PubKeyName defines the amino encoding name for the Schnorr public key
+	PubKeyName = "ethermint/PubKeySchnorr"
+)
+
+// ----------------------------------------------------------------------------
+// Schnorr Private Key
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+// GenerateKey generates a new random private key. It returns an error upon
+// failure.
+func GenerateKey() (*PrivKey, error) {
+	priv, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{
+		Key: priv.Serialize(),
+	}, nil
+}
+
+// Bytes returns the byte representation of the Schnorr Private Key.
+func (privKey PrivKey) Bytes() []byte {
+	bz := make([]byte, len(privKey.Key))
+	copy(bz, privKey.Key)
+
+	return bz
+}
+
+// PubKey returns the x-only public key of the private key. If the privkey is
+// not valid it returns a nil value.
+func (privKey PrivKey) PubKey() cryptotypes.PubKey {
+	priv, err := privKey.ToBTCEC()
+	if err != nil {
+		return nil
+	}
+
+	return &PubKey{
+		Key: btcschnorr.SerializePubKey(priv.PubKey()),
+	}
+}
+
+// Equals returns true if two Schnorr private keys are equal and false otherwise.
+func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type returns schnorr
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size, expected %d got %d", PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// Sign creates a 64-byte BIP-340 signature over the SHA-256 hash of the
+// provided message.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv, err := privKey.ToBTCEC()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(msg)
+	sig, err := btcschnorr.Sign(priv, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return sig.Serialize(), nil
+}
+
+// ToBTCEC returns the private key as a reference to the btcec.PrivateKey type.
+func (privKey PrivKey) ToBTCEC() (*btcec.PrivateKey, error) {
+	if len(privKey.Key) != PrivKeySize {
+		return nil, fmt.Errorf("invalid privkey size, expected %d got %d", PrivKeySize, len(privKey.Key))
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(privKey.Key)
+	if priv.Key.IsZero() {
+		return nil, fmt.Errorf("invalid private key, zero scalar")
+	}
+
+	return priv, nil
+}
+
+// ----------------------------------------------------------------------------
+// Schnorr Public Key
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the Ethereum address of the public key, i.e. the address
+// of the secp256k1 point with the x coordinate of the key and an even y
+// coordinate, as specified by BIP-340.
+// The function will return an empty address if the public key is invalid.
+func (pubKey PubKey) Address() tmcrypto.Address {
+	pubk, err := btcschnorr.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return nil
+	}
+
+	return tmcrypto.Address(crypto.PubkeyToAddress(*pubk.ToECDSA()).Bytes())
+}
+
+// Bytes returns the raw bytes of the x-only public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeySchnorr{%X}", pubKey.Key)
+}
+
+// Type returns schnorr
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature verifies that the x-only public key created a given BIP-340
+// signature over the SHA-256 hash of the provided message.
+func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	pubk, err := btcschnorr.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return false
+	}
+
+	signature, err := btcschnorr.ParseSignature(sig)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return signature.Verify(hash[:], pubk)
+}
+
+// TaprootOutputKey returns the x-only Taproot output key committing to the
+// public key as internal key with no script path, as specified by BIP-86.
+func (pubKey PubKey) TaprootOutputKey() ([]byte, error) {
+	pubk, err := btcschnorr.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidPubKey, err.Error())
+	}
+
+	return btcschnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(pubk)), nil
+}
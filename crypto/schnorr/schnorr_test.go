@@ -0,0 +1,165 @@
+package schnorr
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestPrivKey(t *testing.T) {
+	// validate type and equality
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(privKey))
+	require.Implements(t, (*cryptotypes.PrivKey)(nil), privKey)
+
+	// validate inequality
+	privKey2, err := GenerateKey()
+	require.NoError(t, err)
+	require.False(t, privKey.Equals(privKey2))
+
+	// validate the x-only public key
+	key, err := privKey.ToBTCEC()
+	require.NoError(t, err)
+	require.Equal(t, btcschnorr.SerializePubKey(key.PubKey()), privKey.PubKey().Bytes())
+
+	// validate we can sign some bytes
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	signature, err := btcschnorr.ParseSignature(sig)
+	require.NoError(t, err)
+	hash := sha256.Sum256(msg)
+	require.True(t, signature.Verify(hash[:], key.PubKey()))
+
+	// invalid keys can't sign
+	_, err = (&PrivKey{Key: make([]byte, PrivKeySize)}).Sign(msg)
+	require.Error(t, err)
+	_, err = (&PrivKey{Key: []byte{1}}).Sign(msg)
+	require.Error(t, err)
+	require.Nil(t, (&PrivKey{Key: []byte{1}}).PubKey())
+}
+
+func TestPrivKey_PubKey(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	// validate type and equality
+	pubKey := &PubKey{
+		Key: privKey.PubKey().Bytes(),
+	}
+	require.Implements(t, (*cryptotypes.PubKey)(nil), pubKey)
+	require.Len(t, pubKey.Bytes(), PubKeySize)
+
+	// validate inequality
+	privKey2, err := GenerateKey()
+	require.NoError(t, err)
+	require.False(t, pubKey.Equals(privKey2.PubKey()))
+
+	// validate signature
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("hello world!"), sig))
+	require.False(t, privKey2.PubKey().VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[:SignatureSize-1]))
+	require.False(t, (&PubKey{Key: []byte{1}}).VerifySignature(msg, sig))
+}
+
+func TestPubKey_Address(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	key, err := privKey.ToBTCEC()
+	require.NoError(t, err)
+
+	// the address is the Ethereum address of the even y point
+	point, err := btcschnorr.ParsePubKey(privKey.PubKey().Bytes())
+	require.NoError(t, err)
+	require.Equal(t, key.PubKey().X(), point.X())
+	require.Equal(t, crypto.PubkeyToAddress(*point.ToECDSA()).Bytes(), privKey.PubKey().Address().Bytes())
+
+	require.Nil(t, (&PubKey{Key: []byte{1}}).Address())
+}
+
+func TestTaprootOutputKey(t *testing.T) {
+	// BIP-86 test vector, m/86'/0'/0'/0/0 of the "abandon ... about" mnemonic
+	internalKey, err := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	require.NoError(t, err)
+
+	outputKey, err := (&PubKey{Key: internalKey}).TaprootOutputKey()
+	require.NoError(t, err)
+	require.Equal(t, "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c", hex.EncodeToString(outputKey))
+
+	_, err = (&PubKey{Key: internalKey[1:]}).TaprootOutputKey()
+	require.Error(t, err)
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().(*PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"schnorr private key",
+			privKey,
+			&PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"schnorr public key",
+			pubKey,
+			&PubKey{},
+			append([]byte{32}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
@@ -16,6 +16,7 @@
 package indexer
 
 import (
+	"bytes"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -35,14 +36,42 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash      = 1
+	KeyPrefixTxIndex     = 2
+	KeyPrefixBlockMarker = 3
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+
+	// pruneBatchSize is the number of deletions written per batch when pruning
+	pruneBatchSize = 1000
+)
+
+var (
+	_ ethermint.EVMTxIndexer = &KVIndexer{}
+	_ ethermint.EVMTxPruner  = &KVIndexer{}
 )
 
-var _ ethermint.EVMTxIndexer = &KVIndexer{}
+// BlockReport is the result of the verification of the eth txs indexed at a block height.
+type BlockReport struct {
+	Height int64
+	// Marked is true if the completeness marker of the block is recorded
+	Marked bool
+	// Skipped is the number of eth txs in the block results that fail to be decoded or parsed
+	Skipped int
+	// Missing are the eth txs of the block that are not indexed
+	Missing []common.Hash
+	// Mismatched are the eth txs whose indexed entries differ from the block results
+	Mismatched []common.Hash
+	// Stale are the eth tx indexes indexed at the height beyond the eth txs of the block
+	Stale []int32
+}
+
+// OK returns true if the indexed entries match the block results. The completeness marker may be
+// missing, e.g. for the blocks indexed before the markers were recorded.
+func (r BlockReport) OK() bool {
+	return r.Skipped == 0 && len(r.Missing) == 0 && len(r.Mismatched) == 0 && len(r.Stale) == 0
+}
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -61,12 +90,124 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Records the completeness marker of the block if no eth tx is skipped
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	if _, err := kv.indexBlock(batch, block, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// RepairBlock re-indexes the eth txs of a block, replacing any entry previously indexed at its height,
+// returns false if some eth txs are still skipped, in which case no completeness marker is recorded.
+func (kv *KVIndexer) RepairBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) (bool, error) {
+	height := block.Header.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.deleteBlock(batch, height); err != nil {
+		return false, errorsmod.Wrapf(err, "RepairBlock %d", height)
+	}
+	complete, err := kv.indexBlock(batch, block, txResults)
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "RepairBlock %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return false, errorsmod.Wrapf(err, "RepairBlock %d, write batch", height)
+	}
+	return complete, nil
+}
+
+// VerifyBlock compares the eth txs indexed at the height of a block against the block results.
+func (kv *KVIndexer) VerifyBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) (*BlockReport, error) {
+	height := block.Header.Height
+	txs, skipped := parseBlock(kv.clientCtx, kv.logger, block, txResults)
+	report := &BlockReport{Height: height, Skipped: skipped}
+
+	count, found, err := loadBlockMarker(kv.db, height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	report.Marked = found && count == uint64(len(txs))
+
+	for _, tx := range txs {
+		bz, err := kv.db.Get(TxHashKey(tx.hash))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if len(bz) == 0 {
+			report.Missing = append(report.Missing, tx.hash)
+			continue
+		}
+		hashBz, err := kv.db.Get(TxIndexKey(height, tx.result.EthTxIndex))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if !bytes.Equal(bz, kv.clientCtx.Codec.MustMarshal(tx.result)) || !bytes.Equal(hashBz, tx.hash.Bytes()) {
+			report.Mismatched = append(report.Mismatched, tx.hash)
+		}
+	}
+
+	it, err := kv.db.Iterator(TxIndexKey(height, int32(len(txs))), TxIndexKey(height+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		report.Stale = append(report.Stale, int32(sdk.BigEndianToUint64(it.Key()[9:])))
+	}
+	return report, nil
+}
+
+// indexedTx is an eth tx parsed from the block results, with the entry to index.
+type indexedTx struct {
+	hash     common.Hash
+	msg      *evmtypes.MsgEthereumTx
+	txResult *abci.ResponseDeliverTx
+	result   *ethermint.TxResult
+}
+
+// indexBlock adds the entries of the eth txs in a block to the batch, returns false if some are skipped.
+func (kv *KVIndexer) indexBlock(batch dbm.Batch, block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) (bool, error) {
+	txs, skipped := parseBlock(kv.clientCtx, kv.logger, block, txResults)
+	for _, tx := range txs {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.hash, tx.result); err != nil {
+			return false, err
+		}
+	}
+	if skipped > 0 {
+		return false, nil
+	}
+	if err := batch.Set(BlockMarkerKey(block.Header.Height), sdk.Uint64ToBigEndian(uint64(len(txs)))); err != nil {
+		return false, errorsmod.Wrap(err, "set block marker key")
+	}
+	return true, nil
+}
+
+// parseBlock builds the tx results of the eth txs in a block, together with the number of eth txs
+// skipped because they fail to be decoded or parsed.
+func parseBlock(
+	clientCtx client.Context,
+	logger log.Logger,
+	block *tmtypes.Block,
+	txResults []*abci.ResponseDeliverTx,
+) ([]indexedTx, int) {
+	height := block.Header.Height
+
+	var (
+		indexed []indexedTx
+		skipped int
+	)
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -75,25 +216,38 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
 		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			skipped++
 			continue
 		}
 
-		if !isEthTx(tx) {
-			continue
+		// cosmos txs are indexed for the MsgEthereumCall they execute
+		ethMsgCount := len(rpctypes.EthereumCallMsgs(tx))
+		if ethMsgCount == 0 {
+			if !isEthTx(tx) {
+				continue
+			}
+			ethMsgCount = len(tx.GetMsgs())
 		}
 
 		txs, err := rpctypes.ParseTxResult(result, tx)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			skipped += ethMsgCount
+			continue
+		}
+
+		ethMsgs, err := rpctypes.EthMsgsFromTx(tx, result)
+		if err != nil {
+			logger.Error("Fail to parse eth msgs", "err", err, "block", height, "txIndex", txIndex)
+			skipped += ethMsgCount
 			continue
 		}
 
 		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+		for msgIndex, ethMsg := range ethMsgs {
 			txHash := common.HexToHash(ethMsg.Hash)
 
 			txResult := ethermint.TxResult{
@@ -110,11 +264,12 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			} else {
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					skipped++
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
@@ -124,17 +279,104 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			indexed = append(indexed, indexedTx{hash: txHash, msg: ethMsg, txResult: result, result: &txResult})
+		}
+	}
+	return indexed, skipped
+}
+
+// deleteBlock adds to the batch the deletion of the entries indexed at a height.
+func (kv *KVIndexer) deleteBlock(batch dbm.Batch, height int64) error {
+	it, err := kv.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		hash := common.BytesToHash(it.Value())
+		// the hash may have been indexed again at another height
+		txResult, err := kv.GetByTxHash(hash)
+		if err == nil && txResult.Height == height {
+			if err := batch.Delete(TxHashKey(hash)); err != nil {
+				return errorsmod.Wrap(err, "delete tx-hash key")
 			}
 		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete tx-index key")
+		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	if err := batch.Delete(BlockMarkerKey(height)); err != nil {
+		return errorsmod.Wrap(err, "delete block marker key")
 	}
 	return nil
 }
 
+// PruneBlocks deletes the tx-index entries and completeness markers of the blocks below the height,
+// and their tx-hash entries unless keepTxHash, so FirstIndexedBlock moves to the first retained block.
+func (kv *KVIndexer) PruneBlocks(height int64, keepTxHash bool) error {
+	if err := kv.pruneRange(TxIndexKey(0, 0), TxIndexKey(height, 0), func(batch dbm.Batch, key, value []byte) error {
+		if keepTxHash {
+			return nil
+		}
+		// the hash may have been indexed again at a retained height
+		txHash := common.BytesToHash(value)
+		txResult, err := kv.GetByTxHash(txHash)
+		if err != nil || txResult.Height >= height {
+			return nil
+		}
+		return batch.Delete(TxHashKey(txHash))
+	}); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, tx-index keys", height)
+	}
+	if err := kv.pruneRange(BlockMarkerKey(0), BlockMarkerKey(height), nil); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, block marker keys", height)
+	}
+	return nil
+}
+
+// pruneRange deletes the keys in the range in batches, calling onDelete on every deleted entry
+func (kv *KVIndexer) pruneRange(start, end []byte, onDelete func(batch dbm.Batch, key, value []byte) error) error {
+	for {
+		batch := kv.db.NewBatch()
+		n, err := kv.pruneBatch(batch, start, end, onDelete)
+		if err == nil && n > 0 {
+			err = batch.Write()
+		}
+		batch.Close()
+		if err != nil || n < pruneBatchSize {
+			return err
+		}
+	}
+}
+
+// pruneBatch adds to the batch the deletion of at most pruneBatchSize keys of the range
+func (kv *KVIndexer) pruneBatch(
+	batch dbm.Batch,
+	start, end []byte,
+	onDelete func(batch dbm.Batch, key, value []byte) error,
+) (int, error) {
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var n int
+	for ; it.Valid() && n < pruneBatchSize; it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return 0, err
+		}
+		if onDelete != nil {
+			if err := onDelete(batch, it.Key(), it.Value()); err != nil {
+				return 0, err
+			}
+		}
+		n++
+	}
+	return n, it.Error()
+}
+
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
 func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
@@ -185,6 +427,12 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// BlockMarkerKey returns the key for db entry: `block number -> number of eth txs`, recorded once all
+// the eth txs of the block are indexed
+func BlockMarkerKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockMarker}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -211,6 +459,18 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// loadBlockMarker returns the number of eth txs recorded by the completeness marker of a block
+func loadBlockMarker(db dbm.DB, height int64) (uint64, bool, error) {
+	bz, err := db.Get(BlockMarkerKey(height))
+	if err != nil {
+		return 0, false, errorsmod.Wrap(err, "loadBlockMarker")
+	}
+	if len(bz) == 0 {
+		return 0, false, nil
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -1,17 +1,22 @@
 package indexer_test
 
 import (
+	"database/sql"
 	"math/big"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/app"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	evmenc "github.com/evmos/ethermint/encoding"
 	"github.com/evmos/ethermint/indexer"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/require"
@@ -19,9 +24,13 @@ import (
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+
+	ethermint "github.com/evmos/ethermint/types"
+
+	_ "github.com/mattn/go-sqlite3"
 )
 
-func TestKVIndexer(t *testing.T) {
+func TestEVMTxIndexer(t *testing.T) {
 	priv, err := ethsecp256k1.GenerateKey()
 	require.NoError(t, err)
 	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
@@ -148,42 +157,334 @@ func TestKVIndexer(t *testing.T) {
 		},
 	}
 
+	for _, tc := range testCases {
+		for name, idxer := range newTestIndexers(t, clientCtx) {
+			t.Run(name+", "+tc.name, func(t *testing.T) {
+				err = idxer.IndexBlock(tc.block, tc.blockResult)
+				require.NoError(t, err)
+				if !tc.expSuccess {
+					first, err := idxer.FirstIndexedBlock()
+					require.NoError(t, err)
+					require.Equal(t, int64(-1), first)
+
+					last, err := idxer.LastIndexedBlock()
+					require.NoError(t, err)
+					require.Equal(t, int64(-1), last)
+				} else {
+					first, err := idxer.FirstIndexedBlock()
+					require.NoError(t, err)
+					require.Equal(t, tc.block.Header.Height, first)
+
+					last, err := idxer.LastIndexedBlock()
+					require.NoError(t, err)
+					require.Equal(t, tc.block.Header.Height, last)
+
+					res1, err := idxer.GetByTxHash(txHash)
+					require.NoError(t, err)
+					require.NotNil(t, res1)
+					res2, err := idxer.GetByBlockAndIndex(1, 0)
+					require.NoError(t, err)
+					require.Equal(t, res1, res2)
+				}
+			})
+		}
+	}
+}
+
+func TestEVMTxIndexerEthereumCall(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(priv.PubKey().Address().Bytes())
+	to := common.BigToAddress(big.NewInt(1))
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// a cosmos tx executing a call directly and another one through authz
+	call := types.NewMsgEthereumCall(sender, &to, nil, big.NewInt(1000), 21000)
+	create := types.NewMsgEthereumCall(sender, nil, []byte{0x00}, nil, 60000)
+	exec := authz.NewMsgExec(sender, []sdk.Msg{create})
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(call, &exec))
+	txBz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	callHash := common.BytesToHash([]byte("call"))
+	createHash := common.BytesToHash([]byte("create"))
+	callEvent := func(hash common.Hash, txIndex, gasUsed, nonce string) abci.Event {
+		return abci.Event{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: []byte("amount"), Value: []byte("0")},
+			{Key: []byte("ethereumTxHash"), Value: []byte(hash.Hex())},
+			{Key: []byte("txIndex"), Value: []byte(txIndex)},
+			{Key: []byte("txGasUsed"), Value: []byte(gasUsed)},
+			{Key: []byte("txNonce"), Value: []byte(nonce)},
+		}}
+	}
+
+	testCases := []struct {
+		name        string
+		blockResult *abci.ResponseDeliverTx
+		expSuccess  bool
+	}{
+		{
+			"success",
+			&abci.ResponseDeliverTx{
+				Code:    0,
+				GasUsed: 120000,
+				Events: []abci.Event{
+					callEvent(callHash, "0", "21000", "3"),
+					callEvent(createHash, "1", "53000", "4"),
+				},
+			},
+			true,
+		},
+		{
+			"fail, failed cosmos tx",
+			&abci.ResponseDeliverTx{Code: 5, Log: "insufficient funds"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		for name, idxer := range newTestIndexers(t, clientCtx) {
+			t.Run(name+", "+tc.name, func(t *testing.T) {
+				block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+				require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{tc.blockResult}))
+
+				res, err := idxer.GetByTxHash(createHash)
+				if !tc.expSuccess {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, uint32(1), res.MsgIndex)
+				require.Equal(t, int32(1), res.EthTxIndex)
+				require.Equal(t, uint64(53000), res.GasUsed)
+				require.Equal(t, uint64(74000), res.CumulativeGasUsed)
+
+				res, err = idxer.GetByBlockAndIndex(1, 0)
+				require.NoError(t, err)
+				require.Equal(t, uint32(0), res.MsgIndex)
+				require.Equal(t, uint64(21000), res.GasUsed)
+			})
+		}
+	}
+
+	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+	require.NoError(t, err)
+	ethMsgs, err := rpctypes.EthMsgsFromTx(tx, testCases[0].blockResult)
+	require.NoError(t, err)
+	require.Len(t, ethMsgs, 2)
+	for _, ethMsg := range ethMsgs {
+		require.True(t, rpctypes.IsEthereumCallMsg(ethMsg))
+		require.Equal(t, types.SenderToEVMAddress(sender).Hex(), ethMsg.From)
+	}
+	require.Equal(t, callHash.Hex(), ethMsgs[0].Hash)
+	require.Equal(t, uint64(3), ethMsgs[0].AsTransaction().Nonce())
+	require.Nil(t, ethMsgs[1].AsTransaction().To())
+	require.Equal(t, uint64(4), ethMsgs[1].AsTransaction().Nonce())
+}
+
+// testIndexer is implemented by the eth tx indexers sharing the same tests
+type testIndexer interface {
+	ethermint.EVMTxIndexer
+	FirstIndexedBlock() (int64, error)
+}
+
+// newTestIndexers returns a kv and a sqlite indexer with empty databases
+func newTestIndexers(t *testing.T, clientCtx client.Context) map[string]testIndexer {
+	sqlDB, err := sql.Open(indexer.DriverSQLite, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	sqlIndexer, err := indexer.NewSQLIndexer(sqlDB, indexer.DriverSQLite, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	return map[string]testIndexer{
+		"kv":  indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx),
+		"sql": sqlIndexer,
+	}
+}
+
+func TestKVIndexerVerifyRepair(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+					{Key: []byte("txIndex"), Value: []byte("0")},
+					{Key: []byte("amount"), Value: []byte("1000")},
+					{Key: []byte("txGasUsed"), Value: []byte("21000")},
+					{Key: []byte("txHash"), Value: []byte("")},
+					{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+				}},
+			},
+		},
+	}
+	// block results the indexer fails to parse
+	invalidResult := []*abci.ResponseDeliverTx{{Code: 0, Events: []abci.Event{}}}
+
+	testCases := []struct {
+		name     string
+		malleate func(db dbm.DB, idxer *indexer.KVIndexer)
+		check    func(report *indexer.BlockReport)
+	}{
+		{
+			"indexed block",
+			func(_ dbm.DB, idxer *indexer.KVIndexer) {
+				require.NoError(t, idxer.IndexBlock(block, blockResult))
+			},
+			func(report *indexer.BlockReport) {
+				require.True(t, report.OK())
+			},
+		},
+		{
+			"consistent entries without a completeness marker",
+			func(db dbm.DB, idxer *indexer.KVIndexer) {
+				require.NoError(t, idxer.IndexBlock(block, blockResult))
+				require.NoError(t, db.Delete(indexer.BlockMarkerKey(1)))
+			},
+			func(report *indexer.BlockReport) {
+				require.False(t, report.Marked)
+				require.True(t, report.OK())
+			},
+		},
+		{
+			"gap, block not indexed",
+			func(dbm.DB, *indexer.KVIndexer) {},
+			func(report *indexer.BlockReport) {
+				require.False(t, report.Marked)
+				require.Equal(t, []common.Hash{txHash}, report.Missing)
+			},
+		},
+		{
+			"gap, eth tx skipped by the indexer",
+			func(_ dbm.DB, idxer *indexer.KVIndexer) {
+				require.NoError(t, idxer.IndexBlock(block, invalidResult))
+			},
+			func(report *indexer.BlockReport) {
+				require.False(t, report.Marked)
+				require.Equal(t, []common.Hash{txHash}, report.Missing)
+			},
+		},
+		{
+			"mismatch, different tx result",
+			func(db dbm.DB, idxer *indexer.KVIndexer) {
+				require.NoError(t, idxer.IndexBlock(block, blockResult))
+				require.NoError(t, db.Set(indexer.TxIndexKey(1, 0), common.Hash{}.Bytes()))
+			},
+			func(report *indexer.BlockReport) {
+				require.True(t, report.Marked)
+				require.Equal(t, []common.Hash{txHash}, report.Mismatched)
+			},
+		},
+		{
+			"stale entry",
+			func(db dbm.DB, idxer *indexer.KVIndexer) {
+				require.NoError(t, idxer.IndexBlock(block, blockResult))
+				require.NoError(t, db.Set(indexer.TxIndexKey(1, 1), common.Hash{}.Bytes()))
+			},
+			func(report *indexer.BlockReport) {
+				require.Equal(t, []int32{1}, report.Stale)
+			},
+		},
+	}
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			db := dbm.NewMemDB()
 			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+			tc.malleate(db, idxer)
 
-			err = idxer.IndexBlock(tc.block, tc.blockResult)
+			report, err := idxer.VerifyBlock(block, blockResult)
 			require.NoError(t, err)
-			if !tc.expSuccess {
-				first, err := idxer.FirstIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, int64(-1), first)
-
-				last, err := idxer.LastIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, int64(-1), last)
-			} else {
-				first, err := idxer.FirstIndexedBlock()
-				require.NoError(t, err)
-				require.Equal(t, tc.block.Header.Height, first)
+			tc.check(report)
 
-				last, err := idxer.LastIndexedBlock()
+			// repair is idempotent and fixes the block
+			for i := 0; i < 2; i++ {
+				complete, err := idxer.RepairBlock(block, blockResult)
 				require.NoError(t, err)
-				require.Equal(t, tc.block.Header.Height, last)
+				require.True(t, complete)
 
-				res1, err := idxer.GetByTxHash(txHash)
-				require.NoError(t, err)
-				require.NotNil(t, res1)
-				res2, err := idxer.GetByBlockAndIndex(1, 0)
+				report, err = idxer.VerifyBlock(block, blockResult)
 				require.NoError(t, err)
-				require.Equal(t, res1, res2)
+				require.True(t, report.OK())
 			}
+
+			res, err := idxer.GetByTxHash(txHash)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), res.Height)
 		})
 	}
+
+	// the eth txs that can't be parsed are reported, and repair doesn't mark the block
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	complete, err := idxer.RepairBlock(block, invalidResult)
+	require.NoError(t, err)
+	require.False(t, complete)
+	report, err := idxer.VerifyBlock(block, invalidResult)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Skipped)
+	require.False(t, report.OK())
 }
 
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
 }
+
+func TestKVIndexerPruneBlocks(t *testing.T) {
+	tb1, tb2 := newTestBlock(t), newTestBlock(t)
+	block2 := &tmtypes.Block{Header: tmtypes.Header{Height: 2}, Data: tb2.block.Data}
+
+	for _, keepTxHash := range []bool{false, true} {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), tb1.clientCtx)
+		require.NoError(t, idxer.IndexBlock(tb1.block, tb1.blockResult))
+		require.NoError(t, idxer.IndexBlock(block2, tb2.blockResult))
+
+		require.NoError(t, idxer.PruneBlocks(2, keepTxHash))
+
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), first)
+
+		_, err = idxer.GetByBlockAndIndex(1, 0)
+		require.Error(t, err)
+		_, err = idxer.GetByTxHash(tb1.txHash)
+		require.Equal(t, keepTxHash, err == nil)
+
+		report, err := idxer.VerifyBlock(tb1.block, tb1.blockResult)
+		require.NoError(t, err)
+		require.False(t, report.Marked)
+
+		// the retained blocks are untouched
+		report, err = idxer.VerifyBlock(block2, tb2.blockResult)
+		require.NoError(t, err)
+		require.True(t, report.OK())
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// BlockSink exports the records of the finalized blocks to downstream systems.
+type BlockSink interface {
+	// Write exports the record of a block, the records are written in increasing height order. A
+	// block is written again if the process stops before the cursor is saved, or on a replay.
+	Write(record *BlockRecord) error
+	Close() error
+}
+
+// BlockRecord is the record of a finalized block exported to the sinks, with the receipts and
+// logs of its eth txs.
+type BlockRecord struct {
+	Number     hexutil.Uint64   `json:"number"`
+	Hash       common.Hash      `json:"hash"`
+	ParentHash common.Hash      `json:"parentHash"`
+	Timestamp  hexutil.Uint64   `json:"timestamp"`
+	Receipts   []*ReceiptRecord `json:"receipts"`
+	// SkippedTxs is the number of eth txs missing from the receipts as their results can't be parsed
+	SkippedTxs hexutil.Uint64 `json:"skippedTxs,omitempty"`
+}
+
+// ReceiptRecord is the receipt of an eth tx in a BlockRecord.
+type ReceiptRecord struct {
+	TransactionHash   common.Hash     `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
+	From              common.Address  `json:"from"`
+	To                *common.Address `json:"to"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	Nonce             hexutil.Uint64  `json:"nonce"`
+	Value             *hexutil.Big    `json:"value"`
+	Gas               hexutil.Uint64  `json:"gas"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	Status            hexutil.Uint64  `json:"status"`
+	LogsBloom         ethtypes.Bloom  `json:"logsBloom"`
+	Logs              []*ethtypes.Log `json:"logs"`
+}
+
+// NewBlockRecord builds the record of a block from the block results.
+func NewBlockRecord(
+	clientCtx client.Context,
+	logger log.Logger,
+	block *tmtypes.Block,
+	txResults []*abci.ResponseDeliverTx,
+) (*BlockRecord, error) {
+	txs, skipped := parseBlock(clientCtx, logger, block, txResults)
+	if skipped > 0 {
+		logger.Error("exporting block with skipped txs", "height", block.Header.Height, "skipped", skipped)
+	}
+
+	record := &BlockRecord{
+		Number:     hexutil.Uint64(block.Header.Height),
+		Hash:       common.BytesToHash(block.Hash()),
+		ParentHash: common.BytesToHash(block.Header.LastBlockID.Hash),
+		Timestamp:  hexutil.Uint64(block.Header.Time.Unix()),
+		Receipts:   make([]*ReceiptRecord, 0, len(txs)),
+		SkippedTxs: hexutil.Uint64(skipped),
+	}
+	for _, tx := range txs {
+		ethTx := tx.msg.AsTransaction()
+		if ethTx == nil {
+			return nil, fmt.Errorf("invalid tx data of eth tx %s", tx.hash.Hex())
+		}
+		receipt := &ReceiptRecord{
+			TransactionHash:   tx.hash,
+			TransactionIndex:  hexutil.Uint64(tx.result.EthTxIndex),
+			From:              txSender(tx.msg, ethTx),
+			To:                ethTx.To(),
+			Nonce:             hexutil.Uint64(ethTx.Nonce()),
+			Value:             (*hexutil.Big)(ethTx.Value()),
+			Gas:               hexutil.Uint64(ethTx.Gas()),
+			GasUsed:           hexutil.Uint64(tx.result.GasUsed),
+			CumulativeGasUsed: hexutil.Uint64(tx.result.CumulativeGasUsed),
+			Status:            hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+			Logs:              []*ethtypes.Log{},
+		}
+		if receipt.To == nil {
+			contract := crypto.CreateAddress(receipt.From, ethTx.Nonce())
+			receipt.ContractAddress = &contract
+		}
+		if tx.result.Failed {
+			receipt.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		} else {
+			logs, err := txLogsFromEvents(tx.txResult.Events, int(tx.result.MsgIndex))
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "parse logs of eth tx %s", tx.hash.Hex())
+			}
+			if logs != nil {
+				receipt.Logs = logs
+			}
+		}
+		receipt.LogsBloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(receipt.Logs))
+		record.Receipts = append(record.Receipts, receipt)
+	}
+	return record, nil
+}
+
+// txSender returns the sender of the eth tx, recovered from the signature if the msg doesn't set it.
+func txSender(msg *evmtypes.MsgEthereumTx, ethTx *ethtypes.Transaction) common.Address {
+	if msg.From != "" {
+		return common.HexToAddress(msg.From)
+	}
+	from, err := ethtypes.LatestSignerForChainID(ethTx.ChainId()).Sender(ethTx)
+	if err != nil {
+		return common.Address{}
+	}
+	return from
+}
+
+// BlockExporter writes the block records to a sink, tracking the last exported height in a
+// durable cursor file so the export resumes where it stopped after a restart. The cursor is moved
+// after the record is written, the block written before a crash is written again on restart.
+type BlockExporter struct {
+	sink       BlockSink
+	cursorPath string
+	clientCtx  client.Context
+	logger     log.Logger
+
+	// replayFrom is the height of the last replay started, recorded in the cursor file
+	replayFrom int64
+}
+
+// NewBlockExporter creates the BlockExporter writing to the sink, with the cursor stored at the given path.
+func NewBlockExporter(sink BlockSink, cursorPath string, clientCtx client.Context, logger log.Logger) *BlockExporter {
+	return &BlockExporter{sink: sink, cursorPath: cursorPath, clientCtx: clientCtx, logger: logger}
+}
+
+// LastExportedBlock returns the height recorded by the cursor, returns -1 if no block is exported yet.
+func (be *BlockExporter) LastExportedBlock() (int64, error) {
+	height, replayFrom, err := be.readCursor()
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastExportedBlock")
+	}
+	be.replayFrom = replayFrom
+	return height, nil
+}
+
+// StartReplay moves the cursor before the height to export the blocks again, unless the replay from
+// this height was already started, so it's only applied once. It returns if the replay is started.
+func (be *BlockExporter) StartReplay(height int64) (bool, error) {
+	_, replayFrom, err := be.readCursor()
+	if err != nil {
+		return false, errorsmod.Wrap(err, "StartReplay")
+	}
+	if replayFrom == height {
+		return false, nil
+	}
+	be.replayFrom = height
+	if err := be.saveCursor(height - 1); err != nil {
+		return false, errorsmod.Wrap(err, "StartReplay, save cursor")
+	}
+	return true, nil
+}
+
+// ExportBlock writes the record of the block to the sink, then moves the cursor to its height.
+func (be *BlockExporter) ExportBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+	record, err := NewBlockRecord(be.clientCtx, be.logger, block, txResults)
+	if err != nil {
+		return errorsmod.Wrapf(err, "ExportBlock %d", height)
+	}
+	if err := be.sink.Write(record); err != nil {
+		return errorsmod.Wrapf(err, "ExportBlock %d, write", height)
+	}
+	if err := be.saveCursor(height); err != nil {
+		return errorsmod.Wrapf(err, "ExportBlock %d, save cursor", height)
+	}
+	return nil
+}
+
+// Close closes the sink.
+func (be *BlockExporter) Close() error {
+	return be.sink.Close()
+}
+
+// readCursor returns the last exported height and the height of the last replay started, the
+// cursor file contains the height, followed by the replay height if any.
+func (be *BlockExporter) readCursor() (int64, int64, error) {
+	bz, err := os.ReadFile(be.cursorPath)
+	if os.IsNotExist(err) {
+		return -1, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	fields := strings.Fields(string(bz))
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, 0, fmt.Errorf("invalid cursor file %s", be.cursorPath)
+	}
+	heights := make([]int64, 2)
+	for i, field := range fields {
+		heights[i], err = strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return 0, 0, errorsmod.Wrapf(err, "invalid cursor file %s", be.cursorPath)
+		}
+	}
+	return heights[0], heights[1], nil
+}
+
+// saveCursor replaces the cursor file atomically
+func (be *BlockExporter) saveCursor(height int64) error {
+	cursor := strconv.FormatInt(height, 10)
+	if be.replayFrom > 0 {
+		cursor += " " + strconv.FormatInt(be.replayFrom, 10)
+	}
+
+	tmpPath := be.cursorPath + ".tmp"
+	f, err := os.OpenFile(filepath.Clean(tmpPath), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(cursor); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, be.cursorPath)
+}
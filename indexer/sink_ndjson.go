@@ -0,0 +1,201 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var _ BlockSink = &NDJSONSink{}
+
+// NDJSONSink writes the block records as newline delimited JSON to the files of a directory,
+// rotating to a new file named after the first height it contains once the current one reaches
+// the max file size.
+//
+// The records are kept in increasing height order and written once: a record with a height already
+// written, i.e. exported again after a crash before the cursor was saved or replayed, replaces the
+// records from its height.
+type NDJSONSink struct {
+	dir         string
+	maxFileSize int64
+
+	file *os.File
+	size int64
+	// last is the height of the last record written, -1 if none
+	last int64
+}
+
+// NewNDJSONSink creates the NDJSONSink writing to the directory, created if it doesn't exist. The
+// sink appends to the last file left by a previous run, dropping a partially written record.
+func NewNDJSONSink(dir string, maxFileSize int64) (*NDJSONSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &NDJSONSink{dir: dir, maxFileSize: maxFileSize, last: -1}
+	if err := s.rewind(math.MaxInt64); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Write appends the record to the current file and syncs it, so the record is durable once the
+// cursor is moved.
+func (s *NDJSONSink) Write(record *BlockRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	height := int64(record.Number)
+	if height <= s.last {
+		if err := s.rewind(height); err != nil {
+			return err
+		}
+	}
+	if s.file == nil || (s.maxFileSize > 0 && s.size >= s.maxFileSize) {
+		if err := s.rotate(height); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(bz)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+	s.last = height
+	return nil
+}
+
+// Close closes the current file.
+func (s *NDJSONSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// rotate closes the current file and creates a new one starting at the height.
+func (s *NDJSONSink) rotate(height int64) error {
+	if err := s.Close(); err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("blocks-%020d.ndjson", height))
+	// the files of the heights written again are removed by rewind, never overwrite a file
+	file, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	s.file = file
+	s.size = 0
+	return nil
+}
+
+// rewind drops the records from the height and the trailing partial record, removing the files
+// left empty, then reopens the last file for appending.
+func (s *NDJSONSink) rewind(height int64) error {
+	if err := s.Close(); err != nil {
+		return err
+	}
+	s.last = -1
+
+	// the zero padded names are sorted in height order
+	files, err := filepath.Glob(filepath.Join(s.dir, "blocks-*.ndjson"))
+	if err != nil {
+		return err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		file, err := os.OpenFile(filepath.Clean(files[i]), os.O_RDWR, 0o600)
+		if err != nil {
+			return err
+		}
+		heights, offsets, err := scanRecords(file)
+		if err != nil {
+			_ = file.Close()
+			return fmt.Errorf("invalid ndjson file %s: %w", files[i], err)
+		}
+		// offsets has one more entry than heights, the end of the last complete record
+		keep := sort.Search(len(heights), func(j int) bool { return heights[j] >= height })
+		if keep == 0 {
+			if err := file.Close(); err != nil {
+				return err
+			}
+			if err := os.Remove(files[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		size := offsets[keep]
+		if err := file.Truncate(size); err != nil {
+			_ = file.Close()
+			return err
+		}
+		if _, err := file.Seek(size, io.SeekStart); err != nil {
+			_ = file.Close()
+			return err
+		}
+		s.file = file
+		s.size = size
+		s.last = heights[keep-1]
+		return nil
+	}
+	return nil
+}
+
+// scanRecords returns the heights of the complete records of a file and their offsets, followed
+// by the offset of the end of the last complete record.
+func scanRecords(file *os.File) ([]int64, []int64, error) {
+	var (
+		heights []int64
+		offsets = []int64{0}
+		offset  int64
+	)
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// a record without newline is partially written
+			return heights, offsets, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		var record struct {
+			Number hexutil.Uint64 `json:"number"`
+		}
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, nil, err
+		}
+		offset += int64(len(line))
+		heights = append(heights, int64(record.Number))
+		offsets = append(offsets, offset)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"encoding/json"
+	"net"
+	"time"
+)
+
+// socketWriteTimeout is the timeout of the writes to the unix socket
+const socketWriteTimeout = 10 * time.Second
+
+var _ BlockSink = &SocketSink{}
+
+// SocketSink sends the block records as newline delimited JSON to a local unix socket, it connects
+// lazily and reconnects on the next write after an error.
+type SocketSink struct {
+	path string
+	conn net.Conn
+}
+
+// NewSocketSink creates the SocketSink sending to the unix socket at the path.
+func NewSocketSink(path string) *SocketSink {
+	return &SocketSink{path: path}
+}
+
+// Write sends the record to the socket.
+func (s *SocketSink) Write(record *BlockRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	if s.conn == nil {
+		conn, err := net.Dial("unix", s.path)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout)); err != nil {
+		_ = s.Close()
+		return err
+	}
+	if _, err := s.conn.Write(bz); err != nil {
+		// the record may be partially sent, the reader discards the incomplete line on disconnection
+		_ = s.Close()
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection.
+func (s *SocketSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
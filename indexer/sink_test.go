@@ -0,0 +1,206 @@
+package indexer_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestNewBlockRecord(t *testing.T) {
+	tb := newTestBlock(t)
+
+	record, err := indexer.NewBlockRecord(tb.clientCtx, tmlog.NewNopLogger(), tb.block, tb.blockResult)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(1), record.Number)
+	require.Len(t, record.Receipts, 1)
+
+	receipt := record.Receipts[0]
+	require.Equal(t, tb.txHash, receipt.TransactionHash)
+	require.Equal(t, tb.from, receipt.From)
+	require.Equal(t, tb.to, *receipt.To)
+	require.Nil(t, receipt.ContractAddress)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), receipt.Status)
+	require.Equal(t, hexutil.Uint64(21000), receipt.GasUsed)
+	require.Len(t, receipt.Logs, len(tb.logs))
+	for i, ethLog := range receipt.Logs {
+		require.Equal(t, tb.logs[i].Address, ethLog.Address)
+		require.Equal(t, tb.logs[i].Topics, ethLog.Topics)
+	}
+	require.True(t, receipt.LogsBloom.Test(tb.topic.Bytes()))
+	require.Zero(t, record.SkippedTxs)
+
+	// the eth txs without events are reported as skipped
+	result := *tb.blockResult[0]
+	result.Events = nil
+	record, err = indexer.NewBlockRecord(tb.clientCtx, tmlog.NewNopLogger(), tb.block, []*abci.ResponseDeliverTx{&result})
+	require.NoError(t, err)
+	require.Empty(t, record.Receipts)
+	require.Equal(t, hexutil.Uint64(1), record.SkippedTxs)
+}
+
+func TestBlockExporterNDJSON(t *testing.T) {
+	tb := newTestBlock(t)
+	dir := t.TempDir()
+	cursorPath := filepath.Join(dir, "evmsink.cursor")
+
+	newExporter := func(maxFileSize int64) *indexer.BlockExporter {
+		sink, err := indexer.NewNDJSONSink(filepath.Join(dir, "evmsink"), maxFileSize)
+		require.NoError(t, err)
+		return indexer.NewBlockExporter(sink, cursorPath, tb.clientCtx, tmlog.NewNopLogger())
+	}
+	blockAt := func(height int64) *tmtypes.Block {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tb.block.Data}
+	}
+
+	exporter := newExporter(1)
+	last, err := exporter.LastExportedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// every record rotates the files
+	for height := int64(1); height <= 2; height++ {
+		require.NoError(t, exporter.ExportBlock(blockAt(height), tb.blockResult))
+	}
+	require.NoError(t, exporter.Close())
+
+	// the cursor resumes after a restart, and the sink appends to the last file
+	exporter = newExporter(0)
+	last, err = exporter.LastExportedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+	for height := int64(3); height <= 4; height++ {
+		require.NoError(t, exporter.ExportBlock(blockAt(height), tb.blockResult))
+	}
+	require.NoError(t, exporter.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "evmsink", "*.ndjson"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Equal(t, []hexutil.Uint64{1, 2, 3, 4}, ndjsonHeights(t, filepath.Join(dir, "evmsink")))
+
+	// a block exported again, as the cursor wasn't saved before a crash, isn't duplicated, and the
+	// record partially written is dropped
+	f, err := os.OpenFile(files[len(files)-1], os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"number":"0x5"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	exporter = newExporter(0)
+	require.NoError(t, exporter.ExportBlock(blockAt(4), tb.blockResult))
+	require.NoError(t, exporter.ExportBlock(blockAt(5), tb.blockResult))
+	require.NoError(t, exporter.Close())
+	require.Equal(t, []hexutil.Uint64{1, 2, 3, 4, 5}, ndjsonHeights(t, filepath.Join(dir, "evmsink")))
+}
+
+func TestBlockExporterReplay(t *testing.T) {
+	tb := newTestBlock(t)
+	dir := t.TempDir()
+	sinkDir := filepath.Join(dir, "evmsink")
+	cursorPath := filepath.Join(dir, "evmsink.cursor")
+
+	newExporter := func() *indexer.BlockExporter {
+		sink, err := indexer.NewNDJSONSink(sinkDir, 0)
+		require.NoError(t, err)
+		return indexer.NewBlockExporter(sink, cursorPath, tb.clientCtx, tmlog.NewNopLogger())
+	}
+	blockAt := func(height int64) *tmtypes.Block {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tb.block.Data}
+	}
+
+	exporter := newExporter()
+	for height := int64(1); height <= 4; height++ {
+		require.NoError(t, exporter.ExportBlock(blockAt(height), tb.blockResult))
+	}
+	require.NoError(t, exporter.Close())
+
+	// the replay moves the cursor and replaces the records from its height
+	exporter = newExporter()
+	replay, err := exporter.StartReplay(2)
+	require.NoError(t, err)
+	require.True(t, replay)
+	last, err := exporter.LastExportedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+	require.NoError(t, exporter.ExportBlock(blockAt(2), tb.blockResult))
+	require.NoError(t, exporter.Close())
+	require.Equal(t, []hexutil.Uint64{1, 2}, ndjsonHeights(t, sinkDir))
+
+	// the same replay height isn't applied again after a restart
+	exporter = newExporter()
+	replay, err = exporter.StartReplay(2)
+	require.NoError(t, err)
+	require.False(t, replay)
+	last, err = exporter.LastExportedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+	require.NoError(t, exporter.ExportBlock(blockAt(3), tb.blockResult))
+	require.NoError(t, exporter.Close())
+	require.Equal(t, []hexutil.Uint64{1, 2, 3}, ndjsonHeights(t, sinkDir))
+}
+
+// ndjsonHeights returns the heights of the records of the ndjson files of the directory.
+func ndjsonHeights(t *testing.T, dir string) []hexutil.Uint64 {
+	files, err := filepath.Glob(filepath.Join(dir, "*.ndjson"))
+	require.NoError(t, err)
+
+	var heights []hexutil.Uint64
+	for _, file := range files {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var record indexer.BlockRecord
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			require.Len(t, record.Receipts, 1)
+			heights = append(heights, record.Number)
+		}
+		require.NoError(t, f.Close())
+	}
+	return heights
+}
+
+func TestSocketSink(t *testing.T) {
+	tb := newTestBlock(t)
+	path := filepath.Join(t.TempDir(), "evmsink.sock")
+	sink := indexer.NewSocketSink(path)
+	record, err := indexer.NewBlockRecord(tb.clientCtx, tmlog.NewNopLogger(), tb.block, tb.blockResult)
+	require.NoError(t, err)
+
+	// no reader listening
+	require.Error(t, sink.Write(record))
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan []byte)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		line, _ := reader.ReadBytes('\n')
+		received <- line
+	}()
+
+	require.NoError(t, sink.Write(record))
+	var decoded indexer.BlockRecord
+	require.NoError(t, json.Unmarshal(<-received, &decoded))
+	require.Equal(t, record.Hash, decoded.Hash)
+	require.Equal(t, tb.txHash, decoded.Receipts[0].TransactionHash)
+	require.NoError(t, sink.Close())
+}
@@ -0,0 +1,530 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// DriverSQLite is the database/sql driver name of the SQLite databases
+	DriverSQLite = "sqlite3"
+	// DriverPostgres is the database/sql driver name of the Postgres databases
+	DriverPostgres = "postgres"
+)
+
+// sqlSchema defines the relational schema of the indexer, a block row is only inserted once all
+// the eth txs of the block are indexed.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		block_time BIGINT NOT NULL,
+		eth_tx_count INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS txs (
+		hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		msg_index INTEGER NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		sender TEXT NOT NULL,
+		recipient TEXT,
+		nonce BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		UNIQUE (height, eth_tx_index)
+	)`,
+	`CREATE TABLE IF NOT EXISTS receipts (
+		tx_hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		failed BOOLEAN NOT NULL,
+		gas_used BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		contract_address TEXT,
+		logs_bloom TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS logs (
+		tx_hash TEXT NOT NULL,
+		log_index INTEGER NOT NULL,
+		height BIGINT NOT NULL,
+		block_hash TEXT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		topic0 TEXT,
+		topic1 TEXT,
+		topic2 TEXT,
+		topic3 TEXT,
+		data TEXT NOT NULL,
+		PRIMARY KEY (tx_hash, log_index)
+	)`,
+	`CREATE TABLE IF NOT EXISTS retained_height (
+		height BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS receipts_height ON receipts (height)`,
+	`CREATE INDEX IF NOT EXISTS logs_height ON logs (height, log_index)`,
+	`CREATE INDEX IF NOT EXISTS logs_address ON logs (address, height)`,
+	`CREATE INDEX IF NOT EXISTS logs_topic0 ON logs (topic0, height)`,
+}
+
+// maxLogTopics is the number of topics indexed per log, logs have at most 4 topics
+const maxLogTopics = 4
+
+// retainedTxs restricts the txs rows to the heights above the last pruning, the tx rows kept by
+// PruneBlocks are only served by hash.
+const retainedTxs = "t.height >= (SELECT COALESCE(MAX(height), 0) FROM retained_height)"
+
+var (
+	_ ethermint.EVMTxIndexer  = &SQLIndexer{}
+	_ ethermint.EVMLogIndexer = &SQLIndexer{}
+	_ ethermint.EVMTxPruner   = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on a relational database, storing the blocks, txs,
+// receipts and logs, so they can also be queried with SQL.
+type SQLIndexer struct {
+	db        *sql.DB
+	driver    string
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer creates the SQLIndexer on a database opened with the given driver, creating the
+// tables if they don't exist.
+func NewSQLIndexer(db *sql.DB, driver string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if driver != DriverSQLite && driver != DriverPostgres {
+		return nil, fmt.Errorf("unsupported sql driver %s, expect: %s|%s", driver, DriverSQLite, DriverPostgres)
+	}
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "create indexer schema")
+		}
+	}
+	return &SQLIndexer{db, driver, logger, clientCtx}, nil
+}
+
+// IndexBlock index all the eth txs in a block with their receipts and logs, replacing the rows
+// previously indexed at the same height, and inserts the block row if no eth tx is skipped.
+func (si *SQLIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+	txs, skipped := parseBlock(si.clientCtx, si.logger, block, txResults)
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	for _, table := range []string{"blocks", "txs", "receipts", "logs"} {
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE height = ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	for _, tx := range txs {
+		if err := si.insertTx(dbTx, tx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+
+	if skipped == 0 {
+		if _, err := dbTx.Exec(
+			si.rebind("INSERT INTO blocks (height, hash, block_time, eth_tx_count) VALUES (?, ?, ?, ?)"),
+			height, hexutil.Encode(block.Hash()), block.Time.Unix(), len(txs),
+		); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+		}
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// insertTx inserts the tx, receipt and logs rows of an eth tx.
+func (si *SQLIndexer) insertTx(dbTx *sql.Tx, tx indexedTx) error {
+	ethTx := tx.msg.AsTransaction()
+	if ethTx == nil {
+		return fmt.Errorf("invalid tx data of eth tx %s", tx.hash.Hex())
+	}
+	hash := tx.hash.Hex()
+	from := txSender(tx.msg, ethTx)
+
+	var (
+		logs            []*ethtypes.Log
+		to              sql.NullString
+		contractAddress sql.NullString
+	)
+	if ethTx.To() != nil {
+		to = sql.NullString{String: hexutil.Encode(ethTx.To().Bytes()), Valid: true}
+	} else {
+		contract := crypto.CreateAddress(from, ethTx.Nonce())
+		contractAddress = sql.NullString{String: hexutil.Encode(contract.Bytes()), Valid: true}
+	}
+	if !tx.result.Failed {
+		var err error
+		logs, err = txLogsFromEvents(tx.txResult.Events, int(tx.result.MsgIndex))
+		if err != nil {
+			return errorsmod.Wrapf(err, "parse logs of eth tx %s", hash)
+		}
+	}
+
+	// the hash may have been indexed at another height
+	for _, table := range []string{"txs", "receipts"} {
+		column := "tx_hash"
+		if table == "txs" {
+			column = "hash"
+		}
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE "+column+" = ?"), hash); err != nil {
+			return errorsmod.Wrapf(err, "delete %s", table)
+		}
+	}
+	if _, err := dbTx.Exec(si.rebind("DELETE FROM logs WHERE tx_hash = ?"), hash); err != nil {
+		return errorsmod.Wrap(err, "delete logs")
+	}
+
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO txs (hash, height, tx_index, msg_index, eth_tx_index, sender, recipient, nonce, value, gas_limit)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		hash, tx.result.Height, tx.result.TxIndex, tx.result.MsgIndex, tx.result.EthTxIndex,
+		hexutil.Encode(from.Bytes()), to, int64(ethTx.Nonce()), ethTx.Value().String(), int64(ethTx.Gas()),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert tx")
+	}
+
+	bloom := ethtypes.BytesToBloom(ethtypes.LogsBloom(logs))
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO receipts (tx_hash, height, failed, gas_used, cumulative_gas_used, contract_address, logs_bloom)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		hash, tx.result.Height, tx.result.Failed, int64(tx.result.GasUsed), int64(tx.result.CumulativeGasUsed),
+		contractAddress, hexutil.Encode(bloom.Bytes()),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+
+	for _, ethLog := range logs {
+		topics := make([]sql.NullString, maxLogTopics)
+		for i, topic := range ethLog.Topics {
+			if i < maxLogTopics {
+				topics[i] = sql.NullString{String: topic.Hex(), Valid: true}
+			}
+		}
+		if _, err := dbTx.Exec(
+			si.rebind(`INSERT INTO logs (tx_hash, log_index, height, block_hash, tx_index, address, topic0, topic1, topic2, topic3, data)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+			hash, ethLog.Index, tx.result.Height, ethLog.BlockHash.Hex(), tx.result.EthTxIndex, hexutil.Encode(ethLog.Address.Bytes()),
+			topics[0], topics[1], topics[2], topics[3], hexutil.Encode(ethLog.Data),
+		); err != nil {
+			return errorsmod.Wrap(err, "insert log")
+		}
+	}
+	return nil
+}
+
+// PruneBlocks deletes the rows of the blocks below the height, keeping the tx and receipt rows if
+// keepTxHash, so the eth txs can still be queried by hash. The height is recorded as the retained
+// height, below which the kept rows are no longer indexed by block.
+func (si *SQLIndexer) PruneBlocks(height int64, keepTxHash bool) error {
+	tables := []string{"blocks", "logs"}
+	if !keepTxHash {
+		tables = append(tables, "txs", "receipts")
+	}
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, begin", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	for _, table := range tables {
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE height < ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "PruneBlocks %d, delete %s", height, table)
+		}
+	}
+
+	var retained sql.NullInt64
+	if err := dbTx.QueryRow("SELECT MAX(height) FROM retained_height").Scan(&retained); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, load retained height", height)
+	}
+	if !retained.Valid || retained.Int64 < height {
+		if _, err := dbTx.Exec("DELETE FROM retained_height"); err != nil {
+			return errorsmod.Wrapf(err, "PruneBlocks %d, delete retained height", height)
+		}
+		if _, err := dbTx.Exec(si.rebind("INSERT INTO retained_height (height) VALUES (?)"), height); err != nil {
+			return errorsmod.Wrapf(err, "PruneBlocks %d, insert retained height", height)
+		}
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d, commit", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.loadHeight("SELECT MAX(t.height) FROM txs t WHERE " + retainedTxs)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.loadHeight("SELECT MIN(t.height) FROM txs t WHERE " + retainedTxs)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error) {
+	txResult, err := si.loadTxResult("t.hash = ?", hash.Hex())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if txResult == nil {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	return txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	txResult, err := si.loadTxResult("t.height = ? AND t.eth_tx_index = ? AND "+retainedTxs, blockNumber, txIndex)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if txResult == nil {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return txResult, nil
+}
+
+// GetLogs returns the logs of the block range matching the addresses and topics filter criteria.
+func (si *SQLIndexer) GetLogs(
+	from, to int64,
+	fromIndex uint,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	var indexed int64
+	if err := si.db.QueryRow(
+		si.rebind("SELECT COUNT(*) FROM blocks WHERE height >= ? AND height <= ?"), from, to,
+	).Scan(&indexed); err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs, count blocks")
+	}
+	if indexed < to-from+1 {
+		return nil, ethermint.ErrLogsNotIndexed
+	}
+
+	logs := []*ethtypes.Log{}
+	if len(topics) > maxLogTopics {
+		// no log has more topics
+		return logs, nil
+	}
+
+	query := `SELECT tx_hash, log_index, height, block_hash, tx_index, address, topic0, topic1, topic2, topic3, data
+		FROM logs WHERE height >= ? AND height <= ?`
+	args := []interface{}{from, to}
+	if fromIndex > 0 {
+		query += " AND (height > ? OR log_index >= ?)"
+		args = append(args, from, fromIndex)
+	}
+	if len(addresses) > 0 {
+		values := make([]string, len(addresses))
+		for i, address := range addresses {
+			values[i] = hexutil.Encode(address.Bytes())
+		}
+		query += " AND address IN (" + placeholders(len(values)) + ")"
+		args = appendStrings(args, values)
+	}
+	for i, alternatives := range topics {
+		// empty alternatives match any topic
+		if len(alternatives) == 0 {
+			continue
+		}
+		values := make([]string, len(alternatives))
+		for j, topic := range alternatives {
+			values[j] = topic.Hex()
+		}
+		query += fmt.Sprintf(" AND topic%d IN (%s)", i, placeholders(len(values)))
+		args = appendStrings(args, values)
+	}
+	query += " ORDER BY height, log_index"
+	if limit > 0 {
+		query += " LIMIT " + strconv.Itoa(limit)
+	}
+
+	rows, err := si.db.Query(si.rebind(query), args...)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			txHash, blockHash, address, data string
+			logIndex, txIndex                uint
+			height                           uint64
+			topicColumns                     [maxLogTopics]sql.NullString
+		)
+		if err := rows.Scan(
+			&txHash, &logIndex, &height, &blockHash, &txIndex, &address,
+			&topicColumns[0], &topicColumns[1], &topicColumns[2], &topicColumns[3], &data,
+		); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs, scan log")
+		}
+		bz, err := hexutil.Decode(data)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs, decode data")
+		}
+		ethLog := &ethtypes.Log{
+			Address:     common.HexToAddress(address),
+			Topics:      []common.Hash{},
+			Data:        bz,
+			BlockNumber: height,
+			TxHash:      common.HexToHash(txHash),
+			TxIndex:     txIndex,
+			BlockHash:   common.HexToHash(blockHash),
+			Index:       logIndex,
+		}
+		for _, topic := range topicColumns {
+			if topic.Valid {
+				ethLog.Topics = append(ethLog.Topics, common.HexToHash(topic.String))
+			}
+		}
+		logs = append(logs, ethLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	return logs, nil
+}
+
+// loadTxResult loads the tx result of the eth tx matching the condition, returns nil if not found
+func (si *SQLIndexer) loadTxResult(cond string, args ...interface{}) (*ethermint.TxResult, error) {
+	var (
+		txResult                   ethermint.TxResult
+		gasUsed, cumulativeGasUsed int64
+	)
+	err := si.db.QueryRow(
+		si.rebind(`SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.failed, r.gas_used, r.cumulative_gas_used
+			FROM txs t JOIN receipts r ON r.tx_hash = t.hash WHERE `+cond),
+		args...,
+	).Scan(
+		&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex,
+		&txResult.Failed, &gasUsed, &cumulativeGasUsed,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	txResult.GasUsed = uint64(gasUsed)
+	txResult.CumulativeGasUsed = uint64(cumulativeGasUsed)
+	return &txResult, nil
+}
+
+// loadHeight runs the query of a block height, returns -1 if the result is null
+func (si *SQLIndexer) loadHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "loadHeight")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// rebind replaces the `?` placeholders of the query with the `$n` ones for postgres
+func (si *SQLIndexer) rebind(query string) string {
+	if si.driver != DriverPostgres {
+		return query
+	}
+	var (
+		sb strings.Builder
+		n  int
+	)
+	for _, c := range query {
+		if c != '?' {
+			sb.WriteRune(c)
+			continue
+		}
+		n++
+		sb.WriteString("$" + strconv.Itoa(n))
+	}
+	return sb.String()
+}
+
+// placeholders returns n comma separated `?` placeholders
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func appendStrings(args []interface{}, values []string) []interface{} {
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
+
+// txLogsFromEvents parses the logs of the eth tx at the msg index from the tx events
+func txLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+
+		logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var log evmtypes.Log
+			if err := json.Unmarshal(attr.Value, &log); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &log)
+		}
+		return evmtypes.LogsToEthereum(logs), nil
+	}
+	// eth txs without logs don't emit the event
+	return nil, nil
+}
@@ -0,0 +1,187 @@
+package indexer_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// testBlock is a block with an eth tx emitting two logs
+type testBlock struct {
+	clientCtx   client.Context
+	block       *tmtypes.Block
+	blockResult []*abci.ResponseDeliverTx
+	txHash      common.Hash
+	from, to    common.Address
+	topic       common.Hash
+	logs        []*ethtypes.Log
+}
+
+func newTestBlock(t *testing.T) testBlock {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	topic := common.BytesToHash([]byte("topic"))
+	ethLogs := []*ethtypes.Log{
+		{Address: to, Topics: []common.Hash{topic}, Data: []byte{1}, BlockNumber: 1, TxHash: txHash, Index: 0},
+		{Address: from, Topics: []common.Hash{}, Data: []byte{}, BlockNumber: 1, TxHash: txHash, Index: 1},
+	}
+	logAttrs := make([]abci.EventAttribute, len(ethLogs))
+	for i, ethLog := range ethLogs {
+		bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+		require.NoError(t, err)
+		logAttrs[i] = abci.EventAttribute{Key: []byte(types.AttributeKeyTxLog), Value: bz}
+	}
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+					{Key: []byte("txIndex"), Value: []byte("0")},
+					{Key: []byte("amount"), Value: []byte("1000")},
+					{Key: []byte("txGasUsed"), Value: []byte("21000")},
+					{Key: []byte("txHash"), Value: []byte("")},
+					{Key: []byte("recipient"), Value: []byte(to.Hex())},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: logAttrs},
+			},
+		},
+	}
+
+	return testBlock{clientCtx, block, blockResult, txHash, from, to, topic, ethLogs}
+}
+
+func TestSQLIndexerGetLogs(t *testing.T) {
+	tb := newTestBlock(t)
+	clientCtx, block, blockResult, txHash := tb.clientCtx, tb.block, tb.blockResult, tb.txHash
+	from, to, topic, ethLogs := tb.from, tb.to, tb.topic, tb.logs
+
+	sqlDB, err := sql.Open(indexer.DriverSQLite, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	idxer, err := indexer.NewSQLIndexer(sqlDB, indexer.DriverSQLite, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	_, err = idxer.GetLogs(1, 1, 0, nil, nil, 0)
+	require.ErrorIs(t, err, ethermint.ErrLogsNotIndexed)
+
+	// indexing is idempotent
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+
+	testCases := []struct {
+		name      string
+		fromIndex uint
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []*ethtypes.Log
+	}{
+		{"all logs", 0, nil, nil, 0, ethLogs},
+		{"limit", 0, nil, nil, 1, ethLogs[:1]},
+		{"from index", 1, nil, nil, 0, ethLogs[1:]},
+		{"address", 0, []common.Address{from}, nil, 0, ethLogs[1:]},
+		{"topic", 0, nil, [][]common.Hash{{topic}}, 0, ethLogs[:1]},
+		{"wildcard topic", 0, []common.Address{to}, [][]common.Hash{{}}, 0, ethLogs[:1]},
+		{"no match", 0, []common.Address{from}, [][]common.Hash{{topic}}, 0, []*ethtypes.Log{}},
+		{"too many topics", 0, nil, make([][]common.Hash, 5), 0, []*ethtypes.Log{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(1, 1, tc.fromIndex, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, logs)
+		})
+	}
+
+	_, err = idxer.GetLogs(1, 2, 0, nil, nil, 0)
+	require.ErrorIs(t, err, ethermint.ErrLogsNotIndexed)
+
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), res.GasUsed)
+}
+
+func TestSQLIndexerPruneBlocks(t *testing.T) {
+	tb1, tb2 := newTestBlock(t), newTestBlock(t)
+	block2 := &tmtypes.Block{Header: tmtypes.Header{Height: 2}, Data: tb2.block.Data}
+
+	for _, keepTxHash := range []bool{false, true} {
+		sqlDB, err := sql.Open(indexer.DriverSQLite, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+		require.NoError(t, err)
+		defer sqlDB.Close()
+		idxer, err := indexer.NewSQLIndexer(sqlDB, indexer.DriverSQLite, tmlog.NewNopLogger(), tb1.clientCtx)
+		require.NoError(t, err)
+		require.NoError(t, idxer.IndexBlock(tb1.block, tb1.blockResult))
+		require.NoError(t, idxer.IndexBlock(block2, tb2.blockResult))
+
+		require.NoError(t, idxer.PruneBlocks(2, keepTxHash))
+
+		_, err = idxer.GetLogs(1, 1, 0, nil, nil, 0)
+		require.ErrorIs(t, err, ethermint.ErrLogsNotIndexed)
+		_, err = idxer.GetByTxHash(tb1.txHash)
+		require.Equal(t, keepTxHash, err == nil)
+		// the kept tx rows are not indexed by block
+		_, err = idxer.GetByBlockAndIndex(1, 0)
+		require.Error(t, err)
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), first)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), last)
+
+		// a lower pruning height doesn't expose the kept rows again
+		require.NoError(t, idxer.PruneBlocks(1, keepTxHash))
+		first, err = idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), first)
+
+		// the retained blocks are untouched
+		logs, err := idxer.GetLogs(2, 2, 0, nil, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, logs, len(tb2.logs))
+		txResult, err := idxer.GetByTxHash(tb2.txHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), txResult.Height)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/namespaces/b2"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
@@ -32,6 +33,7 @@ import (
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/web3"
+	ethermintapi "github.com/evmos/ethermint/rpc/namespaces/ethermint"
 	ethermint "github.com/evmos/ethermint/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
@@ -53,6 +55,14 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Rollup namespaces
+
+	B2Namespace = "b2"
+
+	// Ethermint namespaces
+
+	EthermintNamespace = "ethermint"
+
 	apiVersion = "1.0"
 )
 
@@ -170,6 +180,32 @@ func init() {
 				},
 			}
 		},
+		B2Namespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: B2Namespace,
+					Version:   apiVersion,
+					Service:   b2.NewPublicAPI(ctx.Logger, clientCtx),
+					Public:    true,
+				},
+			}
+		},
+		EthermintNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EthermintNamespace,
+					Version:   apiVersion,
+					Service:   ethermintapi.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -27,6 +27,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
@@ -153,6 +154,162 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// StorageRangeAt returns up to maxResult storage entries of the given account,
+// starting from keyStart, at the state before the transaction at txIndex in the
+// given block. Intermediate states can't be queried, so txIndex must either be
+// 0 (the state at the end of the parent block) or at least the number of
+// Ethereum transactions in the block (the state at the end of the block).
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("invalid max result %d", maxResult)
+	}
+
+	resBlock, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if resBlock == nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block not found for hash %s", blockHash.Hex())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	height := resBlock.Block.Height
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	switch {
+	case txIndex >= len(msgs):
+		// state at the end of the block
+	case txIndex == 0:
+		height--
+	default:
+		return rpctypes.StorageRangeResult{}, fmt.Errorf(
+			"storage at transaction index %d is not available, only the block start (0) or end (%d) can be queried",
+			txIndex, len(msgs),
+		)
+	}
+
+	if height < 1 {
+		return rpctypes.StorageRangeResult{}, errors.New("genesis state is not available")
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address:  address.String(),
+		StartKey: common.BytesToHash(keyStart).Hex(),
+		Limit:    uint64(maxResult),
+	}
+
+	res, err := b.queryClient.StorageRange(rpctypes.ContextWithHeight(height), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[key] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// AccountRange returns up to maxResults EVM accounts, ordered by address and
+// starting from the start address, at the given block. Ethermint doesn't keep
+// an EVM state trie, so the dump root is left empty and start is an address
+// rather than a hashed trie key.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage bool,
+) (state.IteratorDump, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	if maxResults <= 0 || maxResults > AccountRangeMaxResults {
+		maxResults = AccountRangeMaxResults
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Limit:     uint64(maxResults),
+		NoCode:    nocode,
+		NoStorage: nostorage,
+	}
+	if len(start) > 0 {
+		req.Start = common.BytesToAddress(start).Hex()
+	}
+
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	dump := state.IteratorDump{
+		Accounts: make(map[common.Address]state.DumpAccount, len(res.Accounts)),
+	}
+	for _, account := range res.Accounts {
+		dump.Accounts[common.HexToAddress(account.Address)] = toDumpAccount(account)
+	}
+	if res.Next != "" {
+		dump.Next = common.HexToAddress(res.Next).Bytes()
+	}
+
+	return dump, nil
+}
+
+// DumpBlock returns the full EVM state, including code and storage, at the
+// given block.
+func (b *Backend) DumpBlock(blockNum rpctypes.BlockNumber) (state.Dump, error) {
+	// pin the height so that every page is read from the same state
+	if blockNum < rpctypes.EthEarliestBlockNumber {
+		n, err := b.BlockNumber()
+		if err != nil {
+			return state.Dump{}, err
+		}
+		blockNum = rpctypes.NewBlockNumber(new(big.Int).SetUint64(uint64(n)))
+	}
+
+	dump := state.Dump{
+		Accounts: make(map[common.Address]state.DumpAccount),
+	}
+
+	var start hexutil.Bytes
+	for {
+		page, err := b.AccountRange(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, start, AccountRangeMaxResults, false, false)
+		if err != nil {
+			return state.Dump{}, err
+		}
+
+		for address, account := range page.Accounts {
+			dump.Accounts[address] = account
+		}
+
+		if page.Next == nil {
+			return dump, nil
+		}
+		start = page.Next
+	}
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -8,6 +8,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 	"google.golang.org/grpc/metadata"
 
@@ -243,6 +245,152 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+	nextKey := common.BigToHash(big.NewInt(3))
+
+	testCases := []struct {
+		name         string
+		addr         common.Address
+		txIndex      int
+		registerMock func(common.Address)
+		expPass      bool
+		expResult    rpctypes.StorageRangeResult
+	}{
+		{
+			"fail - block not found",
+			tests.GenerateAddress(),
+			0,
+			func(addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashNotFound(client, common.Hash{}, nil)
+			},
+			false,
+			rpctypes.StorageRangeResult{},
+		},
+		{
+			"fail - query client errors on getting StorageRange",
+			tests.GenerateAddress(),
+			0,
+			func(addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+				RegisterStorageRangeError(queryClient, 1)
+			},
+			false,
+			rpctypes.StorageRangeResult{},
+		},
+		{
+			"pass",
+			tests.GenerateAddress(),
+			0,
+			func(addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+				RegisterStorageRange(queryClient, 1, addr, evmtypes.Storage{evmtypes.NewState(key, value)}, nextKey.Hex())
+			},
+			true,
+			rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{key: {Key: &key, Value: value}},
+				NextKey: &nextKey,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock(tc.addr)
+
+			res, err := suite.backend.StorageRangeAt(common.Hash{}, tc.txIndex, tc.addr, nil, 1)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestDumpBlock() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	first := common.BigToAddress(big.NewInt(1))
+	second := common.BigToAddress(big.NewInt(2))
+	key := common.BigToHash(big.NewInt(1))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+		expDump      state.Dump
+	}{
+		{
+			"fail - query client errors on getting AccountRange",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRangeError(queryClient, 1)
+			},
+			false,
+			state.Dump{},
+		},
+		{
+			"pass - multiple pages",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRange(queryClient, 1, "", []evmtypes.AccountDump{
+					{Address: first.Hex(), Balance: "1", Nonce: 1, CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash).Hex()},
+				}, second.Hex())
+				RegisterAccountRange(queryClient, 1, second.Hex(), []evmtypes.AccountDump{
+					{
+						Address:  second.Hex(),
+						Balance:  "0",
+						CodeHash: crypto.Keccak256Hash([]byte("code")).Hex(),
+						Code:     []byte("code"),
+						Storage:  evmtypes.Storage{evmtypes.NewState(key, key)},
+					},
+				}, "")
+			},
+			true,
+			state.Dump{
+				Accounts: map[common.Address]state.DumpAccount{
+					first: {
+						Balance:  "1",
+						Nonce:    1,
+						CodeHash: evmtypes.EmptyCodeHash,
+						Address:  &first,
+					},
+					second: {
+						Balance:  "0",
+						CodeHash: crypto.Keccak256([]byte("code")),
+						Code:     []byte("code"),
+						Storage:  map[common.Hash]string{key: key.Hex()},
+						Address:  &second,
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			dump, err := suite.backend.DumpBlock(blockNr)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDump, dump)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
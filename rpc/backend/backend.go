@@ -26,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -64,6 +65,7 @@ type EVMBackend interface {
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
+	ListAccountsMetadata(args rpctypes.BTCAddressArgs) ([]rpctypes.AccountMetadata, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
@@ -99,6 +101,9 @@ type EVMBackend interface {
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (state.IteratorDump, error)
+	DumpBlock(blockNum rpctypes.BlockNumber) (state.Dump, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
 
@@ -133,6 +138,7 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(from, to int64, fromIndex uint, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
@@ -140,6 +146,9 @@ type EVMBackend interface {
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 }
 
+// AccountRangeMaxResults is the maximum number of accounts returned per AccountRange call.
+const AccountRangeMaxResults = 256
+
 var _ BackendI = (*Backend)(nil)
 
 var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
@@ -154,6 +163,7 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
+	cache               responseCache
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -183,5 +193,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               newResponseCache(appConf.JSONRPC),
 	}
 }
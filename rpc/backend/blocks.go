@@ -74,18 +74,31 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, nil
 	}
 
-	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	height := resBlock.Block.Height
+	if blockNum.Int64() <= 0 {
+		// the latest block can be formatted before the state at its height is queryable,
+		// so it is always formatted again and not cached.
+		b.cache.removeRPCBlock(height, fullTx)
+	} else if res, ok := b.cache.getRPCBlock(height, fullTx); ok {
+		return res, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
 	if err != nil {
 		b.logger.Debug("failed to fetch block result from Tendermint", "height", blockNum, "error", err.Error())
 		return nil, nil
 	}
 
-	res, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, fullTx)
+	res, complete, err := b.rpcBlockFromTendermintBlock(resBlock, blockRes, fullTx)
 	if err != nil {
 		b.logger.Debug("GetEthBlockFromTendermint failed", "height", blockNum, "error", err.Error())
 		return nil, err
 	}
 
+	if blockNum.Int64() > 0 && complete {
+		b.cacheRPCBlock(height, fullTx, res)
+	}
+
 	return res, nil
 }
 
@@ -102,21 +115,40 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, nil
 	}
 
-	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	height := resBlock.Block.Height
+	if res, ok := b.cache.getRPCBlock(height, fullTx); ok {
+		return res, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
 	if err != nil {
 		b.logger.Debug("failed to fetch block result from Tendermint", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
 	}
 
-	res, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, fullTx)
+	res, complete, err := b.rpcBlockFromTendermintBlock(resBlock, blockRes, fullTx)
 	if err != nil {
 		b.logger.Debug("GetEthBlockFromTendermint failed", "hash", hash, "error", err.Error())
 		return nil, err
 	}
 
+	if complete {
+		b.cacheRPCBlock(height, fullTx, res)
+	}
 	return res, nil
 }
 
+// cacheRPCBlock caches a formatted block once the abci app state has moved past its
+// height. The latest block can be formatted before the state at its height is
+// queryable, so it is not cached.
+func (b *Backend) cacheRPCBlock(height int64, fullTx bool, block map[string]interface{}) {
+	latest, err := b.BlockNumber()
+	if err != nil || height >= int64(latest) {
+		return
+	}
+	b.cache.addRPCBlock(height, fullTx, block)
+}
+
 // GetBlockTransactionCountByHash returns the number of Ethereum transactions in
 // the block identified by hash.
 func (b *Backend) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
@@ -176,6 +208,10 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		}
 		height = int64(n)
 	}
+	if resBlock, ok := b.cache.getBlock(height); ok {
+		return resBlock, nil
+	}
+
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &height)
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "height", height, "error", err.Error())
@@ -187,17 +223,37 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
 // TendermintBlockResultByNumber returns a Tendermint-formatted block result
 // by block number
 func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
-	return b.clientCtx.Client.BlockResults(b.ctx, height)
+	if height == nil {
+		// the latest block result is not cached
+		return b.clientCtx.Client.BlockResults(b.ctx, height)
+	}
+
+	if blockRes, ok := b.cache.getBlockResult(*height); ok {
+		return blockRes, nil
+	}
+
+	blockRes, err := b.clientCtx.Client.BlockResults(b.ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.addBlockResult(*height, blockRes)
+	return blockRes, nil
 }
 
 // TendermintBlockByHash returns a Tendermint-formatted block by block number
 func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	if resBlock, ok := b.cache.getBlockByHash(blockHash); ok {
+		return resBlock, nil
+	}
+
 	resBlock, err := b.clientCtx.Client.BlockByHash(b.ctx, blockHash.Bytes())
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -209,6 +265,7 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -243,8 +300,8 @@ func (b *Backend) BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.I
 }
 
 // EthMsgsFromTendermintBlock returns all real MsgEthereumTxs from a
-// Tendermint block. It also ensures consistency over the correct txs indexes
-// across RPC endpoints
+// Tendermint block, along with the executed MsgEthereumCalls as unsigned msgs.
+// It also ensures consistency over the correct txs indexes across RPC endpoints
 func (b *Backend) EthMsgsFromTendermintBlock(
 	resBlock *tmrpctypes.ResultBlock,
 	blockRes *tmrpctypes.ResultBlockResults,
@@ -269,15 +326,12 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 			continue
 		}
 
-		for _, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				continue
-			}
-
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
-			result = append(result, ethMsg)
+		ethMsgs, err := rpctypes.EthMsgsFromTx(tx, txResults[i])
+		if err != nil {
+			b.logger.Debug("failed to parse eth msgs in block", "height", block.Height, "error", err.Error())
+			continue
 		}
+		result = append(result, ethMsgs...)
 	}
 
 	return result
@@ -367,13 +421,27 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	blockRes *tmrpctypes.ResultBlockResults,
 	fullTx bool,
 ) (map[string]interface{}, error) {
+	res, _, err := b.rpcBlockFromTendermintBlock(resBlock, blockRes, fullTx)
+	return res, err
+}
+
+// rpcBlockFromTendermintBlock formats the block like RPCBlockFromTendermintBlock and
+// reports if every field could be queried. The errors are tolerated for pruned
+// nodes, but the incomplete block must not be cached.
+func (b *Backend) rpcBlockFromTendermintBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	fullTx bool,
+) (map[string]interface{}, bool, error) {
 	ethRPCTxs := []interface{}{}
 	block := resBlock.Block
+	complete := true
 
 	baseFee, err := b.BaseFee(blockRes)
 	if err != nil {
 		// handle the error for pruned node.
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", block.Height, "error", err)
+		complete = false
 	}
 
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
@@ -384,9 +452,8 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			uint64(block.Height),
 			uint64(txIndex),
@@ -394,7 +461,8 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
+			complete = false
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -403,6 +471,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	bloom, err := b.BlockBloom(blockRes)
 	if err != nil {
 		b.logger.Debug("failed to query BlockBloom", "height", block.Height, "error", err.Error())
+		complete = false
 	}
 
 	req := &evmtypes.QueryValidatorAccountRequest{
@@ -422,10 +491,11 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		)
 		// use zero address as the validator operator address
 		validatorAccAddr = sdk.AccAddress(common.Address{}.Bytes())
+		complete = false
 	} else {
 		validatorAccAddr, err = sdk.AccAddressFromBech32(res.AccountAddress)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	}
 
@@ -434,6 +504,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.clientCtx, block.Height)
 	if err != nil {
 		b.logger.Error("failed to query consensus params", "error", err.Error())
+		complete = false
 	}
 
 	gasUsed := uint64(0)
@@ -452,7 +523,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
-	return formattedBlock, nil
+	return formattedBlock, complete, nil
 }
 
 // EthBlockByNumber returns the Ethereum Block identified by number.
@@ -0,0 +1,170 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// cacheMetricsPrefix is the prefix of the hit and miss meters of the JSON-RPC caches.
+const cacheMetricsPrefix = "rpc/cache/"
+
+// meteredCache is a LRU cache recording its hits and misses, a nil cache is disabled.
+type meteredCache struct {
+	lru  *lru.Cache
+	hit  metrics.Meter
+	miss metrics.Meter
+}
+
+// newMeteredCache returns a cache holding up to size entries, or nil if size is not positive.
+func newMeteredCache(name string, size int) *meteredCache {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		// only returned on a non-positive size
+		panic(err)
+	}
+
+	return &meteredCache{
+		lru:  cache,
+		hit:  metrics.GetOrRegisterMeter(cacheMetricsPrefix+name+"/hit", nil),
+		miss: metrics.GetOrRegisterMeter(cacheMetricsPrefix+name+"/miss", nil),
+	}
+}
+
+func (c *meteredCache) get(key interface{}) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	value, ok := c.lru.Get(key)
+	if ok {
+		c.hit.Mark(1)
+	} else {
+		c.miss.Mark(1)
+	}
+	return value, ok
+}
+
+func (c *meteredCache) add(key, value interface{}) {
+	if c != nil {
+		c.lru.Add(key, value)
+	}
+}
+
+func (c *meteredCache) remove(key interface{}) {
+	if c != nil {
+		c.lru.Remove(key)
+	}
+}
+
+// rpcBlockKey is the key of the formatted RPC blocks, which depend on the full transactions flag.
+type rpcBlockKey struct {
+	height int64
+	fullTx bool
+}
+
+// responseCache caches the historical data served by the JSON-RPC. The Tendermint blocks are final
+// once committed, so the entries are keyed by height or hash and never expire, only the formatted
+// blocks requested as "latest" or "pending" are invalidated. The zero value is a disabled cache.
+type responseCache struct {
+	blocks       *meteredCache // height -> *tmrpctypes.ResultBlock
+	blockHashes  *meteredCache // common.Hash -> height
+	blockResults *meteredCache // height -> *tmrpctypes.ResultBlockResults
+	rpcBlocks    *meteredCache // rpcBlockKey -> map[string]interface{}
+	receipts     *meteredCache // common.Hash -> map[string]interface{}
+}
+
+// newResponseCache returns the JSON-RPC caches with the sizes of the given configuration.
+func newResponseCache(cfg config.JSONRPCConfig) responseCache {
+	return responseCache{
+		blocks:       newMeteredCache("blocks", cfg.BlockCacheSize),
+		blockHashes:  newMeteredCache("blockhashes", cfg.BlockCacheSize),
+		blockResults: newMeteredCache("blockresults", cfg.BlockCacheSize),
+		rpcBlocks:    newMeteredCache("rpcblocks", cfg.BlockCacheSize),
+		receipts:     newMeteredCache("receipts", cfg.ReceiptCacheSize),
+	}
+}
+
+func (c responseCache) getBlock(height int64) (*tmrpctypes.ResultBlock, bool) {
+	value, ok := c.blocks.get(height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*tmrpctypes.ResultBlock), true
+}
+
+func (c responseCache) getBlockByHash(hash common.Hash) (*tmrpctypes.ResultBlock, bool) {
+	height, ok := c.blockHashes.get(hash)
+	if !ok {
+		return nil, false
+	}
+	return c.getBlock(height.(int64))
+}
+
+func (c responseCache) addBlock(resBlock *tmrpctypes.ResultBlock) {
+	height := resBlock.Block.Height
+	c.blocks.add(height, resBlock)
+	c.blockHashes.add(common.BytesToHash(resBlock.Block.Hash()), height)
+}
+
+func (c responseCache) getBlockResult(height int64) (*tmrpctypes.ResultBlockResults, bool) {
+	value, ok := c.blockResults.get(height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*tmrpctypes.ResultBlockResults), true
+}
+
+func (c responseCache) addBlockResult(height int64, blockRes *tmrpctypes.ResultBlockResults) {
+	c.blockResults.add(height, blockRes)
+}
+
+func (c responseCache) getRPCBlock(height int64, fullTx bool) (map[string]interface{}, bool) {
+	value, ok := c.rpcBlocks.get(rpcBlockKey{height, fullTx})
+	if !ok {
+		return nil, false
+	}
+	return value.(map[string]interface{}), true
+}
+
+func (c responseCache) addRPCBlock(height int64, fullTx bool, block map[string]interface{}) {
+	c.rpcBlocks.add(rpcBlockKey{height, fullTx}, block)
+}
+
+func (c responseCache) removeRPCBlock(height int64, fullTx bool) {
+	c.rpcBlocks.remove(rpcBlockKey{height, fullTx})
+}
+
+func (c responseCache) getReceipt(hash common.Hash) (map[string]interface{}, bool) {
+	value, ok := c.receipts.get(hash)
+	if !ok {
+		return nil, false
+	}
+	return value.(map[string]interface{}), true
+}
+
+func (c responseCache) addReceipt(hash common.Hash, receipt map[string]interface{}) {
+	c.receipts.add(hash, receipt)
+}
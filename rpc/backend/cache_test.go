@@ -0,0 +1,96 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	ethrpc "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tests"
+)
+
+func (suite *BackendTestSuite) TestResponseCache() {
+	suite.SetupTest()
+	suite.backend.cache = newResponseCache(config.JSONRPCConfig{BlockCacheSize: 8, ReceiptCacheSize: 8})
+
+	height := int64(1)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	resBlock, _ := RegisterBlock(client, height, nil)
+	RegisterBlockResultsWithBloom(client, height)
+	RegisterConsensusParams(client, height)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+
+	// the block at the latest app height is not cached
+	var header metadata.MD
+	RegisterParams(queryClient, &header, height)
+	block, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), false)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+	_, ok := suite.backend.cache.getRPCBlock(height, false)
+	suite.Require().False(ok)
+
+	suite.backend.ctx = ethrpc.ContextWithHeight(height + 1)
+	RegisterParams(queryClient, &header, height+1)
+	block, err = suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), false)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+
+	// served from the cache without querying the node again
+	cached, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cached)
+	client.AssertNumberOfCalls(suite.T(), "Block", 1)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+	queryClient.AssertNumberOfCalls(suite.T(), "ValidatorAccount", 2)
+
+	// the blocks are also cached by hash
+	hash := common.BytesToHash(resBlock.Block.Hash())
+	byHash, err := suite.backend.TendermintBlockByHash(hash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(resBlock, byHash)
+	client.AssertNotCalled(suite.T(), "BlockByHash")
+
+	// the formatted blocks depend on the full transactions flag
+	_, ok = suite.backend.cache.getRPCBlock(height, true)
+	suite.Require().False(ok)
+
+	suite.backend.cache.removeRPCBlock(height, false)
+	_, ok = suite.backend.cache.getRPCBlock(height, false)
+	suite.Require().False(ok)
+}
+
+func (suite *BackendTestSuite) TestResponseCacheIncompleteBlock() {
+	suite.SetupTest()
+	suite.backend.cache = newResponseCache(config.JSONRPCConfig{BlockCacheSize: 8, ReceiptCacheSize: 8})
+
+	height := int64(1)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterBlock(client, height, nil)
+	RegisterBlockResults(client, height)
+	RegisterConsensusParams(client, height)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFeeError(queryClient)
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+
+	// the base fee and the bloom are missing, the block is formatted but not cached
+	// without querying the app height
+	block, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), false)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+	_, ok := suite.backend.cache.getRPCBlock(height, false)
+	suite.Require().False(ok)
+}
+
+func (suite *BackendTestSuite) TestResponseCacheDisabled() {
+	for _, cache := range []responseCache{{}, newResponseCache(config.JSONRPCConfig{})} {
+		cache.addRPCBlock(1, false, map[string]interface{}{})
+		_, ok := cache.getRPCBlock(1, false)
+		suite.Require().False(ok)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpc "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -210,6 +211,24 @@ func RegisterBlockResults(
 	return res, nil
 }
 
+func RegisterBlockResultsWithBloom(client *mocks.Client, height int64) (*tmrpctypes.ResultBlockResults, error) {
+	res := &tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abci.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
+		EndBlockEvents: []abci.Event{{
+			Type: evmtypes.EventTypeBlockBloom,
+			Attributes: []abci.EventAttribute{{
+				Key:   []byte(evmtypes.AttributeKeyEthereumBloom),
+				Value: ethtypes.Bloom{}.Bytes(),
+			}},
+		}},
+	}
+
+	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+	return res, nil
+}
+
 func RegisterBlockResultsError(client *mocks.Client, height int64) {
 	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
 		Return(nil, errortypes.ErrInvalidRequest)
@@ -242,6 +242,28 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// StorageRange
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, height int64, addr common.Address, storage evmtypes.Storage, nextKey string) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryStorageRangeRequest")).
+		Return(&evmtypes.QueryStorageRangeResponse{Storage: storage, NextKey: nextKey}, nil)
+}
+
+func RegisterStorageRangeError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryStorageRangeRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// AccountRange
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, height int64, start string, accounts []evmtypes.AccountDump, next string) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRangeRequest{Start: start, Limit: 256}).
+		Return(&evmtypes.QueryAccountRangeResponse{Accounts: accounts, Next: next}, nil)
+}
+
+func RegisterAccountRangeError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryAccountRangeRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -19,6 +19,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	ethermint "github.com/evmos/ethermint/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -44,6 +46,23 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns at most limit logs of the block range matching the addresses and topics from the
+// custom indexer, starting at the log index fromIndex of the from block. It returns ErrLogsNotIndexed if the
+// indexer doesn't index the logs of the whole range.
+func (b *Backend) GetIndexedLogs(
+	from, to int64,
+	fromIndex uint,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	logIndexer, ok := b.indexer.(ethermint.EVMLogIndexer)
+	if !ok {
+		return nil, ethermint.ErrLogsNotIndexed
+	}
+	return logIndexer.GetLogs(from, to, fromIndex, addresses, topics, limit)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -22,6 +22,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
@@ -33,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/btc"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
@@ -247,6 +249,48 @@ func (b *Backend) ListAccounts() ([]common.Address, error) {
 	return addrs, nil
 }
 
+// ListAccountsMetadata returns the accounts this node manages along with the Bitcoin address of
+// their keys.
+func (b *Backend) ListAccountsMetadata(args rpctypes.BTCAddressArgs) ([]rpctypes.AccountMetadata, error) {
+	network := args.Network
+	if network == "" {
+		network = chaincfg.MainNetParams.Name
+	}
+
+	params, err := btc.NetworkParams(network)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := b.clientCtx.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]rpctypes.AccountMetadata, 0, len(list))
+	for _, info := range list {
+		pubKey, err := info.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		account := rpctypes.AccountMetadata{
+			Name:    info.Name,
+			Address: common.BytesToAddress(pubKey.Address()),
+			KeyType: pubKey.Type(),
+		}
+
+		// not all the keys support the Bitcoin addresses, e.g. multisig keys
+		if btcAddr, err := btc.NewAddress(pubKey, args.Type, params); err == nil {
+			account.BTCAddress = btcAddr.EncodeAddress()
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 // NewAccount will create a new account and returns the address for the new account.
 func (b *Backend) NewMnemonic(uid string,
 	_ keyring.Language,
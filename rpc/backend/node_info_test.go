@@ -9,7 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/spf13/viper"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -128,6 +130,83 @@ func (suite *BackendTestSuite) TestListAccounts() {
 	}
 }
 
+func (suite *BackendTestSuite) TestListAccountsMetadata() {
+	// mnemonic of the BIP-84 and BIP-86 test vectors
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	testCases := []struct {
+		name          string
+		registerKeys  func()
+		args          rpctypes.BTCAddressArgs
+		expBTCAddress map[string]string
+		expPass       bool
+	}{
+		{
+			"pass - returns empty metadata",
+			func() {},
+			rpctypes.BTCAddressArgs{},
+			map[string]string{},
+			true,
+		},
+		{
+			"pass - default address types",
+			func() {
+				_, err := suite.backend.clientCtx.Keyring.NewAccount("eth", mnemonic, "", "m/84'/0'/0'/0/0", hd.EthSecp256k1)
+				suite.Require().NoError(err)
+				_, err = suite.backend.clientCtx.Keyring.NewAccount("schnorr", mnemonic, "", "m/86'/0'/0'/0/0", hd.Schnorr)
+				suite.Require().NoError(err)
+			},
+			rpctypes.BTCAddressArgs{},
+			map[string]string{
+				"eth":     "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+				"schnorr": "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+			},
+			true,
+		},
+		{
+			"pass - unsupported address type is omitted",
+			func() {
+				_, err := suite.backend.clientCtx.Keyring.NewAccount("eth", mnemonic, "", "m/84'/0'/0'/0/0", hd.EthSecp256k1)
+				suite.Require().NoError(err)
+				_, err = suite.backend.clientCtx.Keyring.NewAccount("schnorr", mnemonic, "", "m/86'/0'/0'/0/0", hd.Schnorr)
+				suite.Require().NoError(err)
+			},
+			rpctypes.BTCAddressArgs{Network: "regtest", Type: "p2wpkh"},
+			map[string]string{
+				"eth":     "bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx",
+				"schnorr": "",
+			},
+			true,
+		},
+		{
+			"fail - invalid network",
+			func() {},
+			rpctypes.BTCAddressArgs{Network: "simnet"},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerKeys()
+
+			output, err := suite.backend.ListAccountsMetadata(tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(output, len(tc.expBTCAddress))
+				for _, account := range output {
+					suite.Require().Equal(tc.expBTCAddress[account.Name], account.BTCAddress, account.Name)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestAccounts() {
 	testCases := []struct {
 		name         string
@@ -78,6 +78,11 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	// the calls are executed by cosmos txs, which the tracer can't replay
+	if len(rpctypes.EthereumCallMsgs(tx)) > 0 {
+		return nil, errors.New("ethereum calls of cosmos txs are not traceable")
+	}
+
 	// add predecessor messages in current cosmos tx
 	for i := 0; i < int(transaction.MsgIndex); i++ {
 		ethMsg, ok := tx.GetMsgs()[i].(*evmtypes.MsgEthereumTx)
@@ -51,18 +51,17 @@ func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransac
 		return nil, err
 	}
 
-	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
-	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
-	if !ok {
-		return nil, errors.New("invalid ethereum tx")
-	}
-
 	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
 		return nil, nil
 	}
 
+	msg, err := ethMsgByIndex(tx, blockRes, res)
+	if err != nil {
+		return nil, err
+	}
+
 	if res.EthTxIndex == -1 {
 		// Fallback to find tx index by iterating all valid eth transactions
 		msgs := b.EthMsgsFromTendermintBlock(block, blockRes)
@@ -148,6 +147,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.getReceipt(hash); ok {
+		return receipt, nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -163,7 +166,17 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		b.logger.Debug("decoding failed", "error", err.Error())
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
-	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	ethMsg, err := ethMsgByIndex(tx, blockRes, res)
+	if err != nil {
+		return nil, err
+	}
 
 	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
 	if err != nil {
@@ -172,11 +185,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed)
 	}
@@ -193,9 +201,13 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
-	if err != nil {
-		return nil, err
+	// the calls are unsigned, their sender is set on the msg
+	from := common.HexToAddress(ethMsg.From)
+	if !rpctypes.IsEthereumCallMsg(ethMsg) {
+		from, err = ethMsg.GetSender(chainID.ToInt())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// parse tx logs from events
@@ -256,13 +268,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
-			// tolerate the error for pruned node.
+			// tolerate the error for pruned node, the incomplete receipt is not cached.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			return receipt, nil
 		}
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
+	b.cache.addReceipt(hash, receipt)
 	return receipt, nil
 }
 
@@ -384,10 +397,8 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 			return nil, nil
 		}
 
-		var ok bool
-		// msgIndex is inferred from tx events, should be within bound.
-		msg, ok = tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
-		if !ok {
+		msg, err = ethMsgByIndex(tx, blockRes, res)
+		if err != nil {
 			b.logger.Debug("invalid ethereum tx", "height", block.Block.Header, "index", idx)
 			return nil, nil
 		}
@@ -417,3 +428,19 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		b.chainID,
 	)
 }
+
+// ethMsgByIndex returns the eth msg of a tx at the msg index of its indexed result, see
+// rpctypes.EthMsgsFromTx.
+func ethMsgByIndex(tx sdk.Tx, blockRes *tmrpctypes.ResultBlockResults, res *ethermint.TxResult) (*evmtypes.MsgEthereumTx, error) {
+	if int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return nil, errors.New("invalid ethereum tx")
+	}
+	ethMsgs, err := rpctypes.EthMsgsFromTx(tx, blockRes.TxsResults[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+	if int(res.MsgIndex) >= len(ethMsgs) {
+		return nil, errors.New("invalid ethereum tx")
+	}
+	return ethMsgs[res.MsgIndex], nil
+}
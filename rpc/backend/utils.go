@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -310,3 +311,24 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// toDumpAccount converts an EVM AccountDump into the geth state dump format.
+func toDumpAccount(account evmtypes.AccountDump) state.DumpAccount {
+	address := common.HexToAddress(account.Address)
+	dump := state.DumpAccount{
+		Balance:  account.Balance,
+		Nonce:    account.Nonce,
+		CodeHash: common.HexToHash(account.CodeHash).Bytes(),
+		Code:     account.Code,
+		Address:  &address,
+	}
+
+	if len(account.Storage) > 0 {
+		dump.Storage = make(map[common.Hash]string, len(account.Storage))
+		for _, st := range account.Storage {
+			dump.Storage[common.HexToHash(st.Key)] = common.HexToHash(st.Value).Hex()
+		}
+	}
+
+	return dump
+}
@@ -108,11 +108,15 @@ func (m *memEventBus) Subscribe(name string) (<-chan coretypes.ResultEvent, Unsu
 		m.subscribers[name] = make(map[uint64]chan<- coretypes.ResultEvent)
 	}
 	m.subscribers[name][id] = ch
+	subscribersGauge.WithLabelValues(name).Inc()
 
 	unsubscribe := func() {
 		m.subscribersMux.Lock()
 		defer m.subscribersMux.Unlock()
-		delete(m.subscribers[name], id)
+		if _, ok := m.subscribers[name][id]; ok {
+			delete(m.subscribers[name], id)
+			subscribersGauge.WithLabelValues(name).Dec()
+		}
 	}
 
 	return ch, unsubscribe, nil
@@ -138,6 +142,7 @@ func (m *memEventBus) closeAllSubscribers(name string) {
 
 	subsribers := m.subscribers[name]
 	delete(m.subscribers, name)
+	subscribersGauge.WithLabelValues(name).Sub(float64(len(subsribers)))
 
 	for _, sub := range subsribers {
 		close(sub)
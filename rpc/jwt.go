@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// jwtExpiryTimeout is the max drift allowed between the issued-at claim of a token and the local time.
+const jwtExpiryTimeout = 60 * time.Second
+
+// JWTHandler authenticates the requests with a bearer token, signed with HS256 by a shared secret and
+// issued within the last minute as for the go-ethereum engine API, before serving them with the
+// wrapped handler.
+type JWTHandler struct {
+	keyFunc func(token *jwt.Token) (interface{}, error)
+	next    http.Handler
+}
+
+// NewJWTHandler returns a handler authenticating the requests with the tokens signed by the secret.
+func NewJWTHandler(secret []byte, next http.Handler) *JWTHandler {
+	return &JWTHandler{
+		keyFunc: func(token *jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		next: next,
+	}
+}
+
+func (h *JWTHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		strToken string
+		claims   jwt.RegisteredClaims
+	)
+
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		strToken = strings.TrimPrefix(auth, "Bearer ")
+	}
+
+	if strToken == "" {
+		http.Error(w, "missing token", http.StatusForbidden)
+		return
+	}
+
+	// the claims are checked below, allowing the issued-at time to drift in both directions
+	token, err := jwt.ParseWithClaims(strToken, &claims, h.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithoutClaimsValidation(),
+	)
+
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case !token.Valid:
+		http.Error(w, "invalid token", http.StatusForbidden)
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		http.Error(w, "token is expired", http.StatusForbidden)
+	case claims.IssuedAt == nil:
+		http.Error(w, "missing issued-at", http.StatusForbidden)
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "stale token", http.StatusForbidden)
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "future token", http.StatusForbidden)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTHandler(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	handler := NewJWTHandler(secret, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+	issuedAt := func(d time.Duration) jwt.Claims {
+		return jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(d))}
+	}
+
+	testCases := []struct {
+		name   string
+		auth   string
+		status int
+	}{
+		{"valid token", sign(jwt.SigningMethodHS256, secret, issuedAt(0)), http.StatusOK},
+		{"drifted token", sign(jwt.SigningMethodHS256, secret, issuedAt(30*time.Second)), http.StatusOK},
+		{"missing token", "", http.StatusForbidden},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", http.StatusForbidden},
+		{"wrong secret", sign(jwt.SigningMethodHS256, []byte("wrong"), issuedAt(0)), http.StatusForbidden},
+		{"wrong algorithm", sign(jwt.SigningMethodHS512, secret, issuedAt(0)), http.StatusForbidden},
+		{"missing issued-at", sign(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{}), http.StatusForbidden},
+		{"stale token", sign(jwt.SigningMethodHS256, secret, issuedAt(-2*time.Minute)), http.StatusForbidden},
+		{"future token", sign(jwt.SigningMethodHS256, secret, issuedAt(2*time.Minute)), http.StatusForbidden},
+		{
+			"expired token",
+			sign(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Second)),
+			}),
+			http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// maxMeteredRequestSize is the max size of the requests read by the metrics, larger requests are
+	// rejected by the go-ethereum server.
+	maxMeteredRequestSize = 5 * 1024 * 1024
+	// maxMeteredResponseSize is the max size of the responses parsed for the error codes.
+	maxMeteredResponseSize = 1024 * 1024
+
+	// otherMethod is the metrics label of the methods not served by the JSON-RPC, which bounds the
+	// cardinality of the method label.
+	otherMethod = "other"
+	// batchMethod is the metrics label of the duration of the batch requests.
+	batchMethod = "batch"
+)
+
+var (
+	requestsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ethermint",
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Number of JSON-RPC requests by method.",
+	}, []string{"method"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ethermint",
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the JSON-RPC requests by method, the batch requests are observed as a whole.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method"})
+
+	errorsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ethermint",
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of JSON-RPC error responses by method and error code.",
+	}, []string{"method", "code"})
+
+	wsConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "ethermint",
+		Subsystem: "rpc",
+		Name:      "ws_connections",
+		Help:      "Number of open websocket connections.",
+	})
+
+	wsSubscriptions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ethermint",
+		Subsystem: "rpc",
+		Name:      "ws_subscriptions",
+		Help:      "Number of websocket subscriptions by kind.",
+	}, []string{"kind"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsCounter, requestDuration, errorsCounter, wsConnections, wsSubscriptions)
+}
+
+// jsonrpcMessage holds the fields of the JSON-RPC requests and responses read by the metrics.
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error,omitempty"`
+}
+
+// parseMessages decodes a single or a batch of JSON-RPC messages, it returns nil if invalid.
+func parseMessages(raw []byte) []jsonrpcMessage {
+	if isBatch(raw) {
+		var msgs []jsonrpcMessage
+		if err := json.Unmarshal(raw, &msgs); err != nil {
+			return nil
+		}
+		return msgs
+	}
+
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil
+	}
+	return []jsonrpcMessage{msg}
+}
+
+// responseRecorder records the beginning of a response written to the client.
+type responseRecorder struct {
+	http.ResponseWriter
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.truncated {
+		if r.body.Len()+len(b) > maxMeteredResponseSize {
+			r.truncated = true
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// rpcMethods is the set of the JSON-RPC methods served by the APIs, any other method is labeled
+// as otherMethod.
+type rpcMethods map[string]bool
+
+// newRPCMethods returns the set of the methods of the given APIs.
+func newRPCMethods(apis []rpc.API) rpcMethods {
+	methods := make(rpcMethods)
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			methods[api.Namespace+"_"+formatMethodName(typ.Method(i).Name)] = true
+		}
+	}
+	return methods
+}
+
+// formatMethodName converts a Go method name to the JSON-RPC one, as the go-ethereum server does.
+func formatMethodName(name string) string {
+	ret := []rune(name)
+	if len(ret) > 0 {
+		ret[0] = unicode.ToLower(ret[0])
+	}
+	return string(ret)
+}
+
+// label returns the metrics label of a requested method.
+func (m rpcMethods) label(method string) string {
+	if m[method] {
+		return method
+	}
+	return otherMethod
+}
+
+// MetricsHandler records the number, the duration and the error codes of the JSON-RPC requests
+// served by the wrapped handler.
+type MetricsHandler struct {
+	next    http.Handler
+	methods rpcMethods
+}
+
+// NewMetricsHandler returns a handler recording the metrics of the methods of the given APIs.
+func NewMetricsHandler(next http.Handler, apis []rpc.API) *MetricsHandler {
+	return &MetricsHandler{
+		next:    next,
+		methods: newRPCMethods(apis),
+	}
+}
+
+func (h *MetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxMeteredRequestSize))
+	// the server reads the whole body, including what is left after an error or the size limit
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	var reqs []jsonrpcMessage
+	if err == nil {
+		reqs = parseMessages(body)
+	}
+	if len(reqs) == 0 {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	rec := &responseRecorder{ResponseWriter: w}
+	start := time.Now()
+	h.next.ServeHTTP(rec, r)
+	elapsed := time.Since(start).Seconds()
+
+	methods := make(map[string]string, len(reqs))
+	for _, req := range reqs {
+		method := h.methods.label(req.Method)
+		methods[string(req.ID)] = method
+		requestsCounter.WithLabelValues(method).Inc()
+	}
+
+	batch := isBatch(body)
+	if batch {
+		requestDuration.WithLabelValues(batchMethod).Observe(elapsed)
+	} else {
+		requestDuration.WithLabelValues(h.methods.label(reqs[0].Method)).Observe(elapsed)
+	}
+
+	if rec.truncated || !bytes.Contains(rec.body.Bytes(), []byte(`"error"`)) {
+		return
+	}
+
+	for _, res := range parseMessages(rec.body.Bytes()) {
+		if res.Error == nil {
+			continue
+		}
+		// the error of a single request may not carry its id, e.g. on invalid params
+		method, ok := methods[string(res.ID)]
+		if !batch {
+			method = h.methods.label(reqs[0].Method)
+		} else if !ok {
+			method = otherMethod
+		}
+		errorsCounter.WithLabelValues(method, strconv.Itoa(res.Error.Code)).Inc()
+	}
+}
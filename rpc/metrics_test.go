@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string { return s }
+
+// Sleep returns after the given number of milliseconds, or once the request is canceled.
+func (testService) Sleep(ctx context.Context, ms int) error {
+	select {
+	case <-time.After(time.Duration(ms) * time.Millisecond):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestMetricsHandler(t *testing.T) {
+	server := rpc.NewServer()
+	apis := []rpc.API{{Namespace: "test", Service: testService{}}}
+	require.NoError(t, server.RegisterName("test", testService{}))
+	handler := NewMetricsHandler(server, apis)
+
+	serve := func(body string) string {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Body.String()
+	}
+
+	echoes := testutil.ToFloat64(requestsCounter.WithLabelValues("test_echo"))
+	others := testutil.ToFloat64(requestsCounter.WithLabelValues(otherMethod))
+	invalidParams := testutil.ToFloat64(errorsCounter.WithLabelValues("test_echo", "-32602"))
+	notFound := testutil.ToFloat64(errorsCounter.WithLabelValues(otherMethod, "-32601"))
+
+	res := serve(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`)
+	require.Contains(t, res, `"result":"hello"`)
+	require.Equal(t, echoes+1, testutil.ToFloat64(requestsCounter.WithLabelValues("test_echo")))
+
+	serve(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[1]}`)
+	require.Equal(t, invalidParams+1, testutil.ToFloat64(errorsCounter.WithLabelValues("test_echo", "-32602")))
+
+	// the unknown methods share a label
+	serve(`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","id":"b","method":"test_foo"}]`)
+	require.Equal(t, echoes+3, testutil.ToFloat64(requestsCounter.WithLabelValues("test_echo")))
+	require.Equal(t, others+1, testutil.ToFloat64(requestsCounter.WithLabelValues(otherMethod)))
+	require.Equal(t, notFound+1, testutil.ToFloat64(errorsCounter.WithLabelValues(otherMethod, "-32601")))
+
+	// invalid requests are served without being recorded
+	res = serve(`{"jsonrpc":"2.0","id":1,"method":`)
+	require.Contains(t, res, `"error"`)
+	require.Equal(t, others+1, testutil.ToFloat64(requestsCounter.WithLabelValues(otherMethod)))
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package b2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	batchtypes "github.com/evmos/ethermint/x/batch/types"
+)
+
+// RPCBatch is a batch commitment returned by the b2 namespace.
+type RPCBatch struct {
+	Index             hexutil.Uint64 `json:"index"`
+	StartHeight       hexutil.Uint64 `json:"startHeight"`
+	EndHeight         hexutil.Uint64 `json:"endHeight"`
+	StateRoot         common.Hash    `json:"stateRoot"`
+	TxRoot            common.Hash    `json:"txRoot"`
+	TxCount           hexutil.Uint64 `json:"txCount"`
+	WithdrawalRoot    common.Hash    `json:"withdrawalRoot"`
+	FirstWithdrawalID hexutil.Uint64 `json:"firstWithdrawalId"`
+	WithdrawalCount   hexutil.Uint64 `json:"withdrawalCount"`
+	Hash              common.Hash    `json:"hash"`
+}
+
+// RPCWithdrawalProof is the merkle proof of a withdrawal returned by the b2 namespace. It can be
+// verified with batchtypes.VerifyMerkleProof.
+type RPCWithdrawalProof struct {
+	BatchIndex     hexutil.Uint64 `json:"batchIndex"`
+	WithdrawalID   hexutil.Uint64 `json:"withdrawalId"`
+	Leaf           hexutil.Bytes  `json:"leaf"`
+	LeafIndex      hexutil.Uint64 `json:"leafIndex"`
+	LeafCount      hexutil.Uint64 `json:"leafCount"`
+	Siblings       []common.Hash  `json:"siblings"`
+	WithdrawalRoot common.Hash    `json:"withdrawalRoot"`
+}
+
+// PublicAPI is the b2_ prefixed set of APIs exposing the rollup batch commitments to the
+// inscribers.
+type PublicAPI struct {
+	ctx         context.Context
+	logger      log.Logger
+	queryClient batchtypes.QueryClient
+}
+
+// NewPublicAPI creates an instance of the public b2 API.
+func NewPublicAPI(logger log.Logger, clientCtx client.Context) *PublicAPI {
+	return &PublicAPI{
+		ctx:         context.Background(),
+		logger:      logger.With("module", "b2"),
+		queryClient: batchtypes.NewQueryClient(clientCtx),
+	}
+}
+
+// GetBatch returns the batch with the given index, or the last committed batch if the index is
+// omitted. It returns nil if the batch is not committed.
+func (api *PublicAPI) GetBatch(index *hexutil.Uint64) (*RPCBatch, error) {
+	api.logger.Debug("b2_getBatch", "index", index)
+
+	var (
+		batch batchtypes.Batch
+		err   error
+	)
+	if index == nil {
+		var res *batchtypes.QueryLatestBatchResponse
+		if res, err = api.queryClient.LatestBatch(api.ctx, &batchtypes.QueryLatestBatchRequest{}); err == nil {
+			batch = res.Batch
+		}
+	} else {
+		var res *batchtypes.QueryBatchResponse
+		if res, err = api.queryClient.Batch(api.ctx, &batchtypes.QueryBatchRequest{Index: uint64(*index)}); err == nil {
+			batch = res.Batch
+		}
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return NewRPCBatch(batch)
+}
+
+// GetWithdrawalProof returns the merkle proof of a BTC bridge withdrawal against the withdrawal
+// root of its batch. It returns nil if the withdrawal is not committed.
+func (api *PublicAPI) GetWithdrawalProof(withdrawalID hexutil.Uint64) (*RPCWithdrawalProof, error) {
+	api.logger.Debug("b2_getWithdrawalProof", "withdrawal id", withdrawalID)
+
+	res, err := api.queryClient.WithdrawalProof(api.ctx, &batchtypes.QueryWithdrawalProofRequest{
+		WithdrawalId: uint64(withdrawalID),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return NewRPCWithdrawalProof(res.Proof)
+}
+
+// NewRPCBatch converts a batch to its JSON-RPC representation.
+func NewRPCBatch(batch batchtypes.Batch) (*RPCBatch, error) {
+	roots := make([]common.Hash, 4)
+	for i, root := range []string{batch.StateRoot, batch.TxRoot, batch.WithdrawalRoot, batch.Hash} {
+		hash, err := batchtypes.ParseHash(root)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = hash
+	}
+
+	return &RPCBatch{
+		Index:             hexutil.Uint64(batch.Index),
+		StartHeight:       hexutil.Uint64(batch.StartHeight),
+		EndHeight:         hexutil.Uint64(batch.EndHeight),
+		StateRoot:         roots[0],
+		TxRoot:            roots[1],
+		TxCount:           hexutil.Uint64(batch.TxCount),
+		WithdrawalRoot:    roots[2],
+		FirstWithdrawalID: hexutil.Uint64(batch.FirstWithdrawalId),
+		WithdrawalCount:   hexutil.Uint64(batch.WithdrawalCount),
+		Hash:              roots[3],
+	}, nil
+}
+
+// NewRPCWithdrawalProof converts a withdrawal proof to its JSON-RPC representation.
+func NewRPCWithdrawalProof(proof batchtypes.WithdrawalProof) (*RPCWithdrawalProof, error) {
+	root, err := batchtypes.ParseHash(proof.WithdrawalRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := make([]common.Hash, len(proof.Siblings))
+	for i, sibling := range proof.Siblings {
+		if siblings[i], err = batchtypes.ParseHash(sibling); err != nil {
+			return nil, err
+		}
+	}
+
+	return &RPCWithdrawalProof{
+		BatchIndex:     hexutil.Uint64(proof.BatchIndex),
+		WithdrawalID:   hexutil.Uint64(proof.WithdrawalId),
+		Leaf:           proof.Leaf,
+		LeafIndex:      hexutil.Uint64(proof.LeafIndex),
+		LeafCount:      hexutil.Uint64(proof.LeafCount),
+		Siblings:       siblings,
+		WithdrawalRoot: root,
+	}, nil
+}
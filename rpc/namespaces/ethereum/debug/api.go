@@ -38,6 +38,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -117,6 +118,38 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// StorageRangeAt returns the storage of the given account at the given block,
+// starting from keyStart and returning at most maxResult entries.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the EVM accounts at the given block, ordered by
+// address and starting from start. incompletes is accepted for compatibility
+// with geth and ignored, as every account has a known address.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, incompletes bool,
+) (state.IteratorDump, error) {
+	a.logger.Debug("debug_accountRange", "block", blockNrOrHash, "start", start, "max-results", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// DumpBlock retrieves the entire EVM state at the given block.
+func (a *API) DumpBlock(blockNr rpctypes.BlockNumber) (state.Dump, error) {
+	a.logger.Debug("debug_dumpBlock", "number", blockNr)
+	return a.backend.DumpBlock(blockNr)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
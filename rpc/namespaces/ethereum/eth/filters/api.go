@@ -58,6 +58,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(from, to int64, fromIndex uint, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -121,7 +122,7 @@ func (api *PublicFilterAPI) timeoutLoop() {
 			select {
 			case <-f.deadline.C:
 				f.s.Unsubscribe(api.events)
-				delete(api.filters, id)
+				api.deleteFilter(id)
 			default:
 				continue
 			}
@@ -151,12 +152,12 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{
+	api.addFilter(pendingTxSub.ID(), &filter{
 		typ:      filters.PendingTransactionsSubscription,
 		deadline: time.NewTimer(deadline),
 		hashes:   make([]common.Hash, 0),
 		s:        pendingTxSub,
-	}
+	})
 
 	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -166,7 +167,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -195,7 +196,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
+				api.deleteFilter(pendingTxSub.ID())
 				api.filtersMu.Unlock()
 			}
 		}
@@ -232,7 +233,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -286,7 +287,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
 	}
 
-	api.filters[headerSub.ID()] = &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub}
+	api.addFilter(headerSub.ID(), &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub})
 
 	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -296,7 +297,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 			case ev, ok := <-headersCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, headerSub.ID())
+					api.deleteFilter(headerSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -314,7 +315,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, headerSub.ID())
+				api.deleteFilter(headerSub.ID())
 				api.filtersMu.Unlock()
 				return
 			}
@@ -472,13 +473,13 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 
 	filterID = logsSub.ID()
 
-	api.filters[filterID] = &filter{
+	api.addFilter(filterID, &filter{
 		typ:      filters.LogsSubscription,
 		crit:     criteria,
 		deadline: time.NewTimer(deadline),
 		hashes:   []common.Hash{},
 		s:        logsSub,
-	}
+	})
 
 	go func(eventCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
@@ -488,7 +489,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 			case ev, ok := <-eventCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, filterID)
+					api.deleteFilter(filterID)
 					api.filtersMu.Unlock()
 					return
 				}
@@ -513,7 +514,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 				api.filtersMu.Unlock()
 			case <-logsSub.Err():
 				api.filtersMu.Lock()
-				delete(api.filters, filterID)
+				api.deleteFilter(filterID)
 				api.filtersMu.Unlock()
 				return
 			}
@@ -527,23 +528,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
-	var filter *Filter
-	if crit.BlockHash != nil {
-		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
-	} else {
-		// Convert the RPC block numbers into internal representations
-		begin := rpc.LatestBlockNumber.Int64()
-		if crit.FromBlock != nil {
-			begin = crit.FromBlock.Int64()
-		}
-		end := rpc.LatestBlockNumber.Int64()
-		if crit.ToBlock != nil {
-			end = crit.ToBlock.Int64()
-		}
-		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
-	}
+	filter := NewCriteriaFilter(api.logger, api.backend, crit)
 
 	// Run the filter and return all the logs
 	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
@@ -561,7 +546,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
 	f, found := api.filters[id]
 	if found {
-		delete(api.filters, id)
+		api.deleteFilter(id)
 	}
 	api.filtersMu.Unlock()
 
@@ -0,0 +1,75 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// logCursorLength is the length of the encoded log cursors.
+const logCursorLength = 24
+
+// LogCursor is the position of a log, from which a paginated logs query resumes. The logs of a block
+// are ordered by their index, the tx index is kept so the position identifies the log. It's encoded
+// as an opaque hex string in the JSON-RPC.
+type LogCursor struct {
+	Height   int64
+	TxIndex  uint
+	LogIndex uint
+}
+
+// NewLogCursor returns the position of a log.
+func NewLogCursor(log *ethtypes.Log) LogCursor {
+	return LogCursor{
+		Height:   int64(log.BlockNumber),
+		TxIndex:  log.TxIndex,
+		LogIndex: log.Index,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c LogCursor) MarshalText() ([]byte, error) {
+	bz := make([]byte, logCursorLength)
+	binary.BigEndian.PutUint64(bz, uint64(c.Height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(c.TxIndex))
+	binary.BigEndian.PutUint64(bz[16:], uint64(c.LogIndex))
+	return hexutil.Bytes(bz).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *LogCursor) UnmarshalText(input []byte) error {
+	var bz hexutil.Bytes
+	if err := bz.UnmarshalText(input); err != nil {
+		return fmt.Errorf("invalid log cursor: %w", err)
+	}
+	if len(bz) != logCursorLength {
+		return fmt.Errorf("invalid log cursor length %d", len(bz))
+	}
+
+	height := binary.BigEndian.Uint64(bz)
+	if height > uint64(1<<63-1) {
+		return fmt.Errorf("invalid log cursor height %d", height)
+	}
+
+	c.Height = int64(height)
+	c.TxIndex = uint(binary.BigEndian.Uint64(bz[8:]))
+	c.LogIndex = uint(binary.BigEndian.Uint64(bz[16:]))
+	return nil
+}
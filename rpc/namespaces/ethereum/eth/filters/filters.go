@@ -23,6 +23,7 @@ import (
 
 	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
@@ -32,6 +33,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // BloomIV represents the bit indexes and value inside the bloom filter that belong
@@ -91,6 +93,26 @@ func NewRangeFilter(logger log.Logger, backend Backend, begin, end int64, addres
 	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
 }
 
+// NewCriteriaFilter creates a block filter if the criteria has a block hash, or else a range filter.
+func NewCriteriaFilter(logger log.Logger, backend Backend, crit filters.FilterCriteria) *Filter {
+	if crit.BlockHash != nil {
+		// Block filter requested, construct a single-shot filter
+		return NewBlockFilter(logger, backend, crit)
+	}
+
+	// Convert the RPC block numbers into internal representations
+	begin := rpc.LatestBlockNumber.Int64()
+	if crit.FromBlock != nil {
+		begin = crit.FromBlock.Int64()
+	}
+	end := rpc.LatestBlockNumber.Int64()
+	if crit.ToBlock != nil {
+		end = crit.ToBlock.Int64()
+	}
+	// Construct the range filter
+	return NewRangeFilter(logger, backend, begin, end, crit.Addresses, crit.Topics)
+}
+
 // newFilter returns a new Filter
 func newFilter(logger log.Logger, backend Backend, criteria filters.FilterCriteria, bloomFilters [][]BloomIV) *Filter {
 	return &Filter{
@@ -133,26 +155,9 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	}
 
 	// Figure out the limits of the filter range
-	header, err := f.backend.HeaderByNumber(types.EthLatestBlockNumber)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
-	}
-
-	if header == nil || header.Number == nil {
-		f.logger.Debug("header not found or has no number")
-		return nil, nil
-	}
-
-	head := header.Number.Int64()
-	if f.criteria.FromBlock.Int64() < 0 {
-		f.criteria.FromBlock = big.NewInt(head)
-	} else if f.criteria.FromBlock.Int64() == 0 {
-		f.criteria.FromBlock = big.NewInt(1)
-	}
-	if f.criteria.ToBlock.Int64() < 0 {
-		f.criteria.ToBlock = big.NewInt(head)
-	} else if f.criteria.ToBlock.Int64() == 0 {
-		f.criteria.ToBlock = big.NewInt(1)
+	head, err := f.resolveRange()
+	if err != nil || head < 0 {
+		return nil, err
 	}
 
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
@@ -169,6 +174,18 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// query the custom indexer if it indexes the logs of the whole range
+	indexed, err := f.backend.GetIndexedLogs(from, to, 0, f.criteria.Addresses, f.criteria.Topics, logLimit+1)
+	switch {
+	case err == nil:
+		if len(indexed) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return indexed, nil
+	case !errors.Is(err, ethermint.ErrLogsNotIndexed):
+		return nil, err
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -195,6 +212,134 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// LogsPaged returns at most logLimit logs matching the filter criteria from the cursor position, or
+// from the start of the range if nil, and the cursor of the next page, which is nil once the whole range
+// is scanned. A page scans at most blockLimit+1 blocks, so the range itself is not bounded.
+func (f *Filter) LogsPaged(_ context.Context, cursor *LogCursor, logLimit int, blockLimit int64) ([]*ethtypes.Log, *LogCursor, error) {
+	if logLimit <= 0 {
+		return nil, nil, fmt.Errorf("invalid log limit %d, the pages must have at least one log", logLimit)
+	}
+
+	var from, to int64
+	if f.criteria.BlockHash != nil && *f.criteria.BlockHash != (common.Hash{}) {
+		resBlock, err := f.backend.TendermintBlockByHash(*f.criteria.BlockHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch header by hash %s: %w", f.criteria.BlockHash, err)
+		}
+		if resBlock == nil {
+			return nil, nil, fmt.Errorf("block not found for hash %s", f.criteria.BlockHash)
+		}
+		from, to = resBlock.Block.Height, resBlock.Block.Height
+	} else {
+		head, err := f.resolveRange()
+		if err != nil || head < 0 {
+			return nil, nil, err
+		}
+		// the pages don't overhang the head, so the scan ends
+		from, to = f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64()
+		if to > head {
+			to = head
+		}
+	}
+
+	start := LogCursor{Height: from}
+	if cursor != nil {
+		if cursor.Height < from {
+			return nil, nil, fmt.Errorf("cursor height %d is lower than the from block %d", cursor.Height, from)
+		}
+		start = *cursor
+	}
+	if start.Height > to {
+		return []*ethtypes.Log{}, nil, nil
+	}
+
+	end := to
+	if end-start.Height > blockLimit {
+		end = start.Height + blockLimit
+	}
+
+	// query the custom indexer if it indexes the logs of the whole page
+	logs, err := f.backend.GetIndexedLogs(start.Height, end, start.LogIndex, f.criteria.Addresses, f.criteria.Topics, logLimit+1)
+	switch {
+	case errors.Is(err, ethermint.ErrLogsNotIndexed):
+		if logs, err = f.scanLogs(start, end, logLimit+1); err != nil {
+			return nil, nil, err
+		}
+	case err != nil:
+		return nil, nil, err
+	}
+
+	if len(logs) > logLimit {
+		next := NewLogCursor(logs[logLimit])
+		return logs[:logLimit], &next, nil
+	}
+	if end < to {
+		return logs, &LogCursor{Height: end + 1}, nil
+	}
+	return logs, nil, nil
+}
+
+// resolveRange resolves the latest and earliest blocks of the filter range, it returns the head
+// block number, or -1 if the head is not found.
+func (f *Filter) resolveRange() (int64, error) {
+	header, err := f.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return -1, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+	}
+
+	if header == nil || header.Number == nil {
+		f.logger.Debug("header not found or has no number")
+		return -1, nil
+	}
+
+	head := header.Number.Int64()
+	if f.criteria.FromBlock.Int64() < 0 {
+		f.criteria.FromBlock = big.NewInt(head)
+	} else if f.criteria.FromBlock.Int64() == 0 {
+		f.criteria.FromBlock = big.NewInt(1)
+	}
+	if f.criteria.ToBlock.Int64() < 0 {
+		f.criteria.ToBlock = big.NewInt(head)
+	} else if f.criteria.ToBlock.Int64() == 0 {
+		f.criteria.ToBlock = big.NewInt(1)
+	}
+	return head, nil
+}
+
+// scanLogs returns at most limit logs matching the filter criteria from the start position to the end
+// block, skipping the blocks with a non matching bloom.
+func (f *Filter) scanLogs(start LogCursor, end int64, limit int) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	for height := start.Height; height <= end && len(logs) < limit; height++ {
+		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch block result %d", height)
+		}
+
+		bloom, err := f.backend.BlockBloom(blockRes)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered, err := f.blockLogs(blockRes, bloom)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+		}
+
+		for _, ethLog := range filtered {
+			if height == start.Height && ethLog.Index < start.LogIndex {
+				continue
+			}
+			logs = append(logs, ethLog)
+		}
+	}
+
+	if len(logs) > limit {
+		logs = logs[:limit]
+	}
+	return logs, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
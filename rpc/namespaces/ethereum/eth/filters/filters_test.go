@@ -0,0 +1,168 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// pagedBackend serves blocks with two txs emitting a log each, from the block results or
+// from the indexer.
+type pagedBackend struct {
+	Backend
+	head    int64
+	logs    map[int64][]*ethtypes.Log
+	indexed bool
+}
+
+func newPagedBackend(head int64, address common.Address) *pagedBackend {
+	b := &pagedBackend{head: head, logs: make(map[int64][]*ethtypes.Log)}
+	for height := int64(1); height <= head; height++ {
+		for i := 0; i < 2; i++ {
+			b.logs[height] = append(b.logs[height], &ethtypes.Log{
+				Address:     address,
+				Topics:      []common.Hash{},
+				Data:        []byte{},
+				BlockNumber: uint64(height),
+				TxHash:      common.BigToHash(big.NewInt(height*10 + int64(i))),
+				TxIndex:     uint(i),
+				Index:       uint(i),
+			})
+		}
+	}
+	return b
+}
+
+func (b *pagedBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *pagedBackend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	res := &tmrpctypes.ResultBlockResults{Height: *height}
+	for _, ethLog := range b.logs[*height] {
+		bz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+		if err != nil {
+			return nil, err
+		}
+		res.TxsResults = append(res.TxsResults, &abci.ResponseDeliverTx{
+			Events: []abci.Event{{
+				Type:       evmtypes.EventTypeTxLog,
+				Attributes: []abci.EventAttribute{{Key: []byte(evmtypes.AttributeKeyTxLog), Value: bz}},
+			}},
+		})
+	}
+	return res, nil
+}
+
+func (b *pagedBackend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom(b.logs[blockRes.Height])), nil
+}
+
+func (b *pagedBackend) GetIndexedLogs(
+	from, to int64,
+	fromIndex uint,
+	_ []common.Address,
+	_ [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	if !b.indexed {
+		return nil, ethermint.ErrLogsNotIndexed
+	}
+	logs := []*ethtypes.Log{}
+	for height := from; height <= to && len(logs) < limit; height++ {
+		for _, ethLog := range b.logs[height] {
+			if height == from && ethLog.Index < fromIndex {
+				continue
+			}
+			logs = append(logs, ethLog)
+		}
+	}
+	if len(logs) > limit {
+		logs = logs[:limit]
+	}
+	return logs, nil
+}
+
+func TestFilterLogsPaged(t *testing.T) {
+	address := common.BigToAddress(big.NewInt(1))
+
+	for _, indexed := range []bool{false, true} {
+		backend := newPagedBackend(5, address)
+		backend.indexed = indexed
+
+		var (
+			logs   []*ethtypes.Log
+			cursor *LogCursor
+			pages  int
+		)
+		for {
+			filter := NewRangeFilter(log.NewNopLogger(), backend, 0, -1, []common.Address{address}, nil)
+			page, next, err := filter.LogsPaged(context.Background(), cursor, 3, 1)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), 3)
+			logs = append(logs, page...)
+			pages++
+			if next == nil {
+				break
+			}
+			cursor = next
+		}
+
+		var expLogs []*ethtypes.Log
+		for height := int64(1); height <= 5; height++ {
+			expLogs = append(expLogs, backend.logs[height]...)
+		}
+		require.Len(t, logs, len(expLogs), "indexed: %v", indexed)
+		for i, ethLog := range logs {
+			require.Equal(t, NewLogCursor(expLogs[i]), NewLogCursor(ethLog), "indexed: %v", indexed)
+		}
+		require.Greater(t, pages, 3)
+	}
+}
+
+func TestFilterLogsPagedCursorOutOfRange(t *testing.T) {
+	backend := newPagedBackend(5, common.Address{})
+
+	filter := NewRangeFilter(log.NewNopLogger(), backend, 3, 5, nil, nil)
+	_, _, err := filter.LogsPaged(context.Background(), &LogCursor{Height: 2}, 10, 10)
+	require.Error(t, err)
+
+	filter = NewRangeFilter(log.NewNopLogger(), backend, 3, 5, nil, nil)
+	logs, next, err := filter.LogsPaged(context.Background(), &LogCursor{Height: 6}, 10, 10)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.Nil(t, next)
+}
+
+func TestFilterLogsPagedInvalidLimit(t *testing.T) {
+	backend := newPagedBackend(5, common.Address{})
+
+	filter := NewRangeFilter(log.NewNopLogger(), backend, 1, 5, nil, nil)
+	_, _, err := filter.LogsPaged(context.Background(), nil, 0, 10)
+	require.Error(t, err)
+}
+
+func TestLogCursorJSON(t *testing.T) {
+	cursor := LogCursor{Height: 12, TxIndex: 3, LogIndex: 7}
+	bz, err := json.Marshal(cursor)
+	require.NoError(t, err)
+
+	var decoded LogCursor
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, cursor, decoded)
+
+	require.Error(t, json.Unmarshal([]byte(`"0x01"`), &decoded))
+	require.Error(t, json.Unmarshal([]byte(`"cursor"`), &decoded))
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// activeFilters tracks the filters installed through the eth_new*Filter methods.
+var activeFilters = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "ethermint",
+	Subsystem: "rpc",
+	Name:      "active_filters",
+	Help:      "Number of installed filters by type.",
+}, []string{"type"})
+
+func init() {
+	prometheus.MustRegister(activeFilters)
+}
+
+// filterTypeLabel returns the metrics label of a filter type.
+func filterTypeLabel(typ filters.Type) string {
+	switch typ {
+	case filters.LogsSubscription:
+		return "logs"
+	case filters.PendingTransactionsSubscription:
+		return "pending_txs"
+	case filters.BlocksSubscription:
+		return "blocks"
+	default:
+		return "unknown"
+	}
+}
+
+// addFilter installs a filter, the caller must hold filtersMu.
+func (api *PublicFilterAPI) addFilter(id rpc.ID, f *filter) {
+	api.filters[id] = f
+	activeFilters.WithLabelValues(filterTypeLabel(f.typ)).Inc()
+}
+
+// deleteFilter removes a filter if installed, the caller must hold filtersMu.
+func (api *PublicFilterAPI) deleteFilter(id rpc.ID) {
+	f, found := api.filters[id]
+	if !found {
+		return
+	}
+	delete(api.filters, id)
+	activeFilters.WithLabelValues(filterTypeLabel(f.typ)).Dec()
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 
 	"github.com/evmos/ethermint/crypto/hd"
 	ethermint "github.com/evmos/ethermint/types"
@@ -76,10 +77,15 @@ func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Add
 	return api.backend.ImportRawKey(privkey, password)
 }
 
-// ListAccounts will return a list of addresses for accounts this node manages.
-func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
+// ListAccounts will return a list of addresses for accounts this node manages. When the optional
+// Bitcoin address arguments are given, it returns the account metadata including the Bitcoin
+// address of the account keys instead.
+func (api *PrivateAccountAPI) ListAccounts(args *rpctypes.BTCAddressArgs) (interface{}, error) {
 	api.logger.Debug("personal_listAccounts")
-	return api.backend.ListAccounts()
+	if args == nil {
+		return api.backend.ListAccounts()
+	}
+	return api.backend.ListAccountsMetadata(*args)
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
@@ -0,0 +1,83 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ethermint
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/server/config"
+)
+
+// RPCLogsPage is a page of logs returned by ethermint_getLogsPaged.
+type RPCLogsPage struct {
+	Logs []*ethtypes.Log `json:"logs"`
+	// Cursor resumes the query on the next page, it is null once the whole range is returned.
+	Cursor *rpcfilters.LogCursor `json:"cursor"`
+}
+
+// PublicAPI is the ethermint_ prefixed set of APIs extending the eth namespace.
+type PublicAPI struct {
+	logger  log.Logger
+	backend rpcfilters.Backend
+}
+
+// NewPublicAPI creates an instance of the public ethermint API.
+func NewPublicAPI(logger log.Logger, backend rpcfilters.Backend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "ethermint"),
+		backend: backend,
+	}
+}
+
+// GetLogsPaged returns a page of the logs matching the filter criteria, starting at the cursor of the
+// previous page or at the start of the range if omitted. A page has at most limit logs, bounded by the
+// logs cap or its default if unset, and spans at most the block range cap, so large ranges are scanned
+// page by page.
+func (api *PublicAPI) GetLogsPaged(
+	ctx context.Context,
+	crit filters.FilterCriteria,
+	cursor *rpcfilters.LogCursor,
+	limit *hexutil.Uint,
+) (*RPCLogsPage, error) {
+	api.logger.Debug("ethermint_getLogsPaged", "cursor", cursor, "limit", limit)
+
+	logLimit := int(api.backend.RPCLogsCap())
+	if logLimit <= 0 {
+		// the pages must be bounded to make progress
+		logLimit = int(config.DefaultLogsCap)
+	}
+	if limit != nil && *limit > 0 && int(*limit) < logLimit {
+		logLimit = int(*limit)
+	}
+
+	filter := rpcfilters.NewCriteriaFilter(api.logger, api.backend, crit)
+	logs, next, err := filter.LogsPaged(ctx, cursor, logLimit, int64(api.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RPCLogsPage{
+		Logs:   logs,
+		Cursor: next,
+	}, nil
+}
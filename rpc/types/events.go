@@ -72,6 +72,8 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// the nonce of a MsgEthereumCall, which is not part of the msg
+	Nonce uint64
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -134,7 +136,7 @@ func ParseTxResult(result *abci.ResponseDeliverTx, tx sdk.Tx) (*ParsedTxs, error
 	}
 
 	// some old versions miss some events, fill it with tx result
-	if len(p.Txs) == 1 {
+	if len(p.Txs) == 1 && p.Txs[0].GasUsed == 0 {
 		p.Txs[0].GasUsed = uint64(result.GasUsed)
 	}
 
@@ -144,8 +146,11 @@ func ParseTxResult(result *abci.ResponseDeliverTx, tx sdk.Tx) (*ParsedTxs, error
 			p.Txs[i].Failed = true
 
 			// replace gasUsed with gasLimit because that's what's actually deducted.
-			gasLimit := tx.GetMsgs()[i].(*evmtypes.MsgEthereumTx).GetGas()
-			p.Txs[i].GasUsed = gasLimit
+			if i < len(tx.GetMsgs()) {
+				if ethMsg, ok := tx.GetMsgs()[i].(*evmtypes.MsgEthereumTx); ok {
+					p.Txs[i].GasUsed = ethMsg.GetGas()
+				}
+			}
 		}
 	}
 	return p, nil
@@ -260,6 +265,12 @@ func fillTxAttribute(tx *ParsedTx, key []byte, value []byte) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyTxNonce:
+		nonce, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return err
+		}
+		tx.Nonce = nonce
 	}
 	return nil
 }
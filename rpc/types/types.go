@@ -44,6 +44,22 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the trie.
+}
+
+// StorageMap maps the hashed storage keys to their storage entries. Ethermint
+// doesn't hash storage keys, so the preimage always equals the slot.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry defines a storage key preimage and its value.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash        *common.Hash         `json:"blockHash"`
@@ -102,3 +118,20 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// BTCAddressArgs represents the Bitcoin address options of the personal_listAccounts
+// metadata. An empty network selects the Bitcoin main network and an empty type selects
+// the default address type of the key.
+type BTCAddressArgs struct {
+	Network string `json:"btcNetwork"`
+	Type    string `json:"btcType"`
+}
+
+// AccountMetadata represents a keyring account along with the Bitcoin address of its key.
+// The Bitcoin address is omitted for the keys that don't support the address type.
+type AccountMetadata struct {
+	Name       string         `json:"name"`
+	Address    common.Address `json:"address"`
+	KeyType    string         `json:"keyType"`
+	BTCAddress string         `json:"btcAddress,omitempty"`
+}
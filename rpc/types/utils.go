@@ -27,7 +27,9 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -62,6 +64,102 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 	return ethTxs, nil
 }
 
+// EthereumCallMsgs returns the MsgEthereumCall messages of a cosmos tx in execution order, including
+// the ones executed through an authz MsgExec.
+func EthereumCallMsgs(tx sdk.Tx) []*evmtypes.MsgEthereumCall {
+	return ethereumCallMsgs(tx.GetMsgs())
+}
+
+func ethereumCallMsgs(msgs []sdk.Msg) []*evmtypes.MsgEthereumCall {
+	var calls []*evmtypes.MsgEthereumCall
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *evmtypes.MsgEthereumCall:
+			calls = append(calls, msg)
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				continue
+			}
+			calls = append(calls, ethereumCallMsgs(execMsgs)...)
+		}
+	}
+	return calls
+}
+
+// NewEthereumCallMsg returns a MsgEthereumCall executed with the given hash and nonce as an unsigned
+// MsgEthereumTx. The sender and the hash can't be recovered from the unsigned tx, so they are set on
+// the From and Hash fields of the msg.
+func NewEthereumCallMsg(call *evmtypes.MsgEthereumCall, hash common.Hash, nonce uint64) (*evmtypes.MsgEthereumTx, error) {
+	txData, err := evmtypes.NewTxDataFromTx(call.AsTransaction(nonce))
+	if err != nil {
+		return nil, err
+	}
+
+	anyData, err := evmtypes.PackTxData(txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &evmtypes.MsgEthereumTx{
+		Data: anyData,
+		From: call.GetFrom().Hex(),
+		Hash: hash.Hex(),
+	}, nil
+}
+
+// IsEthereumCallMsg returns true if the msg is a MsgEthereumCall built by NewEthereumCallMsg.
+func IsEthereumCallMsg(msg *evmtypes.MsgEthereumTx) bool {
+	tx := msg.AsTransaction()
+	if tx == nil || msg.From == "" {
+		return false
+	}
+	_, r, s := tx.RawSignatureValues()
+	return r.Sign() == 0 && s.Sign() == 0
+}
+
+// EthMsgsFromTx returns the msgs of a tx in the order of their ethereum_tx events, with the hashes
+// set: the MsgEthereumTx of an ethereum tx, or the MsgEthereumCall of a successful cosmos tx as
+// unsigned msgs, see NewEthereumCallMsg.
+func EthMsgsFromTx(tx sdk.Tx, result *abci.ResponseDeliverTx) ([]*evmtypes.MsgEthereumTx, error) {
+	calls := EthereumCallMsgs(tx)
+	if len(calls) == 0 {
+		var ethMsgs []*evmtypes.MsgEthereumTx
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsgs = append(ethMsgs, ethMsg)
+		}
+		return ethMsgs, nil
+	}
+
+	// failed cosmos txs don't execute any call
+	if result.Code != abci.CodeTypeOK {
+		return nil, nil
+	}
+
+	txs, err := ParseTxResult(result, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ethMsgs := make([]*evmtypes.MsgEthereumTx, len(calls))
+	for i, call := range calls {
+		parsedTx := txs.GetTxByMsgIndex(i)
+		if parsedTx == nil {
+			return nil, fmt.Errorf("ethereum call %d not found in events", i)
+		}
+		ethMsgs[i], err = NewEthereumCallMsg(call, parsedTx.Hash, parsedTx.Nonce)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ethMsgs, nil
+}
+
 // EthHeaderFromTendermint is an util function that returns an Ethereum Header
 // from a tendermint Header.
 func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFee *big.Int) *ethtypes.Header {
@@ -163,7 +261,15 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	rpcTx, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if IsEthereumCallMsg(msg) {
+		rpcTx.From = common.HexToAddress(msg.From)
+		rpcTx.Hash = common.HexToHash(msg.Hash)
+	}
+	return rpcTx, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
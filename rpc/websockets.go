@@ -16,15 +16,14 @@
 package rpc
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"math/big"
-	"net"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -52,10 +51,23 @@ type WebsocketsServer interface {
 	Start()
 }
 
-type SubscriptionResponseJSON struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	Result  interface{} `json:"result"`
-	ID      float64     `json:"id"`
+// JSON-RPC error codes of the websocket responses, as returned by the go-ethereum server.
+const (
+	errcodeDefault        = -32000
+	errcodeParse          = -32700
+	errcodeInvalidRequest = -32600
+	errcodeInvalidParams  = -32602
+)
+
+// errSlowConsumer is returned when the send buffer of a websocket client is full.
+var errSlowConsumer = errors.New("websocket client is too slow, dropping connection")
+
+// ResponseJSON is the response to a JSON-RPC request, holding either a result or an error.
+type ResponseJSON struct {
+	Jsonrpc string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Result  interface{}       `json:"result,omitempty"`
+	Error   *ErrorMessageJSON `json:"error,omitempty"`
 }
 
 type SubscriptionNotification struct {
@@ -69,36 +81,70 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
-type ErrorResponseJSON struct {
-	Jsonrpc string            `json:"jsonrpc"`
-	Error   *ErrorMessageJSON `json:"error"`
-	ID      *big.Int          `json:"id"`
+type ErrorMessageJSON struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
-type ErrorMessageJSON struct {
-	Code    *big.Int `json:"code"`
-	Message string   `json:"message"`
+// newErrorMessage converts an error of the JSON-RPC server, keeping its code and data.
+func newErrorMessage(err error) *ErrorMessageJSON {
+	msg := &ErrorMessageJSON{
+		Code:    errcodeDefault,
+		Message: err.Error(),
+	}
+
+	var codeErr rpc.Error
+	if errors.As(err, &codeErr) {
+		msg.Code = codeErr.ErrorCode()
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		msg.Data = dataErr.ErrorData()
+	}
+
+	return msg
+}
+
+// wsRequest is a JSON-RPC request read from a websocket client.
+type wsRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
 }
 
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
+	client   *rpc.Client // in-process client of the JSON-RPC server
+	methods  rpcMethods
+	cfg      config.JSONRPCConfig
 	logger   log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+// NewWebsocketsServer creates the websocket server, serving the subscriptions and forwarding the other
+// requests to the given JSON-RPC server in-process.
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	rpcServer *rpc.Server,
+	apis []rpc.API,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
-	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		client:   rpc.DialInProc(rpcServer),
+		methods:  newRPCMethods(apis),
+		cfg:      cfg.JSONRPC,
 		logger:   logger,
 	}
 }
@@ -139,207 +185,439 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConnections.Inc()
+	defer wsConnections.Dec()
+
+	wsConn := newWSConn(conn, s.cfg, s.logger)
+	go wsConn.writeLoop()
+	s.readLoop(wsConn)
 }
 
-func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
-	res := &ErrorResponseJSON{
-		Jsonrpc: "2.0",
-		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
-			Message: msg,
-		},
-		ID: nil,
+// wsConn is a websocket connection whose messages are queued in a bounded send buffer, written by
+// the writeLoop. The clients not reading fast enough to drain the buffer are disconnected, so that
+// they don't hold the subscriptions back.
+type wsConn struct {
+	conn         *websocket.Conn
+	send         chan []byte
+	closed       chan struct{}
+	closeOnce    sync.Once
+	pingInterval time.Duration
+	writeTimeout time.Duration
+	logger       log.Logger
+}
+
+func newWSConn(conn *websocket.Conn, cfg config.JSONRPCConfig, logger log.Logger) *wsConn {
+	conn.SetReadLimit(cfg.WSMaxMessageSize)
+
+	w := &wsConn{
+		conn:         conn,
+		send:         make(chan []byte, cfg.WSSendBufferSize),
+		closed:       make(chan struct{}),
+		pingInterval: cfg.WSPingInterval,
+		writeTimeout: cfg.WSWriteTimeout,
+		logger:       logger,
 	}
 
-	_ = wsConn.WriteJSON(res)
+	if w.pingInterval > 0 {
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(w.pongWait()))
+		})
+	}
+
+	return w
 }
 
-type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+// pongWait is the time allowed to the client to answer a ping.
+func (w *wsConn) pongWait() time.Duration {
+	return 2 * w.pingInterval
 }
 
+// WriteJSON queues a message for the client, it closes the connection if the send buffer is full.
 func (w *wsConn) WriteJSON(v interface{}) error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	return w.conn.WriteJSON(v)
+	select {
+	case <-w.closed:
+		return websocket.ErrCloseSent
+	default:
+	}
+
+	select {
+	case w.send <- bz:
+		return nil
+	default:
+		w.logger.Debug("websocket send buffer full, evicting slow client", "remote", w.conn.RemoteAddr().String())
+		_ = w.Close()
+		return errSlowConsumer
+	}
 }
 
+// Close closes the connection, it can be called multiple times.
 func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
-
-	return w.conn.Close()
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.closed)
+		err = w.conn.Close()
+	})
+	return err
 }
 
 func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
-	// not protected by write mutex
+	if w.pingInterval > 0 {
+		if err := w.conn.SetReadDeadline(time.Now().Add(w.pongWait())); err != nil {
+			return 0, nil, err
+		}
+	}
 
 	return w.conn.ReadMessage()
 }
 
+// writeLoop writes the queued messages and the keepalive pings, it is the only writer of the
+// connection and returns once it is closed.
+func (w *wsConn) writeLoop() {
+	var pings <-chan time.Time
+	if w.pingInterval > 0 {
+		ticker := time.NewTicker(w.pingInterval)
+		defer ticker.Stop()
+		pings = ticker.C
+	}
+
+	for {
+		var err error
+		select {
+		case bz := <-w.send:
+			err = w.write(websocket.TextMessage, bz)
+		case <-pings:
+			err = w.write(websocket.PingMessage, nil)
+		case <-w.closed:
+			return
+		}
+
+		if err != nil {
+			w.logger.Debug("websocket write error, closing connection", "error", err.Error())
+			_ = w.Close()
+			return
+		}
+	}
+}
+
+func (w *wsConn) write(messageType int, data []byte) error {
+	if w.writeTimeout > 0 {
+		if err := w.conn.SetWriteDeadline(time.Now().Add(w.writeTimeout)); err != nil {
+			return err
+		}
+	}
+
+	return w.conn.WriteMessage(messageType, data)
+}
+
 func (s *websocketsServer) readLoop(wsConn *wsConn) {
+	// cancel the pending requests when connection closed
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// the messages are served concurrently, up to the max number of concurrent requests
+	var (
+		pending sync.WaitGroup
+		slots   = make(chan struct{}, s.cfg.WSMaxConcurrentRequests)
+	)
+
 	// subscriptions of current connection
-	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
+	subscriptions := newConnSubscriptions()
 	defer func() {
+		cancel()
+		// wait for the pending requests, which could still subscribe
+		pending.Wait()
 		// cancel all subscriptions when connection closed
-		for _, unsubFn := range subscriptions {
-			unsubFn()
-		}
+		subscriptions.unsubscribeAll()
 	}()
 
 	for {
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
 			_ = wsConn.Close()
-			s.logger.Error("read message error, breaking read loop", "error", err.Error())
+			s.logger.Debug("read message error, breaking read loop", "error", err.Error())
 			return
 		}
 
-		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+		// the subscription requests are served in order, e.g. an eth_unsubscribe following an
+		// eth_subscribe finds the subscription
+		if isSubscriptionRequest(mb) {
+			s.serveMessage(ctx, wsConn, subscriptions, mb)
 			continue
 		}
 
-		var msg map[string]interface{}
-		if err = json.Unmarshal(mb, &msg); err != nil {
-			s.sendErrResponse(wsConn, err.Error())
-			continue
-		}
+		slots <- struct{}{}
+		pending.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				pending.Done()
+			}()
+			s.serveMessage(ctx, wsConn, subscriptions, mb)
+		}()
+	}
+}
 
-		// check if method == eth_subscribe or eth_unsubscribe
-		method, ok := msg["method"].(string)
-		if !ok {
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+// serveMessage serves a message and writes its response, the connection is closed if the response
+// can't be written, which breaks the read loop.
+func (s *websocketsServer) serveMessage(
+	ctx context.Context,
+	wsConn *wsConn,
+	subscriptions *connSubscriptions,
+	mb []byte,
+) {
+	res := s.handleMessage(ctx, wsConn, subscriptions, mb)
+	if res == nil {
+		return
+	}
 
-			continue
+	if err := wsConn.WriteJSON(res); err != nil {
+		_ = wsConn.Close()
+		s.logger.Debug("write response error, closing connection", "error", err.Error())
+	}
+}
+
+// handleMessage serves a single or a batch request, it returns the response to write, or nil if
+// there is none.
+func (s *websocketsServer) handleMessage(
+	ctx context.Context,
+	wsConn *wsConn,
+	subscriptions *connSubscriptions,
+	mb []byte,
+) interface{} {
+	if !isBatch(mb) {
+		if res := s.handleRequest(ctx, wsConn, subscriptions, mb); res != nil {
+			return res
 		}
+		return nil
+	}
 
-		connID, ok := msg["id"].(float64)
-		if !ok {
-			s.sendErrResponse(
-				wsConn,
-				fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
-			)
-			continue
+	var reqs []json.RawMessage
+	if err := json.Unmarshal(mb, &reqs); err != nil {
+		return newErrorResponse(nil, &ErrorMessageJSON{Code: errcodeParse, Message: err.Error()})
+	}
+
+	if len(reqs) == 0 {
+		return newErrorResponse(nil, &ErrorMessageJSON{Code: errcodeInvalidRequest, Message: "empty batch"})
+	}
+
+	if max := s.cfg.WSMaxBatchSize; max > 0 && len(reqs) > max {
+		return newErrorResponse(nil, &ErrorMessageJSON{
+			Code:    errcodeInvalidRequest,
+			Message: fmt.Sprintf("batch too large, the max is %d requests", max),
+		})
+	}
+
+	responses := make([]*ResponseJSON, 0, len(reqs))
+	for _, req := range reqs {
+		if res := s.handleRequest(ctx, wsConn, subscriptions, req); res != nil {
+			responses = append(responses, res)
 		}
+	}
 
-		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+	if len(responses) == 0 {
+		return nil
+	}
+	return responses
+}
 
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
-			}
-			subscriptions[subID] = unsubFn
+// handleRequest serves a JSON-RPC request, it returns nil for the notifications, i.e. the requests
+// without id.
+func (s *websocketsServer) handleRequest(
+	ctx context.Context,
+	wsConn *wsConn,
+	subscriptions *connSubscriptions,
+	raw json.RawMessage,
+) *ResponseJSON {
+	var req wsRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return newErrorResponse(nil, &ErrorMessageJSON{Code: errcodeParse, Message: err.Error()})
+	}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  subID,
-			}
+	if req.Method == "" {
+		return newErrorResponse(req.ID, &ErrorMessageJSON{Code: errcodeInvalidRequest, Message: "invalid request"})
+	}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				break
-			}
-		case "eth_unsubscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+	var (
+		method = req.Method
+		result interface{}
+		rpcErr *ErrorMessageJSON
+		start  = time.Now()
+	)
+
+	switch req.Method {
+	case "eth_subscribe":
+		result, rpcErr = s.subscribe(wsConn, subscriptions, req.Params)
+	case "eth_unsubscribe":
+		result, rpcErr = s.unsubscribe(subscriptions, req.Params)
+	default:
+		method = s.methods.label(req.Method)
+		result, rpcErr = s.call(ctx, &req)
+	}
 
-			id, ok := params[0].(string)
-			if !ok {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
+	requestsCounter.WithLabelValues(method).Inc()
+	requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
 
-			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+	if rpcErr != nil {
+		errorsCounter.WithLabelValues(method, strconv.Itoa(rpcErr.Code)).Inc()
+	}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  ok,
-			}
+	if len(req.ID) == 0 {
+		return nil
+	}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				break
-			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+	if rpcErr != nil {
+		return newErrorResponse(req.ID, rpcErr)
+	}
+
+	return &ResponseJSON{
+		Jsonrpc: "2.0",
+		ID:      req.ID,
+		Result:  result,
+	}
+}
+
+func newErrorResponse(id json.RawMessage, rpcErr *ErrorMessageJSON) *ResponseJSON {
+	return &ResponseJSON{
+		Jsonrpc: "2.0",
+		ID:      id,
+		Error:   rpcErr,
+	}
+}
+
+// subscribe serves eth_subscribe, up to the max number of subscriptions of the connection.
+func (s *websocketsServer) subscribe(
+	wsConn *wsConn,
+	subscriptions *connSubscriptions,
+	rawParams json.RawMessage,
+) (interface{}, *ErrorMessageJSON) {
+	params, rpcErr := decodeSubscriptionParams(rawParams)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	subscriptions.mu.Lock()
+	defer subscriptions.mu.Unlock()
+
+	if max := s.cfg.WSMaxSubscriptions; max > 0 && len(subscriptions.subs) >= max {
+		return nil, &ErrorMessageJSON{
+			Code:    errcodeDefault,
+			Message: fmt.Sprintf("too many subscriptions, the max is %d per connection", max),
 		}
 	}
+
+	subID := rpc.NewID()
+	unsubFn, err := s.api.subscribe(wsConn, subID, params)
+	if err != nil {
+		return nil, &ErrorMessageJSON{Code: errcodeDefault, Message: err.Error()}
+	}
+
+	// the kind is valid once subscribed
+	subGauge := wsSubscriptions.WithLabelValues(params[0].(string))
+	subGauge.Inc()
+	subscriptions.subs[subID] = func() {
+		unsubFn()
+		subGauge.Dec()
+	}
+
+	return subID, nil
 }
 
-// tcpGetAndSendResponse sends error response to client if params is invalid
-func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, wsConn *wsConn) ([]interface{}, bool) {
-	params, ok := msg["params"].([]interface{})
+// unsubscribe serves eth_unsubscribe, it returns false if the subscription is not found.
+func (s *websocketsServer) unsubscribe(
+	subscriptions *connSubscriptions,
+	rawParams json.RawMessage,
+) (interface{}, *ErrorMessageJSON) {
+	params, rpcErr := decodeSubscriptionParams(rawParams)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	id, ok := params[0].(string)
 	if !ok {
-		s.sendErrResponse(wsConn, "invalid parameters")
-		return nil, false
+		return nil, &ErrorMessageJSON{Code: errcodeInvalidParams, Message: "invalid parameters"}
+	}
+
+	subID := rpc.ID(id)
+	subscriptions.mu.Lock()
+	unsubFn, ok := subscriptions.subs[subID]
+	delete(subscriptions.subs, subID)
+	subscriptions.mu.Unlock()
+	if ok {
+		unsubFn()
+	}
+
+	return ok, nil
+}
+
+// decodeSubscriptionParams decodes the non-empty parameters of eth_subscribe and eth_unsubscribe.
+func decodeSubscriptionParams(rawParams json.RawMessage) ([]interface{}, *ErrorMessageJSON) {
+	var params []interface{}
+	if err := json.Unmarshal(rawParams, &params); err != nil {
+		return nil, &ErrorMessageJSON{Code: errcodeInvalidParams, Message: "invalid parameters"}
 	}
 
 	if len(params) == 0 {
-		s.sendErrResponse(wsConn, "empty parameters")
-		return nil, false
+		return nil, &ErrorMessageJSON{Code: errcodeInvalidParams, Message: "empty parameters"}
 	}
 
-	return params, true
+	return params, nil
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
-	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
-	if err != nil {
-		return errors.Wrap(err, "Could not build request")
+// connSubscriptions are the subscriptions of a connection, whose requests are served concurrently.
+type connSubscriptions struct {
+	mu   sync.Mutex
+	subs map[rpc.ID]pubsub.UnsubscribeFunc
+}
+
+func newConnSubscriptions() *connSubscriptions {
+	return &connSubscriptions{subs: make(map[rpc.ID]pubsub.UnsubscribeFunc)}
+}
+
+// unsubscribeAll cancels all the subscriptions.
+func (cs *connSubscriptions) unsubscribeAll() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	for subID, unsubFn := range cs.subs {
+		delete(cs.subs, subID)
+		unsubFn()
 	}
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+// call forwards a request to the JSON-RPC server, the result is returned as is. The request is
+// canceled after the request timeout.
+func (s *websocketsServer) call(ctx context.Context, req *wsRequest) (interface{}, *ErrorMessageJSON) {
+	var params []json.RawMessage
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &ErrorMessageJSON{Code: errcodeInvalidParams, Message: "non-array args"}
+		}
 	}
 
-	defer resp.Body.Close()
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
+	if timeout := s.cfg.WSRequestTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, req.Method, args...); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, &ErrorMessageJSON{Code: errcodeDefault, Message: "request timed out"}
+		}
+		return nil, newErrorMessage(err)
 	}
 
-	return wsConn.WriteJSON(wsSend)
+	return result, nil
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
@@ -686,6 +964,18 @@ func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeF
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
+// isSubscriptionRequest returns true if the message is a single eth_subscribe or eth_unsubscribe request.
+func isSubscriptionRequest(raw []byte) bool {
+	if isBatch(raw) {
+		return false
+	}
+	var req wsRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return false
+	}
+	return req.Method == "eth_subscribe" || req.Method == "eth_unsubscribe"
+}
+
 // isBatch returns true when the first non-whitespace characters is '['
 func isBatch(raw []byte) bool {
 	for _, c := range raw {
@@ -0,0 +1,181 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+func newTestWebsocketsServer(t *testing.T, cfg config.JSONRPCConfig) (*websocketsServer, *websocket.Conn) {
+	server := rpc.NewServer()
+	apis := []rpc.API{{Namespace: "test", Service: testService{}}}
+	require.NoError(t, server.RegisterName("test", testService{}))
+
+	s := &websocketsServer{
+		client:  rpc.DialInProc(server),
+		methods: newRPCMethods(apis),
+		cfg:     cfg,
+		logger:  log.NewNopLogger(),
+	}
+
+	httpSrv := httptest.NewServer(s)
+	t.Cleanup(httpSrv.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpSrv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return s, conn
+}
+
+func TestWebsocketsServer(t *testing.T) {
+	_, conn := newTestWebsocketsServer(t, *config.DefaultJSONRPCConfig())
+
+	roundTrip := func(req string) string {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		_, res, err := conn.ReadMessage()
+		require.NoError(t, err)
+		return string(res)
+	}
+
+	testCases := []struct {
+		name     string
+		req      string
+		expected string
+	}{
+		{
+			"string id",
+			`{"jsonrpc":"2.0","id":"a","method":"test_echo","params":["hello"]}`,
+			`{"jsonrpc":"2.0","id":"a","result":"hello"}`,
+		},
+		{
+			"invalid params",
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[1]}`,
+			`"code":-32602`,
+		},
+		{
+			"method not found",
+			`{"jsonrpc":"2.0","id":1,"method":"test_foo"}`,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32601`,
+		},
+		{
+			"batch",
+			`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","method":"test_echo","params":["b"]},{"jsonrpc":"2.0","id":2,"method":"eth_unsubscribe","params":["0x1"]}]`, //nolint:lll
+			`[{"jsonrpc":"2.0","id":1,"result":"a"},{"jsonrpc":"2.0","id":2,"result":false}]`,
+		},
+		{
+			"empty batch",
+			`[]`,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"empty batch"}}`,
+		},
+		{
+			"parse error",
+			`{"jsonrpc":"2.0","id":1,"method":`,
+			`"code":-32700`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, roundTrip(tc.req), tc.expected)
+		})
+	}
+}
+
+func TestWebsocketsServerMaxMessageSize(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.WSMaxMessageSize = 128
+	_, conn := newTestWebsocketsServer(t, *cfg)
+
+	req := `{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["` + strings.Repeat("a", 128) + `"]}`
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+
+	// the connection is closed by the server
+	_, _, err := conn.ReadMessage()
+	require.Error(t, err)
+}
+
+func TestWebsocketsServerMaxSubscriptions(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.WSMaxSubscriptions = 1
+	s, _ := newTestWebsocketsServer(t, *cfg)
+
+	subscriptions := newConnSubscriptions()
+	subscriptions.subs[rpc.NewID()] = func() {}
+	_, rpcErr := s.subscribe(nil, subscriptions, []byte(`["newHeads"]`))
+	require.NotNil(t, rpcErr)
+	require.Contains(t, rpcErr.Message, "too many subscriptions")
+}
+
+func TestWebsocketsServerMaxBatchSize(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.WSMaxBatchSize = 1
+	_, conn := newTestWebsocketsServer(t, *cfg)
+
+	req := `[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["b"]}]`
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+	_, res, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(res), `"code":-32600,"message":"batch too large, the max is 1 requests"`)
+}
+
+func TestWebsocketsServerConcurrentRequests(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.WSRequestTimeout = time.Second
+	_, conn := newTestWebsocketsServer(t, *cfg)
+
+	// the slow request doesn't hold back the next one, and times out
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"test_sleep","params":[60000]}`)))
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["a"]}`)))
+
+	_, res, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","id":2,"result":"a"}`, string(res))
+
+	_, res, err = conn.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(res), `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"request timed out"}}`)
+}
+
+func TestWSConnSlowConsumer(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.WSSendBufferSize = 1
+	cfg.WSPingInterval = 0
+
+	connCh := make(chan *wsConn, 1)
+	upgrader := websocket.Upgrader{}
+	httpSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		// the writeLoop is not started, so nothing drains the send buffer
+		connCh <- newWSConn(conn, *cfg, log.NewNopLogger())
+	}))
+	defer httpSrv.Close()
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpSrv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var wsConn *wsConn
+	select {
+	case wsConn = <-connCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("websocket connection not accepted")
+	}
+
+	require.NoError(t, wsConn.WriteJSON("a"))
+	require.ErrorIs(t, wsConn.WriteJSON("b"), errSlowConsumer)
+	require.ErrorIs(t, wsConn.WriteJSON("c"), websocket.ErrCloseSent)
+	require.NoError(t, wsConn.Close())
+}
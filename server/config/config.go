@@ -40,6 +40,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAuthAddress is the default address the JWT authenticated JSON-RPC server binds to.
+	DefaultJSONRPCAuthAddress = "127.0.0.1:8551"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -61,6 +64,36 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultBlockCacheSize is the default number of entries of the JSON-RPC block caches
+	DefaultBlockCacheSize = 256
+
+	// DefaultReceiptCacheSize is the default number of entries of the JSON-RPC receipt cache
+	DefaultReceiptCacheSize = 1024
+
+	// DefaultWSMaxSubscriptions is the default max number of subscriptions of a websocket connection
+	DefaultWSMaxSubscriptions = 100
+
+	// DefaultWSMaxMessageSize is the default max size in bytes of the websocket messages read from the clients
+	DefaultWSMaxMessageSize int64 = 15 * 1024 * 1024
+
+	// DefaultWSPingInterval is the default interval of the keepalive pings of the websocket connections
+	DefaultWSPingInterval = 30 * time.Second
+
+	// DefaultWSWriteTimeout is the default timeout of the websocket writes
+	DefaultWSWriteTimeout = 10 * time.Second
+
+	// DefaultWSSendBufferSize is the default number of messages buffered for a websocket client
+	DefaultWSSendBufferSize = 256
+
+	// DefaultWSMaxConcurrentRequests is the default max number of requests served concurrently for a websocket connection
+	DefaultWSMaxConcurrentRequests = 16
+
+	// DefaultWSMaxBatchSize is the default max number of requests of a websocket batch
+	DefaultWSMaxBatchSize = 100
+
+	// DefaultWSRequestTimeout is the default timeout of the websocket requests
+	DefaultWSRequestTimeout = 30 * time.Second
+
 	DefaultEVMTimeout = 5 * time.Second
 
 	// default 1.0 eth
@@ -75,10 +108,35 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// IndexerBackendKV is the indexer backend storing the eth txs in a tm-db key-value database
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQLite is the indexer backend storing the eth txs, receipts and logs in a SQLite database
+	IndexerBackendSQLite = "sqlite"
+
+	// IndexerBackendPostgres is the indexer backend storing the eth txs, receipts and logs in a Postgres database
+	IndexerBackendPostgres = "postgres"
+
+	// DefaultIndexerBackend is the default backend of the custom eth tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// BlockSinkNDJSON is the block sink writing newline delimited JSON files
+	BlockSinkNDJSON = "ndjson"
+
+	// BlockSinkUnix is the block sink sending newline delimited JSON to a unix socket
+	BlockSinkUnix = "unix"
+
+	// DefaultBlockSinkMaxFileSize is the default size of the NDJSON files before rotation (128 MiB)
+	DefaultBlockSinkMaxFileSize int64 = 128 << 20
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+var indexerBackends = []string{IndexerBackendKV, IndexerBackendSQLite, IndexerBackendPostgres}
+
+var blockSinks = []string{BlockSinkNDJSON, BlockSinkUnix}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -106,6 +164,14 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// AuthAddress defines the HTTP and WebSocket server authenticated with JWT to listen on
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthAPI defines a list of JSON-RPC namespaces served exclusively by the authenticated server,
+	// which is disabled if empty
+	AuthAPI []string `mapstructure:"auth-api"`
+	// AuthJWTSecret defines the file of the hex encoded secret signing the JWT tokens, generated if it
+	// doesn't exist (default: <home>/config/jwtsecret)
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// EVMTimeout is the global timeout for eth-call.
@@ -122,6 +188,32 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// BlockCacheSize defines the max number of entries of the caches of the blocks, block results and
+	// formatted RPC blocks (0=disabled).
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// ReceiptCacheSize defines the max number of entries of the cache of the transaction receipts (0=disabled).
+	ReceiptCacheSize int `mapstructure:"receipt-cache-size"`
+	// WSMaxSubscriptions defines the max number of subscriptions of a websocket connection (0=unlimited).
+	WSMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WSMaxMessageSize defines the max size in bytes of the messages read from the websocket clients
+	// (0=default).
+	WSMaxMessageSize int64 `mapstructure:"ws-max-message-size"`
+	// WSPingInterval is the interval of the pings keeping alive the websocket connections, the connections
+	// not answering within two intervals are closed (0=disabled).
+	WSPingInterval time.Duration `mapstructure:"ws-ping-interval"`
+	// WSWriteTimeout is the write timeout of the websocket connections (0=infinite).
+	WSWriteTimeout time.Duration `mapstructure:"ws-write-timeout"`
+	// WSSendBufferSize defines the max number of messages queued for a websocket client, the clients
+	// falling behind are disconnected (0=default).
+	WSSendBufferSize int `mapstructure:"ws-send-buffer-size"`
+	// WSMaxConcurrentRequests defines the max number of requests, or batches, served concurrently for a
+	// websocket connection, the connection isn't read until one of them is served (0=default).
+	WSMaxConcurrentRequests int `mapstructure:"ws-max-concurrent-requests"`
+	// WSMaxBatchSize defines the max number of requests of a websocket batch (0=unlimited).
+	WSMaxBatchSize int `mapstructure:"ws-max-batch-size"`
+	// WSRequestTimeout is the timeout of the requests forwarded to the JSON-RPC server from the
+	// websocket connections (0=infinite).
+	WSRequestTimeout time.Duration `mapstructure:"ws-request-timeout"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -134,6 +226,25 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackend defines the database of the custom indexer (kv|sqlite|postgres).
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerDSN defines the data source name of the sql indexer backends, the sqlite backend
+	// defaults to a file in the data directory.
+	IndexerDSN string `mapstructure:"indexer-dsn"`
+	// IndexerRetainBlocks defines the number of recent blocks kept by the custom indexer, which always
+	// prunes the blocks pruned by the node (0=keep all the blocks of the node).
+	IndexerRetainBlocks uint64 `mapstructure:"indexer-retain-blocks"`
+	// IndexerKeepTxHash defines if the pruning of the custom indexer keeps the entries of the eth tx hashes.
+	IndexerKeepTxHash bool `mapstructure:"indexer-keep-tx-hash"`
+	// BlockSink defines the sink exporting the indexed blocks, receipts and logs (ndjson|unix), disabled if empty.
+	BlockSink string `mapstructure:"block-sink"`
+	// BlockSinkPath defines the directory of the ndjson files, or the path of the unix socket.
+	BlockSinkPath string `mapstructure:"block-sink-path"`
+	// BlockSinkMaxFileSize defines the size of the ndjson files before rotation.
+	BlockSinkMaxFileSize int64 `mapstructure:"block-sink-max-file-size"`
+	// BlockSinkReplayFrom defines the height from which the blocks are exported again on start,
+	// instead of resuming from the cursor. A replay height is only applied once.
+	BlockSinkReplayFrom int64 `mapstructure:"block-sink-replay-from"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -217,7 +328,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "b2", "ethermint"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -227,6 +338,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                      GetDefaultAPINamespaces(),
 		Address:                  DefaultJSONRPCAddress,
 		WsAddress:                DefaultJSONRPCWsAddress,
+		AuthAddress:              DefaultJSONRPCAuthAddress,
+		AuthAPI:                  []string{},
 		GasCap:                   DefaultGasCap,
 		EVMTimeout:               DefaultEVMTimeout,
 		TxFeeCap:                 DefaultTxFeeCap,
@@ -234,11 +347,23 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FeeHistoryCap:            DefaultFeeHistoryCap,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
+		BlockCacheSize:           DefaultBlockCacheSize,
+		ReceiptCacheSize:         DefaultReceiptCacheSize,
+		WSMaxSubscriptions:       DefaultWSMaxSubscriptions,
+		WSMaxMessageSize:         DefaultWSMaxMessageSize,
+		WSPingInterval:           DefaultWSPingInterval,
+		WSWriteTimeout:           DefaultWSWriteTimeout,
+		WSSendBufferSize:         DefaultWSSendBufferSize,
+		WSMaxConcurrentRequests:  DefaultWSMaxConcurrentRequests,
+		WSMaxBatchSize:           DefaultWSMaxBatchSize,
+		WSRequestTimeout:         DefaultWSRequestTimeout,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		IndexerBackend:           DefaultIndexerBackend,
+		BlockSinkMaxFileSize:     DefaultBlockSinkMaxFileSize,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -250,6 +375,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if len(c.AuthAPI) > 0 && c.AuthAddress == "" {
+		return errors.New("cannot serve the JSON-RPC auth namespaces without defining the auth address")
+	}
+
 	if c.FilterCap < 0 {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
@@ -274,6 +403,46 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
+	if c.ReceiptCacheSize < 0 {
+		return errors.New("JSON-RPC receipt cache size cannot be negative")
+	}
+
+	if c.WSMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC websocket max subscriptions cannot be negative")
+	}
+
+	if c.WSMaxMessageSize < 0 {
+		return errors.New("JSON-RPC websocket max message size cannot be negative")
+	}
+
+	if c.WSPingInterval < 0 {
+		return errors.New("JSON-RPC websocket ping interval cannot be negative")
+	}
+
+	if c.WSWriteTimeout < 0 {
+		return errors.New("JSON-RPC websocket write timeout cannot be negative")
+	}
+
+	if c.WSSendBufferSize < 0 {
+		return errors.New("JSON-RPC websocket send buffer size cannot be negative")
+	}
+
+	if c.WSMaxConcurrentRequests < 0 {
+		return errors.New("JSON-RPC websocket max concurrent requests cannot be negative")
+	}
+
+	if c.WSMaxBatchSize < 0 {
+		return errors.New("JSON-RPC websocket max batch size cannot be negative")
+	}
+
+	if c.WSRequestTimeout < 0 {
+		return errors.New("JSON-RPC websocket request timeout cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -282,6 +451,30 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.IndexerBackend != "" && !strings.StringInSlice(c.IndexerBackend, indexerBackends) {
+		return fmt.Errorf("invalid indexer backend %s, available backends: %v", c.IndexerBackend, indexerBackends)
+	}
+
+	if c.IndexerBackend == IndexerBackendPostgres && c.IndexerDSN == "" {
+		return errors.New("JSON-RPC indexer DSN is required by the postgres indexer backend")
+	}
+
+	if c.BlockSink != "" && !strings.StringInSlice(c.BlockSink, blockSinks) {
+		return fmt.Errorf("invalid block sink %s, available sinks: %v", c.BlockSink, blockSinks)
+	}
+
+	if c.BlockSink == BlockSinkUnix && c.BlockSinkPath == "" {
+		return errors.New("JSON-RPC block sink path is required by the unix block sink")
+	}
+
+	if c.BlockSinkMaxFileSize < 0 {
+		return errors.New("JSON-RPC block sink max file size cannot be negative")
+	}
+
+	if c.BlockSinkReplayFrom < 0 {
+		return errors.New("JSON-RPC block sink replay height cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -295,6 +488,19 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// setWSDefaults sets the websocket limits left unset (0) to their defaults.
+func (c *JSONRPCConfig) setWSDefaults() {
+	if c.WSMaxMessageSize == 0 {
+		c.WSMaxMessageSize = DefaultWSMaxMessageSize
+	}
+	if c.WSSendBufferSize == 0 {
+		c.WSSendBufferSize = DefaultWSSendBufferSize
+	}
+	if c.WSMaxConcurrentRequests == 0 {
+		c.WSMaxConcurrentRequests = DefaultWSMaxConcurrentRequests
+	}
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -327,7 +533,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		return Config{}, err
 	}
 
-	return Config{
+	conf := Config{
 		Config: cfg,
 		EVM: EVMConfig{
 			Tracer:         v.GetString("evm.tracer"),
@@ -338,6 +544,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			API:                      v.GetStringSlice("json-rpc.api"),
 			Address:                  v.GetString("json-rpc.address"),
 			WsAddress:                v.GetString("json-rpc.ws-address"),
+			AuthAddress:              v.GetString("json-rpc.auth-address"),
+			AuthAPI:                  v.GetStringSlice("json-rpc.auth-api"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
 			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
 			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
 			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
@@ -345,11 +554,29 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
 			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
+			BlockCacheSize:           v.GetInt("json-rpc.block-cache-size"),
+			ReceiptCacheSize:         v.GetInt("json-rpc.receipt-cache-size"),
+			WSMaxSubscriptions:       v.GetInt("json-rpc.ws-max-subscriptions"),
+			WSMaxMessageSize:         v.GetInt64("json-rpc.ws-max-message-size"),
+			WSPingInterval:           v.GetDuration("json-rpc.ws-ping-interval"),
+			WSWriteTimeout:           v.GetDuration("json-rpc.ws-write-timeout"),
+			WSSendBufferSize:         v.GetInt("json-rpc.ws-send-buffer-size"),
+			WSMaxConcurrentRequests:  v.GetInt("json-rpc.ws-max-concurrent-requests"),
+			WSMaxBatchSize:           v.GetInt("json-rpc.ws-max-batch-size"),
+			WSRequestTimeout:         v.GetDuration("json-rpc.ws-request-timeout"),
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
 			AllowUnprotectedTxs:      v.GetBool("json-rpc.allow-unprotected-txs"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			IndexerBackend:           v.GetString("json-rpc.indexer-backend"),
+			IndexerDSN:               v.GetString("json-rpc.indexer-dsn"),
+			IndexerRetainBlocks:      v.GetUint64("json-rpc.indexer-retain-blocks"),
+			IndexerKeepTxHash:        v.GetBool("json-rpc.indexer-keep-tx-hash"),
+			BlockSink:                v.GetString("json-rpc.block-sink"),
+			BlockSinkPath:            v.GetString("json-rpc.block-sink-path"),
+			BlockSinkMaxFileSize:     v.GetInt64("json-rpc.block-sink-max-file-size"),
+			BlockSinkReplayFrom:      v.GetInt64("json-rpc.block-sink-replay-from"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 		},
@@ -357,7 +584,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
-	}, nil
+	}
+
+	// config files created before the websocket limits were added miss them
+	conf.JSONRPC.setWSDefaults()
+	return conf, nil
 }
 
 // ParseConfig retrieves the default environment configuration for the
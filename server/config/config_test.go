@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +13,16 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestGetConfigWSDefaults(t *testing.T) {
+	v := viper.New()
+	v.Set("json-rpc.ws-send-buffer-size", 0)
+	v.Set("json-rpc.ws-max-batch-size", 0)
+
+	cfg, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, DefaultWSMaxMessageSize, cfg.JSONRPC.WSMaxMessageSize)
+	require.Equal(t, DefaultWSSendBufferSize, cfg.JSONRPC.WSSendBufferSize)
+	require.Equal(t, DefaultWSMaxConcurrentRequests, cfg.JSONRPC.WSMaxConcurrentRequests)
+	require.Equal(t, 0, cfg.JSONRPC.WSMaxBatchSize)
+}
@@ -42,6 +42,12 @@ const (
 	GRPCWebAddress = "grpc-web.address"
 )
 
+// JSON-RPC gateway flags
+const (
+	// GatewayGRPCAddress is the gRPC endpoint of the remote node queried by the JSON-RPC gateway.
+	GatewayGRPCAddress = "grpc-addr"
+)
+
 // Cosmos API flags
 const (
 	RPCEnable         = "api.enable"
@@ -54,17 +60,38 @@ const (
 	JSONRPCAPI                 = "json-rpc.api"
 	JSONRPCAddress             = "json-rpc.address"
 	JSONWsAddress              = "json-rpc.ws-address"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthAPI             = "json-rpc.auth-api"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
 	JSONRPCLogsCap             = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
+	JSONRPCBlockCacheSize      = "json-rpc.block-cache-size"
+	JSONRPCReceiptCacheSize    = "json-rpc.receipt-cache-size"
+	JSONRPCWSMaxSubscriptions  = "json-rpc.ws-max-subscriptions"
+	JSONRPCWSMaxMessageSize    = "json-rpc.ws-max-message-size"
+	JSONRPCWSPingInterval      = "json-rpc.ws-ping-interval"
+	JSONRPCWSWriteTimeout      = "json-rpc.ws-write-timeout"
+	JSONRPCWSSendBufferSize    = "json-rpc.ws-send-buffer-size"
+	JSONRPCWSMaxConcurrent     = "json-rpc.ws-max-concurrent-requests"
+	JSONRPCWSMaxBatchSize      = "json-rpc.ws-max-batch-size"
+	JSONRPCWSRequestTimeout    = "json-rpc.ws-request-timeout"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackend      = "json-rpc.indexer-backend"
+	JSONRPCIndexerDSN          = "json-rpc.indexer-dsn"
+	JSONRPCIndexerRetainBlocks = "json-rpc.indexer-retain-blocks"
+	JSONRPCIndexerKeepTxHash   = "json-rpc.indexer-keep-tx-hash"
+	JSONRPCBlockSink           = "json-rpc.block-sink"
+	JSONRPCBlockSinkPath       = "json-rpc.block-sink-path"
+	JSONRPCBlockSinkMaxSize    = "json-rpc.block-sink-max-file-size"
+	JSONRPCBlockSinkReplayFrom = "json-rpc.block-sink-replay-from"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
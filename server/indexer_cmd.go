@@ -23,14 +23,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmnode "github.com/tendermint/tendermint/node"
 	sm "github.com/tendermint/tendermint/state"
 	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagFromHeight = "from"
+	flagToHeight   = "to"
 )
 
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|verify|repair]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
@@ -38,6 +47,15 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The indexed blocks can be checked and fixed in the range given by --from and --to, default to all the blocks in the chain:
+		- verify: compare the indexed eth txs against the block results, reporting the gaps and mismatches.
+		  The blocks with consistent entries but no completeness marker, e.g. indexed before the markers were
+		  recorded, are only reported as warnings.
+		- repair: re-index the blocks, replacing the indexed entries and recording the completeness markers.
+		  A one-time repair is expected on a db indexed before the markers were recorded.
+
+		The indexer of the configured json-rpc.indexer-backend is used, verify and repair are only supported by the kv backend.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,19 +66,30 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "verify" && direction != "repair" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|verify|repair, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			appCfg, err := config.GetConfig(serverCtx.Viper)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			evmIdxer, err := OpenEVMIndexer(serverCtx, appCfg.JSONRPC, home, clientCtx, logger.With("module", "evmindex"))
+			if err != nil {
+				return err
+			}
+			idxer, ok := evmIdxer.(historyIndexer)
+			if !ok {
+				return fmt.Errorf("indexer backend %s can't be traversed", appCfg.JSONRPC.IndexerBackend)
+			}
+			// the completeness markers checked by verify and repair are only recorded by the kv indexer
+			kvIdxer, isKV := evmIdxer.(*indexer.KVIndexer)
+			if (direction == "verify" || direction == "repair") && !isKV {
+				return fmt.Errorf("%s is only supported by the kv indexer backend, got: %s", direction, appCfg.JSONRPC.IndexerBackend)
+			}
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
@@ -77,22 +106,46 @@ func NewIndexTxCmd() *cobra.Command {
 				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
 			})
 
-			indexBlock := func(height int64) error {
+			loadBlock := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
+					return nil, nil, fmt.Errorf("block not found %d", height)
 				}
 				resBlk, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return nil, nil, err
+				}
+				return blk, resBlk.DeliverTxs, nil
+			}
+
+			indexBlock := func(height int64) error {
+				blk, txResults, err := loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
 				return nil
 			}
 
+			// range of the verify and repair commands
+			from, _ := cmd.Flags().GetInt64(flagFromHeight)
+			if from <= 0 {
+				from = blockStore.Base()
+			}
+			to, _ := cmd.Flags().GetInt64(flagToHeight)
+			if to <= 0 {
+				to = blockStore.Height()
+			}
+			if from < blockStore.Base() || to > blockStore.Height() || from > to {
+				return fmt.Errorf(
+					"invalid block range [%d, %d], the available blocks are [%d, %d]",
+					from, to, blockStore.Base(), blockStore.Height(),
+				)
+			}
+
 			switch args[0] {
 			case "backward":
 				first, err := idxer.FirstIndexedBlock()
@@ -122,6 +175,53 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "verify":
+				var failed, unmarked int
+				for i := from; i <= to; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					report, err := kvIdxer.VerifyBlock(blk, txResults)
+					if err != nil {
+						return err
+					}
+					if report.OK() {
+						if !report.Marked {
+							unmarked++
+							fmt.Printf("%d: warning, missing completeness marker\n", report.Height)
+						}
+						continue
+					}
+					failed++
+					printBlockReport(report)
+				}
+				if unmarked > 0 {
+					fmt.Printf(
+						"%d blocks are consistent but have no completeness marker, e.g. indexed before the markers were recorded, run repair on them once\n",
+						unmarked,
+					)
+				}
+				if failed > 0 {
+					return fmt.Errorf("%d of %d blocks are not fully indexed, run repair on them", failed, to-from+1)
+				}
+				fmt.Printf("blocks [%d, %d] are fully indexed\n", from, to)
+			case "repair":
+				for i := from; i <= to; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					complete, err := kvIdxer.RepairBlock(blk, txResults)
+					if err != nil {
+						return err
+					}
+					if !complete {
+						fmt.Printf("%d: incomplete, some eth txs fail to be decoded or parsed\n", i)
+						continue
+					}
+					fmt.Println(i)
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -129,5 +229,33 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "The first block of the range to verify or repair, default to the earliest block")
+	cmd.Flags().Int64(flagToHeight, 0, "The last block of the range to verify or repair, default to the latest block")
 	return cmd
 }
+
+// historyIndexer is implemented by the custom eth tx indexer backends traversed backward and forward.
+type historyIndexer interface {
+	ethermint.EVMTxIndexer
+	FirstIndexedBlock() (int64, error)
+}
+
+// printBlockReport prints the gaps and mismatches found in the indexed entries of a block.
+func printBlockReport(report *indexer.BlockReport) {
+	if !report.Marked {
+		fmt.Printf("%d: missing completeness marker\n", report.Height)
+	}
+	if report.Skipped > 0 {
+		fmt.Printf("%d: %d eth txs fail to be decoded or parsed\n", report.Height, report.Skipped)
+	}
+	for _, hash := range report.Missing {
+		fmt.Printf("%d: missing eth tx %s\n", report.Height, hash.Hex())
+	}
+	for _, hash := range report.Mismatched {
+		fmt.Printf("%d: mismatched eth tx %s\n", report.Height, hash.Hex())
+	}
+	for _, index := range report.Stale {
+		fmt.Printf("%d: stale eth tx index %d\n", report.Height, index)
+	}
+}
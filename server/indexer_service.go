@@ -23,6 +23,7 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/types"
 
+	"github.com/evmos/ethermint/indexer"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -30,6 +31,13 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// PruneInterval is the number of indexed blocks between the pruning of the indexer
+	PruneInterval = 100
+
+	// ExportRetryMinBackoff and ExportRetryMaxBackoff bound the delay before retrying a failed block export
+	ExportRetryMinBackoff = time.Second
+	ExportRetryMaxBackoff = time.Minute
 )
 
 // EVMIndexerService indexes transactions for json-rpc service.
@@ -38,6 +46,12 @@ type EVMIndexerService struct {
 
 	txIdxr ethermint.EVMTxIndexer
 	client rpcclient.Client
+
+	exporter   *indexer.BlockExporter
+	replayFrom int64
+
+	retainBlocks uint64
+	keepTxHash   bool
 }
 
 // NewEVMIndexerService returns a new service instance.
@@ -50,8 +64,33 @@ func NewEVMIndexerService(
 	return is
 }
 
+// SetBlockExporter sets the exporter of the indexed blocks to a sink, which replays the blocks from
+// the given height when positive, instead of resuming from its cursor, if not already replayed.
+func (eis *EVMIndexerService) SetBlockExporter(exporter *indexer.BlockExporter, replayFrom int64) {
+	eis.exporter = exporter
+	eis.replayFrom = replayFrom
+}
+
+// SetRetention sets the number of recent blocks kept by the indexer, if it supports pruning, on top
+// of the blocks pruned by the node, keeping the entries of the eth tx hashes if keepTxHash.
+func (eis *EVMIndexerService) SetRetention(retainBlocks uint64, keepTxHash bool) {
+	eis.retainBlocks = retainBlocks
+	eis.keepTxHash = keepTxHash
+}
+
+// OnStop implements service.Service by closing the sink of the exporter.
+func (eis *EVMIndexerService) OnStop() {
+	if eis.exporter == nil {
+		return
+	}
+	if err := eis.exporter.Close(); err != nil {
+		eis.Logger.Error("failed to close the block sink", "err", err)
+	}
+}
+
 // OnStart implements service.Service by subscribing for new blocks
-// and indexing them by events.
+// and indexing them by events. The blocks are exported by their own loop,
+// so a failing sink doesn't hold back the indexing.
 func (eis *EVMIndexerService) OnStart() error {
 	ctx := context.Background()
 	status, err := eis.client.Status(ctx)
@@ -60,6 +99,7 @@ func (eis *EVMIndexerService) OnStart() error {
 	}
 	latestBlock := status.SyncInfo.LatestBlockHeight
 	newBlockSignal := make(chan struct{}, 1)
+	newExportSignal := make(chan struct{}, 1)
 
 	// Use SubscribeUnbuffered here to ensure both subscriptions does not get
 	// canceled due to not pulling messages fast enough. Cause this might
@@ -80,14 +120,24 @@ func (eis *EVMIndexerService) OnStart() error {
 			if eventDataHeader.Header.Height > latestBlock {
 				latestBlock = eventDataHeader.Header.Height
 				// notify
-				select {
-				case newBlockSignal <- struct{}{}:
-				default:
+				for _, signal := range []chan struct{}{newBlockSignal, newExportSignal} {
+					select {
+					case signal <- struct{}{}:
+					default:
+					}
 				}
 			}
 		}
 	}()
 
+	if eis.exporter != nil {
+		lastExported, err := eis.lastExportedBlock(latestBlock)
+		if err != nil {
+			return err
+		}
+		go eis.exportBlocks(ctx, lastExported, newExportSignal)
+	}
+
 	lastBlock, err := eis.txIdxr.LastIndexedBlock()
 	if err != nil {
 		return err
@@ -95,7 +145,12 @@ func (eis *EVMIndexerService) OnStart() error {
 	if lastBlock == -1 {
 		lastBlock = latestBlock
 	}
+	var lastPruned int64
 	for {
+		if lastBlock-lastPruned >= PruneInterval {
+			eis.prune(ctx, lastBlock)
+			lastPruned = lastBlock
+		}
 		if latestBlock <= lastBlock {
 			// nothing to index. wait for signal of new block
 			select {
@@ -122,3 +177,112 @@ func (eis *EVMIndexerService) OnStart() error {
 		}
 	}
 }
+
+// exportBlocks exports the blocks following the last exported one as they are committed. A failed
+// block is retried after a backoff, doubled on every consecutive failure, until the service stops.
+func (eis *EVMIndexerService) exportBlocks(ctx context.Context, lastExported int64, newBlockSignal <-chan struct{}) {
+	backoff := ExportRetryMinBackoff
+	for {
+		status, err := eis.client.Status(ctx)
+		if err != nil {
+			eis.Logger.Error("failed to fetch the latest block", "err", err)
+		} else if status.SyncInfo.LatestBlockHeight <= lastExported {
+			// nothing to export. wait for signal of new block
+			select {
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			case <-eis.Quit():
+				return
+			}
+			continue
+		} else {
+			err = eis.exportRange(ctx, &lastExported, status.SyncInfo.LatestBlockHeight)
+		}
+		if err == nil {
+			backoff = ExportRetryMinBackoff
+			continue
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-eis.Quit():
+			return
+		}
+		backoff *= 2
+		if backoff > ExportRetryMaxBackoff {
+			backoff = ExportRetryMaxBackoff
+		}
+	}
+}
+
+// exportRange exports the blocks after lastExported up to the latest one, moving lastExported to
+// the last block exported, it stops at the first failure.
+func (eis *EVMIndexerService) exportRange(ctx context.Context, lastExported *int64, latestBlock int64) error {
+	for i := *lastExported + 1; i <= latestBlock; i++ {
+		block, err := eis.client.Block(ctx, &i)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block to export", "height", i, "err", err)
+			return err
+		}
+		blockResult, err := eis.client.BlockResults(ctx, &i)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block result to export", "height", i, "err", err)
+			return err
+		}
+		if err := eis.exporter.ExportBlock(block.Block, blockResult.TxsResults); err != nil {
+			eis.Logger.Error("failed to export block", "height", i, "err", err)
+			return err
+		}
+		*lastExported = blockResult.Height
+	}
+	return nil
+}
+
+// prune deletes the indexed blocks pruned by the node, or older than the retained ones if set.
+func (eis *EVMIndexerService) prune(ctx context.Context, lastBlock int64) {
+	pruner, ok := eis.txIdxr.(ethermint.EVMTxPruner)
+	if !ok {
+		return
+	}
+	var height int64
+	if eis.retainBlocks > 0 {
+		height = lastBlock - int64(eis.retainBlocks) + 1
+	}
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		eis.Logger.Error("failed to fetch the earliest block", "err", err)
+	} else if status.SyncInfo.EarliestBlockHeight > height {
+		height = status.SyncInfo.EarliestBlockHeight
+	}
+	if height <= 1 {
+		return
+	}
+	if err := pruner.PruneBlocks(height, eis.keepTxHash); err != nil {
+		eis.Logger.Error("failed to prune the indexer", "height", height, "err", err)
+		return
+	}
+	eis.Logger.Debug("pruned the indexer", "height", height)
+}
+
+// lastExportedBlock returns the height after which the exporter starts, the blocks are exported
+// from the replay height if set and not already replayed, otherwise from the cursor, or the new
+// blocks if there's no cursor.
+func (eis *EVMIndexerService) lastExportedBlock(latestBlock int64) (int64, error) {
+	if eis.replayFrom > 0 {
+		replay, err := eis.exporter.StartReplay(eis.replayFrom)
+		if err != nil {
+			return 0, err
+		}
+		if replay {
+			eis.Logger.Info("replaying the block export", "from", eis.replayFrom)
+		}
+	}
+	lastExported, err := eis.exporter.LastExportedBlock()
+	if err != nil {
+		return 0, err
+	}
+	if lastExported == -1 {
+		return latestBlock, nil
+	}
+	return lastExported, nil
+}
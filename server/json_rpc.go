@@ -16,20 +16,35 @@
 package server
 
 import (
+	"crypto/rand"
+	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	ethprometheus "github.com/ethereum/go-ethereum/metrics/prometheus"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 
 	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -59,23 +74,27 @@ func StartJSONRPC(ctx *server.Context,
 	rpcServer := ethrpc.NewServer()
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
-	rpcAPIArr := config.JSONRPC.API
+	// the auth namespaces are only served by the authenticated server
+	rpcAPIArr := excludeNamespaces(config.JSONRPC.API, config.JSONRPC.AuthAPI)
 
 	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	if err := registerAPIs(ctx.Logger, rpcServer, apis); err != nil {
+		return nil, nil, err
+	}
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			ctx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
+	var handler http.Handler = rpcServer
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		handler = rpc.NewMetricsHandler(rpcServer, apis)
+	}
+
+	if len(config.JSONRPC.AuthAPI) > 0 {
+		if err := startAuthJSONRPC(ctx, clientCtx, tmWsClient, config, indexer); err != nil {
 			return nil, nil, err
 		}
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -122,7 +141,153 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, rpcServer, apis)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// registerAPIs registers the services of the APIs in the JSON-RPC server.
+func registerAPIs(logger log.Logger, rpcServer *ethrpc.Server, apis []ethrpc.API) error {
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+// excludeNamespaces returns the namespaces not in the excluded ones.
+func excludeNamespaces(namespaces, excluded []string) []string {
+	excludedSet := make(map[string]bool, len(excluded))
+	for _, ns := range excluded {
+		excludedSet[ns] = true
+	}
+
+	var res []string
+	for _, ns := range namespaces {
+		if !excludedSet[ns] {
+			res = append(res, ns)
+		}
+	}
+	return res
+}
+
+// startAuthJSONRPC starts the JSON-RPC server authenticated with JWT tokens, serving the auth
+// namespaces over HTTP and websockets on the same listener, as the go-ethereum engine API.
+func startAuthJSONRPC(
+	ctx *server.Context,
+	clientCtx client.Context,
+	tmWsClient *rpcclient.WSClient,
+	config *config.Config,
+	indexer ethermint.EVMTxIndexer,
+) error {
+	secretFile := config.JSONRPC.AuthJWTSecret
+	if secretFile == "" {
+		secretFile = filepath.Join(ctx.Config.RootDir, "config", "jwtsecret")
+	}
+
+	secret, err := ObtainJWTSecret(secretFile, ctx.Logger)
+	if err != nil {
+		return err
+	}
+
+	rpcServer := ethrpc.NewServer()
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, config.JSONRPC.AllowUnprotectedTxs, indexer, config.JSONRPC.AuthAPI)
+	if err := registerAPIs(ctx.Logger, rpcServer, apis); err != nil {
+		return err
+	}
+
+	var httpHandler http.Handler = rpcServer
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		httpHandler = rpc.NewMetricsHandler(rpcServer, apis)
+	}
+
+	// any origin is accepted, the clients being authenticated by the token
+	wsHandler := rpcServer.WebsocketHandler([]string{"*"})
+
+	handler := rpc.NewJWTHandler(secret, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	}))
+
+	// the read and write timeouts are left to the websocket connections, and to the debug traces
+	httpSrv := &http.Server{
+		Addr:              config.JSONRPC.AuthAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(httpSrv.Addr, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Info("Starting JSON-RPC auth server", "address", config.JSONRPC.AuthAddress, "namespaces", config.JSONRPC.AuthAPI)
+	go func() {
+		if err := httpSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start JSON-RPC auth server", "error", err.Error())
+		}
+	}()
+
+	return nil
+}
+
+// ObtainJWTSecret loads the hex encoded 32 bytes secret of the JWT tokens from the file, it generates
+// the secret and writes the file if it doesn't exist.
+func ObtainJWTSecret(fileName string, logger log.Logger) ([]byte, error) {
+	// #nosec G304 -- the file is set by the node operator
+	data, err := os.ReadFile(fileName)
+	switch {
+	case err == nil:
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != 32 {
+			return nil, fmt.Errorf("invalid JWT secret in %s, expected 32 hex encoded bytes", fileName)
+		}
+		return secret, nil
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(fileName, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Generated JWT secret", "path", fileName)
+	return secret, nil
+}
+
+// StartMetricsServer starts the JSON-RPC metrics server, serving the go-ethereum registry under
+// /debug/metrics and the JSON-RPC methods, websockets and filters metrics under /metrics.
+func StartMetricsServer(cfg config.JSONRPCConfig, logger log.Logger) {
+	m := http.NewServeMux()
+	m.Handle("/debug/metrics", ethmetricsexp.ExpHandler(ethmetrics.DefaultRegistry))
+	m.Handle("/debug/metrics/prometheus", ethprometheus.Handler(ethmetrics.DefaultRegistry))
+	m.Handle("/metrics", promhttp.Handler())
+
+	srv := &http.Server{
+		Addr:              cfg.MetricsAddress,
+		Handler:           m,
+		ReadHeaderTimeout: cfg.HTTPTimeout,
+	}
+
+	logger.Info("Starting metrics server", "address", cfg.MetricsAddress)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("failed to start metrics server", "error", err.Error())
+		}
+	}()
+}
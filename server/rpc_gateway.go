@@ -0,0 +1,161 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewRPCGatewayCmd returns the command that serves the JSON-RPC and websocket APIs against a
+// remote node, without running the consensus.
+func NewRPCGatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rpc-gateway",
+		Short: "Run the JSON-RPC server against a remote node",
+		Long: `Run the JSON-RPC and websocket servers against the Tendermint RPC endpoint of a remote node,
+given by the '--node' flag, without running the application or the consensus.
+
+Queries are sent through the gRPC endpoint given by '--grpc-addr' when set, otherwise as ABCI
+queries through the Tendermint RPC. When the custom tx indexer is enabled, the gateway keeps its
+own indexer db in the home directory, fed by the new blocks of the remote node.
+`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			return serverCtx.Viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = startRPCGateway(serverCtx, clientCtx)
+			errCode, ok := err.(server.ErrorCode)
+			if !ok {
+				return err
+			}
+
+			serverCtx.Logger.Debug(fmt.Sprintf("received quit signal: %d", errCode.Code))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface of the remote node")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID, fetched from the remote node if empty")
+	cmd.Flags().String(srvflags.GatewayGRPCAddress, "", "<host>:<port> to the gRPC server of the remote node")
+	AddJSONRPCFlags(cmd)
+	return cmd
+}
+
+func startRPCGateway(ctx *server.Context, clientCtx client.Context) error {
+	logger := ctx.Logger
+	home := ctx.Config.RootDir
+
+	cfg, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		return err
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	tmClient, ok := clientCtx.Client.(*rpchttp.HTTP)
+	if !ok {
+		return fmt.Errorf("invalid tendermint rpc client, expected *http.HTTP, got %T", clientCtx.Client)
+	}
+	// the websocket connection of the client is only used for the event subscriptions
+	if err := tmClient.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if err := tmClient.Stop(); err != nil {
+			logger.Error("failed to stop the tendermint rpc client", "error", err.Error())
+		}
+	}()
+
+	if clientCtx.ChainID == "" {
+		status, err := tmClient.Status(context.Background())
+		if err != nil {
+			return err
+		}
+		clientCtx = clientCtx.WithChainID(status.NodeInfo.Network)
+	}
+
+	if grpcAddress := ctx.Viper.GetString(srvflags.GatewayGRPCAddress); grpcAddress != "" {
+		grpcClient, err := dialGRPC(grpcAddress, cfg.GRPC, clientCtx)
+		if err != nil {
+			return err
+		}
+		defer grpcClient.Close()
+
+		clientCtx = clientCtx.WithGRPCClient(grpcClient)
+		logger.Debug("gRPC client assigned to client context", "target", grpcAddress)
+	}
+
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		StartMetricsServer(cfg.JSONRPC, logger)
+	}
+
+	var idxer ethermint.EVMTxIndexer
+	if cfg.JSONRPC.EnableIndexer {
+		idxer, err = startEVMIndexer(ctx, cfg.JSONRPC, home, clientCtx)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		httpSrv     *http.Server
+		httpSrvDone chan struct{}
+	)
+
+	httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmClient.Remote(), "/websocket", &cfg, idxer)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFn()
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+		} else {
+			logger.Info("HTTP server shut down, waiting 5 sec")
+			select {
+			case <-time.Tick(5 * time.Second):
+			case <-httpSrvDone:
+			}
+		}
+	}()
+
+	return server.WaitForQuitSignals()
+}
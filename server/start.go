@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -32,11 +33,16 @@ import (
 
 	"github.com/spf13/cobra"
 
+	// database/sql drivers of the sql indexer backends
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
@@ -48,8 +54,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 
-	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
-
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -195,9 +199,30 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.EnabledUnsafeCors, false, "Defines if CORS should be enabled (unsafe - use it at your own risk)")
 
 	cmd.Flags().Bool(srvflags.JSONRPCEnable, true, "Define if the JSON-RPC server should be enabled")
+	AddJSONRPCFlags(cmd)
+
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+
+	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
+	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
+
+	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
+	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+
+	// add support for all Tendermint-specific command line options
+	tcmd.AddNodeFlags(cmd)
+	return cmd
+}
+
+// AddJSONRPCFlags adds the flags configuring the JSON-RPC server and the EVM indexer to the command.
+func AddJSONRPCFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, config.DefaultJSONRPCAuthAddress, "the JWT authenticated JSON-RPC server address to listen on")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, []string{}, "Defines a list of JSON-RPC namespaces served only by the authenticated server")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "the JWT secret file of the authenticated server (default <home>/config/jwtsecret)")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aphoton (0=infinite)")     //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
@@ -207,22 +232,27 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCBlockCacheSize, config.DefaultBlockCacheSize, "Sets the max number of cached blocks, block results and formatted RPC blocks (0=disabled)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCReceiptCacheSize, config.DefaultReceiptCacheSize, "Sets the max number of cached transaction receipts (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxSubscriptions, config.DefaultWSMaxSubscriptions, "Sets the max number of subscriptions of a websocket connection (0=unlimited)") //nolint:lll
+	cmd.Flags().Int64(srvflags.JSONRPCWSMaxMessageSize, config.DefaultWSMaxMessageSize, "Sets the max size in bytes of the messages read from the websocket clients")     //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCWSPingInterval, config.DefaultWSPingInterval, "Sets the websocket keepalive ping interval (0=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCWSWriteTimeout, config.DefaultWSWriteTimeout, "Sets the websocket write timeout (0=infinite)")
+	cmd.Flags().Int(srvflags.JSONRPCWSSendBufferSize, config.DefaultWSSendBufferSize, "Sets the max number of messages queued for a websocket client before it is disconnected") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxConcurrent, config.DefaultWSMaxConcurrentRequests, "Sets the max number of requests served concurrently for a websocket connection")    //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxBatchSize, config.DefaultWSMaxBatchSize, "Sets the max number of requests of a websocket batch (0=unlimited)")
+	cmd.Flags().Duration(srvflags.JSONRPCWSRequestTimeout, config.DefaultWSRequestTimeout, "Sets the timeout of the websocket requests (0=infinite)")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.DefaultIndexerBackend, "the database of the custom tx indexer (kv|sqlite|postgres)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "the data source name of the sql indexer backends")
+	cmd.Flags().Uint64(srvflags.JSONRPCIndexerRetainBlocks, 0, "the number of recent blocks kept by the custom tx indexer, the blocks pruned by the node are always pruned (0=keep all the blocks of the node)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCIndexerKeepTxHash, false, "keep the entries of the eth tx hashes when pruning the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCBlockSink, "", "the sink exporting the indexed blocks, receipts and logs (ndjson|unix)")
+	cmd.Flags().String(srvflags.JSONRPCBlockSinkPath, "", "the directory of the ndjson files or the path of the unix socket of the block sink")
+	cmd.Flags().Int64(srvflags.JSONRPCBlockSinkMaxSize, config.DefaultBlockSinkMaxFileSize, "the size in bytes of the ndjson files before rotation (0=unlimited)") //nolint:lll
+	cmd.Flags().Int64(srvflags.JSONRPCBlockSinkReplayFrom, 0, "the height from which the blocks are exported again, instead of resuming from the cursor")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
-
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
-
-	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
-	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
-
-	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
-	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
-
-	// add support for all Tendermint-specific command line options
-	tcmd.AddNodeFlags(cmd)
-	return cmd
 }
 
 func startStandAlone(ctx *server.Context, opts StartOptions) error {
@@ -420,34 +450,15 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
-		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+		StartMetricsServer(config.JSONRPC, ctx.Logger)
 	}
 
 	var idxer ethermint.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		idxer, err = startEVMIndexer(ctx, config.JSONRPC, home, clientCtx)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
-		indexerService.SetLogger(idxLogger)
-
-		errCh := make(chan error)
-		go func() {
-			if err := indexerService.Start(); err != nil {
-				errCh <- err
-			}
-		}()
-
-		select {
-		case err := <-errCh:
-			return err
-		case <-time.After(types.ServerStartTime): // assume server started successfully
-		}
 	}
 
 	if config.API.Enable || config.JSONRPC.Enable {
@@ -468,28 +479,10 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 				return errorsmod.Wrapf(err, "invalid grpc address %s", config.GRPC.Address)
 			}
 
-			maxSendMsgSize := config.GRPC.MaxSendMsgSize
-			if maxSendMsgSize == 0 {
-				maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
-			}
-
-			maxRecvMsgSize := config.GRPC.MaxRecvMsgSize
-			if maxRecvMsgSize == 0 {
-				maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
-			}
-
 			grpcAddress := fmt.Sprintf("127.0.0.1:%s", port)
 
 			// If grpc is enabled, configure grpc client for grpc gateway and json-rpc.
-			grpcClient, err := grpc.Dial(
-				grpcAddress,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
-					grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
-					grpc.MaxCallSendMsgSize(maxSendMsgSize),
-				),
-			)
+			grpcClient, err := dialGRPC(grpcAddress, config.GRPC, clientCtx)
 			if err != nil {
 				return err
 			}
@@ -644,6 +637,105 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	return server.WaitForQuitSignals()
 }
 
+// startEVMIndexer opens the custom eth indexer db and starts the service indexing the blocks
+// fetched with the client context.
+func startEVMIndexer(
+	ctx *server.Context,
+	cfg config.JSONRPCConfig,
+	home string,
+	clientCtx client.Context,
+) (ethermint.EVMTxIndexer, error) {
+	idxLogger := ctx.Logger.With("indexer", "evm")
+
+	idxer, err := OpenEVMIndexer(ctx, cfg, home, clientCtx, idxLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
+	indexerService.SetLogger(idxLogger)
+	indexerService.SetRetention(cfg.IndexerRetainBlocks, cfg.IndexerKeepTxHash)
+
+	if cfg.BlockSink != "" {
+		sink, err := OpenBlockSink(home, cfg)
+		if err != nil {
+			return nil, err
+		}
+		cursorPath := filepath.Join(home, "data", "evmsink.cursor")
+		exporter := indexer.NewBlockExporter(sink, cursorPath, clientCtx, idxLogger.With("sink", cfg.BlockSink))
+		indexerService.SetBlockExporter(exporter, cfg.BlockSinkReplayFrom)
+	}
+
+	errCh := make(chan error)
+	go func() {
+		if err := indexerService.Start(); err != nil {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(types.ServerStartTime): // assume server started successfully
+	}
+	return idxer, nil
+}
+
+// OpenEVMIndexer opens the custom eth indexer of the configured backend, the kv indexer uses the same
+// db backend as the main app.
+func OpenEVMIndexer(
+	ctx *server.Context,
+	cfg config.JSONRPCConfig,
+	home string,
+	clientCtx client.Context,
+	logger tmlog.Logger,
+) (ethermint.EVMTxIndexer, error) {
+	switch cfg.IndexerBackend {
+	case config.IndexerBackendSQLite, config.IndexerBackendPostgres:
+		driver, sqlDB, err := OpenSQLIndexerDB(home, cfg.IndexerBackend, cfg.IndexerDSN)
+		if err != nil {
+			ctx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return nil, err
+		}
+		idxer, err := indexer.NewSQLIndexer(sqlDB, driver, logger, clientCtx)
+		if err != nil {
+			return nil, err
+		}
+		return idxer, nil
+	default:
+		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return nil, err
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx), nil
+	}
+}
+
+// dialGRPC creates a gRPC client connection to the given address, for the concurrent queries of
+// the client context.
+func dialGRPC(address string, grpcConfig serverconfig.GRPCConfig, clientCtx client.Context) (*grpc.ClientConn, error) {
+	maxSendMsgSize := grpcConfig.MaxSendMsgSize
+	if maxSendMsgSize == 0 {
+		maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
+	}
+
+	maxRecvMsgSize := grpcConfig.MaxRecvMsgSize
+	if maxRecvMsgSize == 0 {
+		maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
+	}
+
+	return grpc.Dial(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+	)
+}
+
 func openDB(_ types.AppOptions, rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -655,6 +747,40 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenSQLIndexerDB opens the database of the sql indexer backends, returning the database/sql driver name,
+// the sqlite backend defaults to a file in the data directory.
+func OpenSQLIndexerDB(rootDir, backend, dsn string) (string, *sql.DB, error) {
+	driver := indexer.DriverPostgres
+	if backend == config.IndexerBackendSQLite {
+		driver = indexer.DriverSQLite
+		if dsn == "" {
+			dsn = fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL", filepath.Join(rootDir, "data", "evmindexer.sqlite"))
+		}
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return "", nil, err
+	}
+	return driver, db, nil
+}
+
+// OpenBlockSink opens the sink exporting the indexed blocks, the ndjson files are written in the data
+// directory by default.
+func OpenBlockSink(rootDir string, cfg config.JSONRPCConfig) (indexer.BlockSink, error) {
+	if cfg.BlockSink == config.BlockSinkUnix {
+		return indexer.NewSocketSink(cfg.BlockSinkPath), nil
+	}
+	dir := cfg.BlockSinkPath
+	if dir == "" {
+		dir = filepath.Join(rootDir, "data", "evmsink")
+	}
+	return indexer.NewNDJSONSink(dir, cfg.BlockSinkMaxFileSize)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
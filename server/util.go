@@ -68,6 +68,9 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+
+		// json-rpc server against a remote node
+		NewRPCGatewayCmd(),
 	)
 }
 
@@ -0,0 +1,91 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package testutil
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BTCBlockVersion is the block version used by the mined Bitcoin headers, it's valid for all
+// the BIP0034, BIP0065 and BIP0066 activation heights.
+const BTCBlockVersion = 4
+
+// MineBTCHeader returns a header extending prev which satisfies the proof of work of the given
+// compact difficulty. It must only be used with easy difficulties, like the regression test
+// network ones. This should be used for testing purposes only!
+func MineBTCHeader(prev *wire.BlockHeader, bits uint32, timestamp time.Time, merkleRoot chainhash.Hash) *wire.BlockHeader {
+	header := wire.NewBlockHeader(BTCBlockVersion, ptr(prev.BlockHash()), &merkleRoot, bits, 0)
+	header.Timestamp = time.Unix(timestamp.Unix(), 0)
+
+	target := blockchain.CompactToBig(bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return header
+		}
+		header.Nonce++
+	}
+}
+
+// MineBTCHeaders returns n headers extending prev, mined with the given compact difficulty and
+// spaced by the given duration. Each header commits to a random merkle root so that
+// different chains mined from the same parent don't share headers. This should be used for
+// testing purposes only!
+func MineBTCHeaders(prev *wire.BlockHeader, bits uint32, n int, spacing time.Duration) []*wire.BlockHeader {
+	headers := make([]*wire.BlockHeader, n)
+	for i := range headers {
+		var merkleRoot chainhash.Hash
+		if _, err := rand.Read(merkleRoot[:]); err != nil {
+			panic(err)
+		}
+
+		headers[i] = MineBTCHeader(prev, bits, prev.Timestamp.Add(spacing), merkleRoot)
+		prev = headers[i]
+	}
+	return headers
+}
+
+// BTCMerkleBranch returns the merkle branch of the transaction at the given index of a block,
+// from the leaf level. This should be used for testing purposes only!
+func BTCMerkleBranch(txids []chainhash.Hash, index int) []chainhash.Hash {
+	var branch []chainhash.Hash
+
+	level := txids
+	for len(level) > 1 {
+		// the last node of a level is paired with itself
+		if len(level)%2 == 1 {
+			level = append(level[:len(level):len(level)], level[len(level)-1])
+		}
+		branch = append(branch, level[index^1])
+
+		next := make([]chainhash.Hash, len(level)/2)
+		for i := range next {
+			next[i] = *blockchain.HashMerkleBranches(&level[2*i], &level[2*i+1])
+		}
+		level = next
+		index /= 2
+	}
+	return branch
+}
+
+func ptr(hash chainhash.Hash) *chainhash.Hash {
+	return &hash
+}
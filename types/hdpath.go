@@ -25,6 +25,12 @@ var (
 
 	// BIP44HDPath is the default BIP44 HD path used on Ethereum.
 	BIP44HDPath = ethaccounts.DefaultBaseDerivationPath.String()
+
+	// BIP86CoinType is the Bitcoin coin type used on the BIP-86 paths of the Taproot keys.
+	BIP86CoinType uint32 = 0
+
+	// BIP86HDPath is the default BIP-86 HD path used for the Taproot keys on Bitcoin.
+	BIP86HDPath = "m/86'/0'/0'/0/0"
 )
 
 type (
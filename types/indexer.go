@@ -16,7 +16,10 @@
 package types
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
@@ -32,3 +35,24 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMTxPruner defines the interface of the custom eth tx indexers able to prune the old blocks.
+type EVMTxPruner interface {
+	// PruneBlocks deletes the entries of the blocks below the height, keeping the entries of the
+	// eth tx hashes if keepTxHash.
+	PruneBlocks(height int64, keepTxHash bool) error
+}
+
+// ErrLogsNotIndexed is returned by the log indexers when some blocks of the queried range are not indexed.
+var ErrLogsNotIndexed = errors.New("logs not indexed for the block range")
+
+// EVMLogIndexer defines the interface of the custom eth tx indexers also indexing the logs.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// GetLogs returns at most limit logs (0 = no limit) emitted in the block range [from, to] and
+	// matching the addresses and topics filter criteria, in the order they were emitted, skipping
+	// the logs of the from block with an index lower than fromIndex.
+	// It returns ErrLogsNotIndexed if any block of the range is not indexed.
+	GetLogs(from, to int64, fromIndex uint, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
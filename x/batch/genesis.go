@@ -0,0 +1,72 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package batch
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/batch/keeper"
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, batch := range data.Batches {
+		k.SetBatch(ctx, batch)
+	}
+
+	if data.PendingStartHeight != 0 {
+		k.SetPendingStartHeight(ctx, data.PendingStartHeight)
+	}
+	if data.PendingEndHeight != 0 {
+		k.SetPendingEndHeight(ctx, data.PendingEndHeight)
+	}
+	for _, txHash := range data.PendingTxHashes {
+		hash, err := types.ParseHash(txHash)
+		if err != nil {
+			panic(err)
+		}
+		k.AddPendingTx(ctx, hash)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the batch module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	batches := []types.Batch{}
+	k.IterateBatches(ctx, func(batch types.Batch) bool {
+		batches = append(batches, batch)
+		return false
+	})
+
+	txHashes := []string{}
+	for _, txHash := range k.GetPendingTxHashes(ctx) {
+		txHashes = append(txHashes, txHash.Hex())
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), batches, k.GetPendingStartHeight(ctx), k.GetPendingEndHeight(ctx), txHashes)
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlock commits the batch closed at the end of the previous block, the app hash of the block
+// header being the state after the end height of the batch.
+func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	end := k.GetPendingEndHeight(ctx)
+	if end == 0 {
+		return
+	}
+
+	if _, err := k.CommitBatch(ctx, k.GetPendingStartHeight(ctx), end); err != nil {
+		panic(fmt.Errorf("failed to commit batch at height %d: %w", ctx.BlockHeight(), err))
+	}
+}
+
+// EndBlock closes the open batch once it covers the batch interval, it is committed at the
+// beginning of the next block. A block without recorded transactions opens a batch at its height.
+// When batches are disabled, the open batch is closed right away so that no recorded transaction
+// is left out.
+func (k Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	start := k.GetPendingStartHeight(ctx)
+
+	if params.IsEnabled() {
+		if start == 0 {
+			start = height
+			k.SetPendingStartHeight(ctx, start)
+		}
+		if uint64(height-start+1) < params.BatchInterval {
+			return
+		}
+	} else if start == 0 {
+		return
+	}
+
+	k.SetPendingEndHeight(ctx, height)
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+// CommitBatch commits to the blocks from start to end height, the transactions of the open batch
+// and the withdrawals requested since the last batch, then deletes the open batch. It runs at the
+// beginning of the block following the end height, the state root being the app hash of its
+// header, i.e. the state after the end height block.
+func (k Keeper) CommitBatch(ctx sdk.Context, start, end int64) (types.Batch, error) {
+	batch := types.Batch{
+		Index:             1,
+		StartHeight:       start,
+		EndHeight:         end,
+		StateRoot:         common.BytesToHash(ctx.BlockHeader().AppHash).Hex(),
+		FirstWithdrawalId: 1,
+	}
+	if latest, found := k.GetLatestBatch(ctx); found {
+		batch.Index = latest.Index + 1
+		batch.FirstWithdrawalId = latest.FirstWithdrawalId + latest.WithdrawalCount
+	}
+
+	txHashes := k.GetPendingTxHashes(ctx)
+	txLeaves := make([][]byte, len(txHashes))
+	for i, txHash := range txHashes {
+		txLeaves[i] = txHash.Bytes()
+	}
+	batch.TxRoot = types.MerkleRoot(txLeaves).Hex()
+	batch.TxCount = uint64(len(txLeaves))
+
+	if next := k.bridgeKeeper.GetNextWithdrawalID(ctx); next > batch.FirstWithdrawalId {
+		batch.WithdrawalCount = next - batch.FirstWithdrawalId
+	}
+	withdrawalLeaves, err := k.WithdrawalLeaves(ctx, batch)
+	if err != nil {
+		return types.Batch{}, err
+	}
+	batch.WithdrawalRoot = types.MerkleRoot(withdrawalLeaves).Hex()
+
+	hash, err := batch.ComputeHash()
+	if err != nil {
+		return types.Batch{}, err
+	}
+	batch.Hash = hash.Hex()
+
+	k.SetBatch(ctx, batch)
+	k.clearPending(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitBatch,
+			sdk.NewAttribute(types.AttributeKeyBatchIndex, strconv.FormatUint(batch.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(batch.StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(batch.EndHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyBatchHash, batch.Hash),
+		),
+	)
+
+	return batch, nil
+}
+
+// WithdrawalLeaves returns the merkle tree leaves of the withdrawals requested in the batch.
+func (k Keeper) WithdrawalLeaves(ctx sdk.Context, batch types.Batch) ([][]byte, error) {
+	leaves := make([][]byte, batch.WithdrawalCount)
+	for i := range leaves {
+		id := batch.FirstWithdrawalId + uint64(i)
+		withdrawal, found := k.bridgeKeeper.GetWithdrawal(ctx, id)
+		if !found {
+			return nil, fmt.Errorf("withdrawal %d not found", id)
+		}
+
+		leaf, err := types.WithdrawalLeaf(withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leaf
+	}
+	return leaves, nil
+}
+
+// GetWithdrawalProof returns the merkle proof of a withdrawal against the withdrawal root of its
+// batch. It returns false if the withdrawal is not committed yet.
+func (k Keeper) GetWithdrawalProof(ctx sdk.Context, withdrawalID uint64) (types.WithdrawalProof, bool, error) {
+	batch, found := k.GetWithdrawalBatch(ctx, withdrawalID)
+	if !found {
+		return types.WithdrawalProof{}, false, nil
+	}
+
+	leaves, err := k.WithdrawalLeaves(ctx, batch)
+	if err != nil {
+		return types.WithdrawalProof{}, false, err
+	}
+
+	index := withdrawalID - batch.FirstWithdrawalId
+	siblings, err := types.MerkleProof(leaves, index)
+	if err != nil {
+		return types.WithdrawalProof{}, false, err
+	}
+
+	proof := types.WithdrawalProof{
+		BatchIndex:     batch.Index,
+		WithdrawalId:   withdrawalID,
+		Leaf:           leaves[index],
+		LeafIndex:      index,
+		LeafCount:      uint64(len(leaves)),
+		Siblings:       make([]string, len(siblings)),
+		WithdrawalRoot: batch.WithdrawalRoot,
+	}
+	for i, sibling := range siblings {
+		proof.Siblings[i] = sibling.Hex()
+	}
+	return proof, true, nil
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/batch"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	k := suite.app.BatchKeeper
+
+	suite.queueWithdrawals(2)
+	suite.executeTx(false)
+	suite.endBlock(1)
+	suite.endBlock(2)
+	suite.endBlock(3)
+	suite.ctx = suite.ctx.WithBlockHeight(4)
+	suite.executeTx(false)
+
+	// export between the end of the last block of a batch and its commit
+	suite.ctx = suite.ctx.WithBlockHeight(6)
+	k.EndBlock(suite.ctx, abci.RequestEndBlock{Height: 6})
+
+	genesis := batch.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Batches, 1)
+	suite.Require().Equal(int64(4), genesis.PendingStartHeight)
+	suite.Require().Equal(int64(6), genesis.PendingEndHeight)
+	suite.Require().Len(genesis.PendingTxHashes, 1)
+
+	// import in a fresh chain
+	suite.SetupTest()
+	k = suite.app.BatchKeeper
+	batch.InitGenesis(suite.ctx, k, *genesis)
+	suite.Require().Equal(genesis, batch.ExportGenesis(suite.ctx, k))
+
+	// the withdrawals of the imported batch are indexed
+	_, found := k.GetWithdrawalBatch(suite.ctx, 2)
+	suite.Require().True(found)
+
+	// the closed batch is committed by the first block
+	k.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	committed, found := k.GetLatestBatch(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(4), committed.StartHeight)
+	suite.Require().Equal(int64(6), committed.EndHeight)
+	suite.Require().Equal(uint64(1), committed.TxCount)
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Batch implements the Query/Batch gRPC method
+func (k Keeper) Batch(c context.Context, req *types.QueryBatchRequest) (*types.QueryBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	batch, found := k.GetBatch(ctx, req.Index)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "batch %d not found", req.Index)
+	}
+
+	return &types.QueryBatchResponse{
+		Batch: batch,
+	}, nil
+}
+
+// LatestBatch implements the Query/LatestBatch gRPC method
+func (k Keeper) LatestBatch(c context.Context, _ *types.QueryLatestBatchRequest) (*types.QueryLatestBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	batch, found := k.GetLatestBatch(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no batch committed")
+	}
+
+	return &types.QueryLatestBatchResponse{
+		Batch: batch,
+	}, nil
+}
+
+// WithdrawalProof implements the Query/WithdrawalProof gRPC method
+func (k Keeper) WithdrawalProof(c context.Context, req *types.QueryWithdrawalProofRequest) (*types.QueryWithdrawalProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proof, found, err := k.GetWithdrawalProof(ctx, req.WithdrawalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "withdrawal %d not committed by a batch", req.WithdrawalId)
+	}
+
+	return &types.QueryWithdrawalProofResponse{
+		Proof: proof,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryBatches() {
+	_, err := suite.queryClient.LatestBatch(suite.ctx, &types.QueryLatestBatchRequest{})
+	suite.Require().Error(err)
+
+	for height := int64(1); height <= 2*batchInterval; height++ {
+		suite.endBlock(height)
+	}
+
+	res, err := suite.queryClient.LatestBatch(suite.ctx, &types.QueryLatestBatchRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Batch.Index)
+
+	batch, err := suite.queryClient.Batch(suite.ctx, &types.QueryBatchRequest{Index: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(batchInterval), batch.Batch.EndHeight)
+
+	_, err = suite.queryClient.Batch(suite.ctx, &types.QueryBatchRequest{Index: 3})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryWithdrawalProof() {
+	suite.queueWithdrawals(5)
+
+	_, err := suite.queryClient.WithdrawalProof(suite.ctx, &types.QueryWithdrawalProofRequest{WithdrawalId: 1})
+	suite.Require().Error(err)
+
+	for height := int64(1); height <= batchInterval; height++ {
+		suite.endBlock(height)
+	}
+
+	res, err := suite.queryClient.WithdrawalProof(suite.ctx, &types.QueryWithdrawalProofRequest{WithdrawalId: 4})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Proof.BatchIndex)
+	suite.Require().Equal(uint64(3), res.Proof.LeafIndex)
+	suite.Require().Equal(uint64(5), res.Proof.LeafCount)
+	suite.Require().NoError(res.Proof.Verify())
+
+	_, err = suite.queryClient.WithdrawalProof(suite.ctx, &types.QueryWithdrawalProofRequest{WithdrawalId: 6})
+	suite.Require().Error(err)
+}
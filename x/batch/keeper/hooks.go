@@ -0,0 +1,60 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks records the EVM transactions executed in the open batch.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks recording the transactions of the open batch.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PreTxProcessing is a no-op.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message) error {
+	return nil
+}
+
+// PostTxProcessing records a successful transaction.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	h.recordTx(ctx, receipt)
+	return nil
+}
+
+// PostTxFailed records a failed transaction, which is part of the block as well.
+func (h Hooks) PostTxFailed(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	h.recordTx(ctx, receipt)
+	return nil
+}
+
+func (h Hooks) recordTx(ctx sdk.Context, receipt *ethtypes.Receipt) {
+	if !h.k.GetParams(ctx).IsEnabled() {
+		return
+	}
+	h.k.AddPendingTx(ctx, receipt.TxHash)
+}
@@ -0,0 +1,247 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+// Keeper grants access to the batch module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the batch Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	// keepers
+	bridgeKeeper types.BTCBridgeKeeper
+}
+
+// NewKeeper generates new batch module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	bridgeKeeper types.BTCBridgeKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		authority:    authority,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetParams returns the total set of batch parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the batch parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyParams, bz)
+	return nil
+}
+
+// GetBatch returns a committed batch by index.
+func (k Keeper) GetBatch(ctx sdk.Context, index uint64) (types.Batch, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatch)
+	bz := store.Get(types.BatchKey(index))
+	if len(bz) == 0 {
+		return types.Batch{}, false
+	}
+
+	var batch types.Batch
+	k.cdc.MustUnmarshal(bz, &batch)
+	return batch, true
+}
+
+// GetLatestBatch returns the last committed batch.
+func (k Keeper) GetLatestBatch(ctx sdk.Context) (types.Batch, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatch)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Batch{}, false
+	}
+
+	var batch types.Batch
+	k.cdc.MustUnmarshal(iterator.Value(), &batch)
+	return batch, true
+}
+
+// SetBatch stores a committed batch by index and indexes it by its first withdrawal id.
+func (k Keeper) SetBatch(ctx sdk.Context, batch types.Batch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatch)
+	store.Set(types.BatchKey(batch.Index), k.cdc.MustMarshal(&batch))
+
+	if batch.WithdrawalCount > 0 {
+		withdrawals := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalBatch)
+		withdrawals.Set(types.WithdrawalBatchKey(batch.FirstWithdrawalId), types.BatchKey(batch.Index))
+	}
+}
+
+// GetWithdrawalBatch returns the committed batch the withdrawal was requested in.
+func (k Keeper) GetWithdrawalBatch(ctx sdk.Context, withdrawalID uint64) (types.Batch, bool) {
+	// the batch with the greatest first withdrawal id not above the withdrawal id
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawalBatch)
+	iterator := store.ReverseIterator(nil, types.WithdrawalBatchKey(withdrawalID+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Batch{}, false
+	}
+
+	batch, found := k.GetBatch(ctx, sdk.BigEndianToUint64(iterator.Value()))
+	if !found || !batch.ContainsWithdrawal(withdrawalID) {
+		return types.Batch{}, false
+	}
+	return batch, true
+}
+
+// IterateBatches iterates over all the committed batches, ordered by index, until the callback
+// returns true.
+func (k Keeper) IterateBatches(ctx sdk.Context, cb func(batch types.Batch) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBatch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var batch types.Batch
+		k.cdc.MustUnmarshal(iterator.Value(), &batch)
+		if cb(batch) {
+			break
+		}
+	}
+}
+
+// GetPendingStartHeight returns the first block height of the open batch, zero if no batch is
+// open.
+func (k Keeper) GetPendingStartHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPendingStartHeight)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetPendingStartHeight opens a batch at the given block height.
+func (k Keeper) SetPendingStartHeight(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPendingStartHeight, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetPendingEndHeight returns the last block height of the open batch once it is closed, zero if
+// the open batch is not closed.
+func (k Keeper) GetPendingEndHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPendingEndHeight)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetPendingEndHeight closes the open batch at the given block height, it is committed at the
+// beginning of the next block.
+func (k Keeper) SetPendingEndHeight(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPendingEndHeight, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// AddPendingTx appends the hash of an executed EVM transaction to the open batch, opening it at
+// the current height if needed.
+func (k Keeper) AddPendingTx(ctx sdk.Context, txHash common.Hash) {
+	if k.GetPendingStartHeight(ctx) == 0 {
+		k.SetPendingStartHeight(ctx, ctx.BlockHeight())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var count uint64
+	if bz := store.Get(types.KeyPendingTxCount); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+
+	pending := prefix.NewStore(store, types.KeyPrefixPendingTx)
+	pending.Set(types.PendingTxKey(count), txHash.Bytes())
+	store.Set(types.KeyPendingTxCount, sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetPendingTxHashes returns the hashes of the EVM transactions of the open batch, in execution
+// order.
+func (k Keeper) GetPendingTxHashes(ctx sdk.Context) []common.Hash {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingTx)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var txHashes []common.Hash
+	for ; iterator.Valid(); iterator.Next() {
+		txHashes = append(txHashes, common.BytesToHash(iterator.Value()))
+	}
+	return txHashes
+}
+
+// clearPending deletes the open batch and its transaction hashes once committed.
+func (k Keeper) clearPending(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	pending := prefix.NewStore(store, types.KeyPrefixPendingTx)
+
+	iterator := pending.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		pending.Delete(key)
+	}
+	store.Delete(types.KeyPendingTxCount)
+	store.Delete(types.KeyPendingStartHeight)
+	store.Delete(types.KeyPendingEndHeight)
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/batch/types"
+	btcbridgetypes "github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+const (
+	// withdrawalAddress is a mainnet segwit address of a user
+	withdrawalAddress = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+	// batchInterval is the batch interval used by the tests
+	batchInterval = 3
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.EthermintApp
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+		AppHash: common.Hash{1}.Bytes(),
+	})
+	suite.Require().NoError(suite.app.BatchKeeper.SetParams(suite.ctx, types.NewParams(batchInterval)))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.BatchKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// endBlock runs the batch end blocker at the given height, then the begin blocker of the next
+// block, whose header app hash is the height byte.
+func (suite *KeeperTestSuite) endBlock(height int64) {
+	suite.ctx = suite.ctx.WithBlockHeight(height)
+	suite.app.BatchKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{Height: height})
+
+	header := suite.ctx.BlockHeader()
+	header.Height = height + 1
+	header.AppHash = common.Hash{byte(height)}.Bytes()
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+	suite.app.BatchKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{Header: header})
+}
+
+// executeTx runs the batch hooks for an executed EVM transaction and returns its hash.
+func (suite *KeeperTestSuite) executeTx(failed bool) common.Hash {
+	receipt := &ethtypes.Receipt{TxHash: common.BytesToHash(tests.GenerateAddress().Bytes())}
+	hooks := suite.app.BatchKeeper.Hooks()
+	if failed {
+		suite.Require().NoError(hooks.PostTxFailed(suite.ctx, nil, receipt))
+	} else {
+		suite.Require().NoError(hooks.PostTxProcessing(suite.ctx, nil, receipt))
+	}
+	return receipt.TxHash
+}
+
+// queueWithdrawals queues n withdrawals in the BTC bridge.
+func (suite *KeeperTestSuite) queueWithdrawals(n int) {
+	for i := 0; i < n; i++ {
+		_, err := suite.app.BTCBridgeKeeper.QueueWithdrawal(suite.ctx, tests.GenerateAddress(), withdrawalAddress, uint64(10_000+i))
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestEndBlock() {
+	k := suite.app.BatchKeeper
+
+	suite.endBlock(1)
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	txHashes := [][]byte{suite.executeTx(false).Bytes(), suite.executeTx(true).Bytes()}
+	suite.endBlock(2)
+	_, found := k.GetLatestBatch(suite.ctx)
+	suite.Require().False(found)
+
+	// the batch is closed at the end of its last block
+	suite.ctx = suite.ctx.WithBlockHeight(3)
+	k.EndBlock(suite.ctx, abci.RequestEndBlock{Height: 3})
+	suite.Require().Equal(int64(3), k.GetPendingEndHeight(suite.ctx))
+	_, found = k.GetLatestBatch(suite.ctx)
+	suite.Require().False(found)
+
+	// and committed at the beginning of the next one, with the state after its last block
+	header := suite.ctx.BlockHeader()
+	header.Height = 4
+	header.AppHash = common.Hash{3}.Bytes()
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+	k.BeginBlock(suite.ctx, abci.RequestBeginBlock{Header: header})
+	batch, found := k.GetLatestBatch(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), batch.Index)
+	suite.Require().Equal(int64(1), batch.StartHeight)
+	suite.Require().Equal(int64(3), batch.EndHeight)
+	suite.Require().Equal(common.Hash{3}.Hex(), batch.StateRoot)
+	suite.Require().Equal(uint64(2), batch.TxCount)
+	suite.Require().Equal(types.MerkleRoot(txHashes).Hex(), batch.TxRoot)
+	suite.Require().Equal(uint64(0), batch.WithdrawalCount)
+	suite.Require().Equal(types.MerkleRoot(nil).Hex(), batch.WithdrawalRoot)
+	suite.Require().NoError(batch.Validate())
+	suite.Require().Empty(k.GetPendingTxHashes(suite.ctx))
+	suite.Require().Zero(k.GetPendingStartHeight(suite.ctx))
+	suite.Require().Zero(k.GetPendingEndHeight(suite.ctx))
+
+	for height := int64(4); height <= 7; height++ {
+		suite.endBlock(height)
+	}
+	batch, found = k.GetLatestBatch(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), batch.Index)
+	suite.Require().Equal(int64(4), batch.StartHeight)
+	suite.Require().Equal(int64(6), batch.EndHeight)
+	suite.Require().Equal(uint64(0), batch.TxCount)
+	suite.Require().Equal(int64(7), k.GetPendingStartHeight(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDisableBatches() {
+	k := suite.app.BatchKeeper
+
+	// the open batch is committed as soon as the batches are disabled
+	suite.executeTx(false)
+	suite.Require().Equal(int64(1), k.GetPendingStartHeight(suite.ctx))
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(0)))
+	suite.endBlock(1)
+	batch, found := k.GetLatestBatch(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), batch.EndHeight)
+	suite.Require().Equal(uint64(1), batch.TxCount)
+
+	// no transaction is recorded nor batch committed while disabled
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	suite.executeTx(false)
+	suite.endBlock(2)
+	suite.Require().Empty(k.GetPendingTxHashes(suite.ctx))
+	suite.Require().Zero(k.GetPendingStartHeight(suite.ctx))
+	batch, _ = k.GetLatestBatch(suite.ctx)
+	suite.Require().Equal(uint64(1), batch.Index)
+
+	// re-enabled batches start at the next block
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(1)))
+	suite.endBlock(5)
+	batch, _ = k.GetLatestBatch(suite.ctx)
+	suite.Require().Equal(uint64(2), batch.Index)
+	suite.Require().Equal(int64(5), batch.StartHeight)
+	suite.Require().Equal(int64(5), batch.EndHeight)
+}
+
+func (suite *KeeperTestSuite) TestCommitBatchWithdrawals() {
+	k := suite.app.BatchKeeper
+
+	suite.queueWithdrawals(3)
+	first, err := k.CommitBatch(suite.ctx, 1, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), first.FirstWithdrawalId)
+	suite.Require().Equal(uint64(3), first.WithdrawalCount)
+
+	second, err := k.CommitBatch(suite.ctx, 2, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), second.FirstWithdrawalId)
+	suite.Require().Equal(uint64(0), second.WithdrawalCount)
+
+	suite.queueWithdrawals(1)
+	third, err := k.CommitBatch(suite.ctx, 3, 3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), third.FirstWithdrawalId)
+	suite.Require().Equal(uint64(1), third.WithdrawalCount)
+
+	for id, expBatch := range map[uint64]types.Batch{1: first, 3: first, 4: third} {
+		proof, found, err := k.GetWithdrawalProof(suite.ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().True(found)
+		suite.Require().Equal(expBatch.Index, proof.BatchIndex)
+		suite.Require().Equal(expBatch.WithdrawalRoot, proof.WithdrawalRoot)
+		suite.Require().NoError(proof.Verify())
+
+		withdrawal, found := suite.app.BTCBridgeKeeper.GetWithdrawal(suite.ctx, id)
+		suite.Require().True(found)
+		leaf, err := types.WithdrawalLeaf(withdrawal)
+		suite.Require().NoError(err)
+		suite.Require().Equal(leaf, proof.Leaf)
+	}
+
+	// the proofs don't depend on the withdrawal status
+	withdrawal, _ := suite.app.BTCBridgeKeeper.GetWithdrawal(suite.ctx, 1)
+	withdrawal.Status = btcbridgetypes.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED
+	withdrawal.PayoutTxid = common.Hash{}.Hex()[2:]
+	suite.app.BTCBridgeKeeper.SetWithdrawal(suite.ctx, withdrawal)
+	proof, found, err := k.GetWithdrawalProof(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().NoError(proof.Verify())
+
+	// a queued withdrawal is not committed until the next batch
+	suite.queueWithdrawals(1)
+	_, found, err = k.GetWithdrawalProof(suite.ctx, 5)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
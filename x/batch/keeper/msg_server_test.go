@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(10),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run("MsgUpdateParams", func() {
+			_, err := suite.app.BatchKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.BatchKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	btcbridgetypes "github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+var (
+	uint64Type, _  = abi.NewType("uint64", "", nil)
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+	stringType, _  = abi.NewType("string", "", nil)
+
+	// batchArguments is the ABI encoding of the batch fields committed to by the batch hash.
+	batchArguments = abi.Arguments{
+		{Name: "index", Type: uint64Type},
+		{Name: "startHeight", Type: uint64Type},
+		{Name: "endHeight", Type: uint64Type},
+		{Name: "stateRoot", Type: bytes32Type},
+		{Name: "txRoot", Type: bytes32Type},
+		{Name: "txCount", Type: uint64Type},
+		{Name: "withdrawalRoot", Type: bytes32Type},
+		{Name: "firstWithdrawalId", Type: uint64Type},
+		{Name: "withdrawalCount", Type: uint64Type},
+	}
+
+	// withdrawalArguments is the ABI encoding of the withdrawal leaves.
+	withdrawalArguments = abi.Arguments{
+		{Name: "id", Type: uint64Type},
+		{Name: "sender", Type: addressType},
+		{Name: "btcAddress", Type: stringType},
+		{Name: "amount", Type: uint64Type},
+	}
+)
+
+// WithdrawalLeaf returns the merkle tree leaf of a withdrawal, the ABI encoding of its id, sender,
+// Bitcoin address and amount. The status and payout are left out as they change after the batch
+// is committed.
+func WithdrawalLeaf(withdrawal btcbridgetypes.Withdrawal) ([]byte, error) {
+	return withdrawalArguments.Pack(
+		withdrawal.Id,
+		common.HexToAddress(withdrawal.Sender),
+		withdrawal.BtcAddress,
+		withdrawal.Amount,
+	)
+}
+
+// ComputeHash returns the commitment to the batch fields, the Keccak-256 hash of their ABI
+// encoding.
+func (b Batch) ComputeHash() (common.Hash, error) {
+	roots := make([]common.Hash, 3)
+	for i, root := range []string{b.StateRoot, b.TxRoot, b.WithdrawalRoot} {
+		hash, err := ParseHash(root)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		roots[i] = hash
+	}
+
+	bz, err := batchArguments.Pack(
+		b.Index,
+		uint64(b.StartHeight),
+		uint64(b.EndHeight),
+		roots[0],
+		roots[1],
+		b.TxCount,
+		roots[2],
+		b.FirstWithdrawalId,
+		b.WithdrawalCount,
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(bz), nil
+}
+
+// Validate performs a basic validation of the batch fields.
+func (b Batch) Validate() error {
+	if b.Index == 0 {
+		return fmt.Errorf("index cannot be zero")
+	}
+	if b.StartHeight <= 0 {
+		return fmt.Errorf("start height must be positive, got %d", b.StartHeight)
+	}
+	if b.EndHeight < b.StartHeight {
+		return fmt.Errorf("end height %d is below the start height %d", b.EndHeight, b.StartHeight)
+	}
+	if b.FirstWithdrawalId == 0 {
+		return fmt.Errorf("first withdrawal id cannot be zero")
+	}
+
+	hash, err := b.ComputeHash()
+	if err != nil {
+		return err
+	}
+	if hash.Hex() != b.Hash {
+		return fmt.Errorf("batch hash mismatch, expected %s, got %s", hash, b.Hash)
+	}
+	return nil
+}
+
+// ContainsWithdrawal returns true if the withdrawal was requested in the batch.
+func (b Batch) ContainsWithdrawal(id uint64) bool {
+	return id >= b.FirstWithdrawalId && id-b.FirstWithdrawalId < b.WithdrawalCount
+}
+
+// Verify verifies the merkle proof of the withdrawal against the withdrawal root.
+func (p WithdrawalProof) Verify() error {
+	root, err := ParseHash(p.WithdrawalRoot)
+	if err != nil {
+		return err
+	}
+
+	siblings := make([]common.Hash, len(p.Siblings))
+	for i, sibling := range p.Siblings {
+		if siblings[i], err = ParseHash(sibling); err != nil {
+			return err
+		}
+	}
+
+	if !VerifyMerkleProof(root, p.Leaf, p.LeafIndex, p.LeafCount, siblings) {
+		return fmt.Errorf("invalid merkle proof of withdrawal %d against root %s", p.WithdrawalId, p.WithdrawalRoot)
+	}
+	return nil
+}
+
+// ParseHash decodes a 0x prefixed, hex encoded 32 bytes hash.
+func ParseHash(s string) (common.Hash, error) {
+	bz, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid hash %q: %w", s, err)
+	}
+	if len(bz) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid hash %q: expected %d bytes, got %d", s, common.HashLength, len(bz))
+	}
+	return common.BytesToHash(bz), nil
+}
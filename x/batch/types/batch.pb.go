@@ -0,0 +1,1293 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/batch/v1/batch.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the batch module parameters
+type Params struct {
+	// batch_interval is the number of blocks covered by a batch. Batches are
+	// disabled if zero.
+	BatchInterval uint64 `protobuf:"varint,1,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81a66eec5ad3328, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBatchInterval() uint64 {
+	if m != nil {
+		return m.BatchInterval
+	}
+	return 0
+}
+
+// Batch is the commitment to a range of blocks posted to Bitcoin by the
+// inscribers
+type Batch struct {
+	// index is the sequence number of the batch, starting at 1.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// start_height is the first block height covered by the batch.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height covered by the batch.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// state_root is the hex encoded EVM state root after the end height block,
+	// i.e. the app hash of the next block header.
+	StateRoot string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// tx_root is the hex encoded merkle root of the hashes of the EVM
+	// transactions executed in the batch.
+	TxRoot string `protobuf:"bytes,5,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
+	// tx_count is the number of EVM transactions executed in the batch.
+	TxCount uint64 `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// withdrawal_root is the hex encoded merkle root of the BTC bridge
+	// withdrawals requested in the batch.
+	WithdrawalRoot string `protobuf:"bytes,7,opt,name=withdrawal_root,json=withdrawalRoot,proto3" json:"withdrawal_root,omitempty"`
+	// first_withdrawal_id is the id of the first withdrawal of the batch.
+	FirstWithdrawalId uint64 `protobuf:"varint,8,opt,name=first_withdrawal_id,json=firstWithdrawalId,proto3" json:"first_withdrawal_id,omitempty"`
+	// withdrawal_count is the number of withdrawals requested in the batch.
+	WithdrawalCount uint64 `protobuf:"varint,9,opt,name=withdrawal_count,json=withdrawalCount,proto3" json:"withdrawal_count,omitempty"`
+	// hash is the hex encoded commitment to all the other batch fields.
+	Hash string `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *Batch) Reset()         { *m = Batch{} }
+func (m *Batch) String() string { return proto.CompactTextString(m) }
+func (*Batch) ProtoMessage()    {}
+func (*Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81a66eec5ad3328, []int{1}
+}
+func (m *Batch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Batch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Batch.Merge(m, src)
+}
+func (m *Batch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Batch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Batch proto.InternalMessageInfo
+
+func (m *Batch) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Batch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Batch) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Batch) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *Batch) GetTxRoot() string {
+	if m != nil {
+		return m.TxRoot
+	}
+	return ""
+}
+
+func (m *Batch) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *Batch) GetWithdrawalRoot() string {
+	if m != nil {
+		return m.WithdrawalRoot
+	}
+	return ""
+}
+
+func (m *Batch) GetFirstWithdrawalId() uint64 {
+	if m != nil {
+		return m.FirstWithdrawalId
+	}
+	return 0
+}
+
+func (m *Batch) GetWithdrawalCount() uint64 {
+	if m != nil {
+		return m.WithdrawalCount
+	}
+	return 0
+}
+
+func (m *Batch) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// WithdrawalProof is the merkle proof of a withdrawal against the withdrawal
+// root of its batch
+type WithdrawalProof struct {
+	// batch_index is the index of the batch including the withdrawal.
+	BatchIndex uint64 `protobuf:"varint,1,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
+	// withdrawal_id is the id of the withdrawal.
+	WithdrawalId uint64 `protobuf:"varint,2,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	// leaf is the ABI encoded withdrawal committed to by the merkle tree.
+	Leaf []byte `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// leaf_index is the position of the withdrawal in the merkle tree.
+	LeafIndex uint64 `protobuf:"varint,4,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// leaf_count is the number of leaves of the merkle tree.
+	LeafCount uint64 `protobuf:"varint,5,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// siblings are the hex encoded sibling hashes, from the leaf level.
+	Siblings []string `protobuf:"bytes,6,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// withdrawal_root is the hex encoded withdrawal root of the batch.
+	WithdrawalRoot string `protobuf:"bytes,7,opt,name=withdrawal_root,json=withdrawalRoot,proto3" json:"withdrawal_root,omitempty"`
+}
+
+func (m *WithdrawalProof) Reset()         { *m = WithdrawalProof{} }
+func (m *WithdrawalProof) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalProof) ProtoMessage()    {}
+func (*WithdrawalProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81a66eec5ad3328, []int{2}
+}
+func (m *WithdrawalProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalProof.Merge(m, src)
+}
+func (m *WithdrawalProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalProof proto.InternalMessageInfo
+
+func (m *WithdrawalProof) GetBatchIndex() uint64 {
+	if m != nil {
+		return m.BatchIndex
+	}
+	return 0
+}
+
+func (m *WithdrawalProof) GetWithdrawalId() uint64 {
+	if m != nil {
+		return m.WithdrawalId
+	}
+	return 0
+}
+
+func (m *WithdrawalProof) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *WithdrawalProof) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *WithdrawalProof) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *WithdrawalProof) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *WithdrawalProof) GetWithdrawalRoot() string {
+	if m != nil {
+		return m.WithdrawalRoot
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ethermint.batch.v1.Params")
+	proto.RegisterType((*Batch)(nil), "ethermint.batch.v1.Batch")
+	proto.RegisterType((*WithdrawalProof)(nil), "ethermint.batch.v1.WithdrawalProof")
+}
+
+func init() { proto.RegisterFile("ethermint/batch/v1/batch.proto", fileDescriptor_f81a66eec5ad3328) }
+
+var fileDescriptor_f81a66eec5ad3328 = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0x8e, 0x13, 0xc7, 0x89, 0xe7, 0x72, 0x77, 0xb0, 0x20, 0x61, 0x90, 0x30, 0x21, 0x08, 0x61,
+	0x9a, 0x58, 0x27, 0xde, 0x20, 0x34, 0x5c, 0x77, 0x72, 0x83, 0x44, 0x63, 0x6d, 0xe2, 0x4d, 0xbc,
+	0x52, 0xe2, 0x3d, 0xed, 0xce, 0x25, 0xe6, 0x2d, 0x78, 0x2c, 0x44, 0x95, 0x92, 0x12, 0x25, 0x3d,
+	0xcf, 0x80, 0x3c, 0xeb, 0xc4, 0xa6, 0xbb, 0xca, 0x33, 0xdf, 0xf7, 0xcd, 0x8f, 0xbf, 0x1d, 0x08,
+	0x05, 0xe6, 0x42, 0x6f, 0x64, 0x81, 0xf1, 0x9c, 0xe3, 0x22, 0x8f, 0xb7, 0x37, 0x36, 0x98, 0xde,
+	0x6b, 0x85, 0x8a, 0xb1, 0x33, 0x3f, 0xb5, 0xf0, 0xf6, 0x66, 0x12, 0x83, 0x77, 0xc7, 0x35, 0xdf,
+	0x18, 0xf6, 0x1e, 0xae, 0x08, 0x4d, 0x65, 0x81, 0x42, 0x6f, 0xf9, 0x3a, 0x70, 0xc6, 0x4e, 0xe4,
+	0x26, 0x97, 0x84, 0xde, 0xd6, 0xe0, 0xe4, 0x57, 0x17, 0xfa, 0xb3, 0x0a, 0x61, 0xcf, 0xa1, 0x2f,
+	0x8b, 0x4c, 0x94, 0xb5, 0xce, 0x26, 0xec, 0x2d, 0x8c, 0x0c, 0x72, 0x8d, 0x69, 0x2e, 0xe4, 0x2a,
+	0xc7, 0xa0, 0x3b, 0x76, 0xa2, 0x5e, 0x72, 0x41, 0xd8, 0x17, 0x82, 0xd8, 0x6b, 0x00, 0x51, 0x64,
+	0x27, 0x41, 0x8f, 0x04, 0xbe, 0x28, 0xb2, 0x86, 0x36, 0xc8, 0x51, 0xa4, 0x5a, 0x29, 0x0c, 0xdc,
+	0xb1, 0x13, 0xf9, 0x89, 0x4f, 0x48, 0xa2, 0x14, 0xb2, 0x17, 0x30, 0xc0, 0xd2, 0x72, 0x7d, 0xe2,
+	0x3c, 0x2c, 0x89, 0x78, 0x09, 0x43, 0x2c, 0xd3, 0x85, 0x7a, 0x28, 0x30, 0xf0, 0x68, 0xa5, 0x01,
+	0x96, 0x9f, 0xab, 0x94, 0x7d, 0x80, 0xeb, 0x9d, 0xc4, 0x3c, 0xd3, 0x7c, 0xc7, 0xd7, 0xb6, 0x76,
+	0x40, 0xb5, 0x57, 0x0d, 0x4c, 0x3d, 0xa6, 0xf0, 0x6c, 0x29, 0xb5, 0xc1, 0xb4, 0x25, 0x97, 0x59,
+	0x30, 0xa4, 0x76, 0x4f, 0x89, 0xfa, 0x7a, 0x66, 0x6e, 0x33, 0xf6, 0x11, 0x9e, 0xb4, 0x94, 0x76,
+	0xb6, 0x4f, 0xe2, 0xd6, 0x40, 0xbb, 0x03, 0x03, 0x37, 0xe7, 0x26, 0x0f, 0x80, 0x06, 0x53, 0x3c,
+	0xf9, 0xeb, 0xc0, 0x75, 0xd3, 0xef, 0x4e, 0x2b, 0xb5, 0x64, 0x6f, 0xe0, 0xe2, 0xf4, 0x0e, 0x8d,
+	0xb9, 0x50, 0x3f, 0x42, 0xe5, 0xf0, 0x3b, 0xb8, 0xfc, 0x7f, 0xbb, 0x2e, 0x49, 0x46, 0xbb, 0xf6,
+	0x62, 0x0c, 0xdc, 0xb5, 0xe0, 0x4b, 0x72, 0x77, 0x94, 0x50, 0x5c, 0x19, 0x5b, 0x7d, 0xeb, 0xc6,
+	0x2e, 0x55, 0xf9, 0x15, 0x62, 0xfb, 0x9e, 0x68, 0xfb, 0x17, 0xfd, 0x86, 0xb6, 0xfb, 0xbf, 0x82,
+	0xa1, 0x91, 0xf3, 0xb5, 0x2c, 0x56, 0x26, 0xf0, 0xc6, 0xbd, 0xc8, 0x4f, 0xce, 0xf9, 0xa3, 0xfd,
+	0x9d, 0xcd, 0x7e, 0x1e, 0x42, 0x67, 0x7f, 0x08, 0x9d, 0x3f, 0x87, 0xd0, 0xf9, 0x71, 0x0c, 0x3b,
+	0xfb, 0x63, 0xd8, 0xf9, 0x7d, 0x0c, 0x3b, 0xdf, 0xa2, 0x95, 0xc4, 0xfc, 0x61, 0x3e, 0x5d, 0xa8,
+	0x4d, 0x2c, 0xb6, 0x1b, 0x65, 0xe2, 0xe6, 0x9a, 0xcb, 0xfa, 0x9e, 0xf1, 0xfb, 0xbd, 0x30, 0x73,
+	0x8f, 0xae, 0xf9, 0xd3, 0xbf, 0x01, 0x00, 0xd4, 0x6a, 0x90, 0xc0, 0xef, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchInterval != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.WithdrawalCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.WithdrawalCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FirstWithdrawalId != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.FirstWithdrawalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WithdrawalRoot) > 0 {
+		i -= len(m.WithdrawalRoot)
+		copy(dAtA[i:], m.WithdrawalRoot)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.WithdrawalRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxRoot) > 0 {
+		i -= len(m.TxRoot)
+		copy(dAtA[i:], m.TxRoot)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TxRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawalRoot) > 0 {
+		i -= len(m.WithdrawalRoot)
+		copy(dAtA[i:], m.WithdrawalRoot)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.WithdrawalRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintBatch(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WithdrawalId != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchIndex != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchInterval != 0 {
+		n += 1 + sovBatch(uint64(m.BatchInterval))
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBatch(uint64(m.Index))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBatch(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBatch(uint64(m.EndHeight))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.TxRoot)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovBatch(uint64(m.TxCount))
+	}
+	l = len(m.WithdrawalRoot)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.FirstWithdrawalId != 0 {
+		n += 1 + sovBatch(uint64(m.FirstWithdrawalId))
+	}
+	if m.WithdrawalCount != 0 {
+		n += 1 + sovBatch(uint64(m.WithdrawalCount))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchIndex != 0 {
+		n += 1 + sovBatch(uint64(m.BatchIndex))
+	}
+	if m.WithdrawalId != 0 {
+		n += 1 + sovBatch(uint64(m.WithdrawalId))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.LeafIndex != 0 {
+		n += 1 + sovBatch(uint64(m.LeafIndex))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovBatch(uint64(m.LeafCount))
+	}
+	if len(m.Siblings) > 0 {
+		for _, s := range m.Siblings {
+			l = len(s)
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	l = len(m.WithdrawalRoot)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchInterval", wireType)
+			}
+			m.BatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstWithdrawalId", wireType)
+			}
+			m.FirstWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+			}
+			m.WithdrawalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState sets default batch genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 0, 0, nil)
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, batches []Batch, pendingStartHeight, pendingEndHeight int64, pendingTxHashes []string) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		Batches:            batches,
+		PendingStartHeight: pendingStartHeight,
+		PendingTxHashes:    pendingTxHashes,
+		PendingEndHeight:   pendingEndHeight,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. The batches must be ordered by index and cover increasing block heights and contiguous
+// withdrawal ids.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	var prev *Batch
+	for i, batch := range gs.Batches {
+		if err := batch.Validate(); err != nil {
+			return fmt.Errorf("invalid batch %d: %w", batch.Index, err)
+		}
+		if prev != nil {
+			if batch.Index != prev.Index+1 {
+				return fmt.Errorf("batch %d doesn't follow batch %d", batch.Index, prev.Index)
+			}
+			if batch.StartHeight <= prev.EndHeight {
+				return fmt.Errorf("batch %d start height %d is not above the previous end height %d", batch.Index, batch.StartHeight, prev.EndHeight)
+			}
+			if batch.FirstWithdrawalId != prev.FirstWithdrawalId+prev.WithdrawalCount {
+				return fmt.Errorf("batch %d first withdrawal %d doesn't follow the previous batch withdrawals", batch.Index, batch.FirstWithdrawalId)
+			}
+		} else if batch.Index != 1 {
+			return fmt.Errorf("first batch index must be 1, got %d", batch.Index)
+		}
+		prev = &gs.Batches[i]
+	}
+
+	if gs.PendingStartHeight == 0 {
+		if len(gs.PendingTxHashes) > 0 {
+			return fmt.Errorf("pending transactions without an open batch")
+		}
+		if gs.PendingEndHeight != 0 {
+			return fmt.Errorf("pending end height without an open batch")
+		}
+		return nil
+	}
+
+	if gs.PendingStartHeight < 0 {
+		return fmt.Errorf("pending start height cannot be negative, got %d", gs.PendingStartHeight)
+	}
+	if prev != nil && gs.PendingStartHeight <= prev.EndHeight {
+		return fmt.Errorf("pending start height %d is not above the last batch end height %d", gs.PendingStartHeight, prev.EndHeight)
+	}
+	if gs.PendingEndHeight != 0 && gs.PendingEndHeight < gs.PendingStartHeight {
+		return fmt.Errorf("pending end height %d is below the pending start height %d", gs.PendingEndHeight, gs.PendingStartHeight)
+	}
+	for _, txHash := range gs.PendingTxHashes {
+		if _, err := ParseHash(txHash); err != nil {
+			return fmt.Errorf("invalid pending transaction: %w", err)
+		}
+	}
+	return nil
+}
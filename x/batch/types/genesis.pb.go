@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/batch/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the batch module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// batches are the committed batches.
+	Batches []Batch `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches"`
+	// pending_start_height is the first block height of the open batch, zero if
+	// no batch is open.
+	PendingStartHeight int64 `protobuf:"varint,3,opt,name=pending_start_height,json=pendingStartHeight,proto3" json:"pending_start_height,omitempty"`
+	// pending_tx_hashes are the hex encoded hashes of the EVM transactions
+	// executed in the open batch.
+	PendingTxHashes []string `protobuf:"bytes,4,rep,name=pending_tx_hashes,json=pendingTxHashes,proto3" json:"pending_tx_hashes,omitempty"`
+	// pending_end_height is the last block height of the open batch once it is
+	// closed, the batch is committed at the beginning of the next block. Zero if
+	// the open batch is not closed.
+	PendingEndHeight int64 `protobuf:"varint,5,opt,name=pending_end_height,json=pendingEndHeight,proto3" json:"pending_end_height,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81cd56d13d07584e, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBatches() []Batch {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingStartHeight() int64 {
+	if m != nil {
+		return m.PendingStartHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingTxHashes() []string {
+	if m != nil {
+		return m.PendingTxHashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingEndHeight() int64 {
+	if m != nil {
+		return m.PendingEndHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ethermint.batch.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("ethermint/batch/v1/genesis.proto", fileDescriptor_81cd56d13d07584e) }
+
+var fileDescriptor_81cd56d13d07584e = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x93, 0xa6, 0x14, 0xe1, 0x22, 0x01, 0x56, 0x87, 0xd2, 0xc1, 0x44, 0x4c, 0x11, 0x42,
+	0x31, 0x2d, 0x0b, 0xac, 0x91, 0x10, 0x1d, 0x51, 0xcb, 0xc4, 0x12, 0xb9, 0xad, 0x65, 0x67, 0x88,
+	0x1d, 0xc5, 0x47, 0x55, 0x9e, 0x02, 0x1e, 0xab, 0x63, 0x47, 0x26, 0x84, 0xda, 0x17, 0x41, 0x71,
+	0x1c, 0x18, 0xe8, 0x76, 0xba, 0xef, 0xfb, 0xff, 0x93, 0x0e, 0x85, 0x1c, 0x24, 0x2f, 0xf3, 0x4c,
+	0x01, 0x9d, 0x31, 0x98, 0x4b, 0xba, 0x1c, 0x52, 0xc1, 0x15, 0x37, 0x99, 0x89, 0x8b, 0x52, 0x83,
+	0xc6, 0xf8, 0xd7, 0x88, 0xad, 0x11, 0x2f, 0x87, 0x03, 0xb2, 0x27, 0x55, 0x43, 0x9b, 0x19, 0xf4,
+	0x84, 0x16, 0xda, 0x8e, 0xb4, 0x9a, 0xea, 0xed, 0xe5, 0x7b, 0x0b, 0x1d, 0x3f, 0xd6, 0xdd, 0x53,
+	0x60, 0xc0, 0xf1, 0x1d, 0xea, 0x14, 0xac, 0x64, 0xb9, 0xe9, 0xfb, 0xa1, 0x1f, 0x75, 0x47, 0x83,
+	0xf8, 0xff, 0xad, 0xf8, 0xc9, 0x1a, 0x49, 0x7b, 0xfd, 0x75, 0xe1, 0x4d, 0x9c, 0x8f, 0xef, 0xd1,
+	0xa1, 0x15, 0xb8, 0xe9, 0xb7, 0xc2, 0x20, 0xea, 0x8e, 0xce, 0xf7, 0x45, 0x93, 0x6a, 0x70, 0xc9,
+	0xc6, 0xc7, 0x37, 0xa8, 0x57, 0x70, 0xb5, 0xc8, 0x94, 0x48, 0x0d, 0xb0, 0x12, 0x52, 0xc9, 0x33,
+	0x21, 0xa1, 0x1f, 0x84, 0x7e, 0x14, 0x4c, 0xb0, 0x63, 0xd3, 0x0a, 0x8d, 0x2d, 0xc1, 0x57, 0xe8,
+	0xac, 0x49, 0xc0, 0x2a, 0x95, 0xcc, 0x54, 0x67, 0xdb, 0x61, 0x10, 0x1d, 0x4d, 0x4e, 0x1c, 0x78,
+	0x5e, 0x8d, 0xed, 0x1a, 0x5f, 0xa3, 0xa6, 0x21, 0xe5, 0x6a, 0xd1, 0x74, 0x1f, 0xd8, 0xee, 0x53,
+	0x47, 0x1e, 0xd4, 0xa2, 0x6e, 0x4e, 0x92, 0xf5, 0x96, 0xf8, 0x9b, 0x2d, 0xf1, 0xbf, 0xb7, 0xc4,
+	0xff, 0xd8, 0x11, 0x6f, 0xb3, 0x23, 0xde, 0xe7, 0x8e, 0x78, 0x2f, 0x91, 0xc8, 0x40, 0xbe, 0xce,
+	0xe2, 0xb9, 0xce, 0x29, 0x5f, 0xe6, 0xda, 0xd0, 0xbf, 0x97, 0xaf, 0xdc, 0xd3, 0xe1, 0xad, 0xe0,
+	0x66, 0xd6, 0xb1, 0xcf, 0xbd, 0xfd, 0x19, 0x00, 0xab, 0x3c, 0x8a, 0x11, 0xca, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingEndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PendingTxHashes) > 0 {
+		for iNdEx := len(m.PendingTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingTxHashes[iNdEx])
+			copy(dAtA[i:], m.PendingTxHashes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PendingStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingStartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingStartHeight))
+	}
+	if len(m.PendingTxHashes) > 0 {
+		for _, s := range m.PendingTxHashes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingEndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingEndHeight))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, Batch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStartHeight", wireType)
+			}
+			m.PendingStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxHashes = append(m.PendingTxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEndHeight", wireType)
+			}
+			m.PendingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
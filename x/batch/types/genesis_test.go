@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+// newBatch returns a valid batch with the given index, heights and withdrawals.
+func newBatch(t *testing.T, index uint64, start, end int64, firstWithdrawalID, withdrawalCount uint64) types.Batch {
+	batch := types.Batch{
+		Index:             index,
+		StartHeight:       start,
+		EndHeight:         end,
+		StateRoot:         common.Hash{1}.Hex(),
+		TxRoot:            types.MerkleRoot(nil).Hex(),
+		WithdrawalRoot:    types.MerkleRoot(nil).Hex(),
+		FirstWithdrawalId: firstWithdrawalID,
+		WithdrawalCount:   withdrawalCount,
+	}
+	hash, err := batch.ComputeHash()
+	require.NoError(t, err)
+	batch.Hash = hash.Hex()
+	return batch
+}
+
+func TestGenesisValidate(t *testing.T) {
+	params := types.DefaultParams()
+	first := newBatch(t, 1, 1, 100, 1, 2)
+	second := newBatch(t, 2, 101, 200, 3, 0)
+	txHash := common.Hash{2}.Hex()
+
+	tamperedHash := first
+	tamperedHash.TxCount = 1
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"disabled", types.NewGenesisState(types.NewParams(0), nil, 0, 0, nil), true},
+		{"batches and open batch", types.NewGenesisState(params, []types.Batch{first, second}, 201, 0, []string{txHash}), true},
+		{"gap between batches", types.NewGenesisState(params, []types.Batch{first, newBatch(t, 2, 150, 200, 3, 0)}, 0, 0, nil), true},
+		{"first index not 1", types.NewGenesisState(params, []types.Batch{second}, 0, 0, nil), false},
+		{"non consecutive index", types.NewGenesisState(params, []types.Batch{first, newBatch(t, 3, 101, 200, 3, 0)}, 0, 0, nil), false},
+		{"overlapping heights", types.NewGenesisState(params, []types.Batch{first, newBatch(t, 2, 100, 200, 3, 0)}, 0, 0, nil), false},
+		{"non contiguous withdrawals", types.NewGenesisState(params, []types.Batch{first, newBatch(t, 2, 101, 200, 4, 0)}, 0, 0, nil), false},
+		{"end below start", types.NewGenesisState(params, []types.Batch{newBatch(t, 1, 10, 9, 1, 0)}, 0, 0, nil), false},
+		{"zero first withdrawal id", types.NewGenesisState(params, []types.Batch{newBatch(t, 1, 1, 100, 0, 0)}, 0, 0, nil), false},
+		{"hash mismatch", types.NewGenesisState(params, []types.Batch{tamperedHash}, 0, 0, nil), false},
+		{"pending txs without open batch", types.NewGenesisState(params, nil, 0, 0, []string{txHash}), false},
+		{"open batch below last batch", types.NewGenesisState(params, []types.Batch{first}, 100, 0, nil), false},
+		{"closed batch", types.NewGenesisState(params, []types.Batch{first, second}, 201, 300, []string{txHash}), true},
+		{"end height without open batch", types.NewGenesisState(params, nil, 0, 300, nil), false},
+		{"end height below open batch", types.NewGenesisState(params, []types.Batch{first, second}, 201, 200, nil), false},
+		{"invalid pending tx", types.NewGenesisState(params, nil, 1, 0, []string{"0x01"}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	btcbridgetypes "github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+// BTCBridgeKeeper defines the expected interface needed to commit to the withdrawal requests.
+type BTCBridgeKeeper interface {
+	GetWithdrawal(ctx sdk.Context, id uint64) (btcbridgetypes.Withdrawal, bool)
+	GetNextWithdrawalID(ctx sdk.Context) uint64
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "batch"
+
+	// StoreKey key for the batch commitments.
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the batch persistent store
+const (
+	prefixParams = iota + 1
+	prefixBatch
+	prefixWithdrawalBatch
+	prefixPendingTx
+	prefixPendingTxCount
+	prefixPendingStartHeight
+	prefixPendingEndHeight
+)
+
+// KVStore key prefixes
+var (
+	KeyParams                = []byte{prefixParams}
+	KeyPrefixBatch           = []byte{prefixBatch}
+	KeyPrefixWithdrawalBatch = []byte{prefixWithdrawalBatch}
+	KeyPrefixPendingTx       = []byte{prefixPendingTx}
+	KeyPendingTxCount        = []byte{prefixPendingTxCount}
+	KeyPendingStartHeight    = []byte{prefixPendingStartHeight}
+	KeyPendingEndHeight      = []byte{prefixPendingEndHeight}
+)
+
+// BatchKey returns the key of a batch, under the batch prefix.
+func BatchKey(index uint64) []byte {
+	return sdk.Uint64ToBigEndian(index)
+}
+
+// WithdrawalBatchKey returns the key of the first withdrawal id of a batch, under the withdrawal
+// batch prefix.
+func WithdrawalBatchKey(withdrawalID uint64) []byte {
+	return sdk.Uint64ToBigEndian(withdrawalID)
+}
+
+// PendingTxKey returns the key of the n-th transaction hash of the open batch, under the pending
+// tx prefix.
+func PendingTxKey(n uint64) []byte {
+	return sdk.Uint64ToBigEndian(n)
+}
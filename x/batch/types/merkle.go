@@ -0,0 +1,111 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Domain separation prefixes of the merkle tree hashes, as defined by RFC 6962.
+const (
+	leafHashPrefix = 0x00
+	nodeHashPrefix = 0x01
+)
+
+// The batch merkle trees follow the RFC 6962 (Certificate Transparency) construction with the
+// Keccak-256 hash function: leaves and inner nodes are hashed with distinct prefixes and a tree of
+// n leaves is split at the largest power of two smaller than n, so that no leaf is ever duplicated.
+
+// LeafHash returns the hash of a merkle tree leaf.
+func LeafHash(leaf []byte) common.Hash {
+	return crypto.Keccak256Hash([]byte{leafHashPrefix}, leaf)
+}
+
+// nodeHash returns the hash of a merkle tree inner node.
+func nodeHash(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{nodeHashPrefix}, left[:], right[:])
+}
+
+// splitPoint returns the largest power of two smaller than n, n > 1.
+func splitPoint(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+// MerkleRoot returns the root of the merkle tree of the leaves. The root of an empty tree is the
+// hash of an empty string.
+func MerkleRoot(leaves [][]byte) common.Hash {
+	switch len(leaves) {
+	case 0:
+		return crypto.Keccak256Hash()
+	case 1:
+		return LeafHash(leaves[0])
+	}
+	k := splitPoint(len(leaves))
+	return nodeHash(MerkleRoot(leaves[:k]), MerkleRoot(leaves[k:]))
+}
+
+// MerkleProof returns the sibling hashes, from the leaf level, proving the inclusion of the leaf
+// at the given index in the merkle tree of the leaves.
+func MerkleProof(leaves [][]byte, index uint64) ([]common.Hash, error) {
+	if index >= uint64(len(leaves)) {
+		return nil, fmt.Errorf("leaf index %d out of range, tree size %d", index, len(leaves))
+	}
+	return merkleProof(leaves, int(index)), nil
+}
+
+func merkleProof(leaves [][]byte, index int) []common.Hash {
+	if len(leaves) == 1 {
+		return nil
+	}
+	k := splitPoint(len(leaves))
+	if index < k {
+		return append(merkleProof(leaves[:k], index), MerkleRoot(leaves[k:]))
+	}
+	return append(merkleProof(leaves[k:], index-k), MerkleRoot(leaves[:k]))
+}
+
+// VerifyMerkleProof returns true if the sibling hashes prove the inclusion of the leaf at the
+// given index in a merkle tree of count leaves with the given root.
+func VerifyMerkleProof(root common.Hash, leaf []byte, index, count uint64, siblings []common.Hash) bool {
+	if index >= count {
+		return false
+	}
+
+	// RFC 9162, section 2.1.3.2
+	fn, sn := index, count-1
+	hash := LeafHash(leaf)
+	for _, sibling := range siblings {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			hash = nodeHash(sibling, hash)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			hash = nodeHash(hash, sibling)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && hash == root
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/batch/types"
+)
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte{byte(i), 0xff}
+	}
+	return leaves
+}
+
+func TestMerkleRoot(t *testing.T) {
+	leaves := testLeaves(3)
+	node := func(left, right common.Hash) common.Hash {
+		return crypto.Keccak256Hash([]byte{1}, left[:], right[:])
+	}
+
+	require.Equal(t, crypto.Keccak256Hash(), types.MerkleRoot(nil))
+	require.Equal(t, crypto.Keccak256Hash([]byte{0}, leaves[0]), types.MerkleRoot(leaves[:1]))
+	// the odd leaf is not duplicated
+	require.Equal(t,
+		node(node(types.LeafHash(leaves[0]), types.LeafHash(leaves[1])), types.LeafHash(leaves[2])),
+		types.MerkleRoot(leaves),
+	)
+}
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		leaves := testLeaves(n)
+		root := types.MerkleRoot(leaves)
+
+		for i := range leaves {
+			index := uint64(i)
+			siblings, err := types.MerkleProof(leaves, index)
+			require.NoError(t, err)
+			require.True(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), siblings), "leaf %d of %d", i, n)
+
+			require.False(t, types.VerifyMerkleProof(root, []byte{0xfe}, index, uint64(n), siblings))
+			if n > 1 {
+				other := (index + 1) % uint64(n)
+				require.False(t, types.VerifyMerkleProof(root, leaves[i], other, uint64(n), siblings))
+				require.False(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), siblings[1:]))
+			}
+			require.False(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), append(siblings, root)))
+		}
+
+		_, err := types.MerkleProof(leaves, uint64(n))
+		require.Error(t, err)
+		require.False(t, types.VerifyMerkleProof(root, leaves[0], uint64(n), uint64(n), nil))
+	}
+}
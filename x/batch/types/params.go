@@ -0,0 +1,42 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+// DefaultBatchInterval is the default number of blocks covered by a batch
+const DefaultBatchInterval uint64 = 100
+
+// NewParams creates a new Params instance
+func NewParams(batchInterval uint64) Params {
+	return Params{
+		BatchInterval: batchInterval,
+	}
+}
+
+// DefaultParams returns the default batch module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultBatchInterval)
+}
+
+// Validate performs basic validation on the module parameters. A zero batch interval disables
+// the batches.
+func (p Params) Validate() error {
+	return nil
+}
+
+// IsEnabled returns true if batches are committed.
+func (p Params) IsEnabled() bool {
+	return p.BatchInterval > 0
+}
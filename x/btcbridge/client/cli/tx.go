@@ -0,0 +1,196 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+const flagPrevTxs = "prev-txs"
+
+// proofArgsUsage describes the positional arguments of a Bitcoin transaction inclusion proof
+const proofArgsUsage = "RAW_TX_HEX BLOCK_HASH INDEX [MERKLE_HASH...]"
+
+// proofArgsLong describes the Bitcoin transaction inclusion proof arguments
+const proofArgsLong = `The transaction is hex encoded, as returned by "bitcoin-cli getrawtransaction <txid>". The
+block hash, the index of the transaction in the block and the merkle branch hashes, from the leaf
+level, are given in the usual Bitcoin (reversed) hex encoding.`
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewDepositCmd(),
+		NewWithdrawCmd(),
+		NewFulfillWithdrawalsCmd(),
+	)
+	return cmd
+}
+
+// NewDepositCmd returns a CLI command to relay a Bitcoin deposit
+func NewDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit " + proofArgsUsage,
+		Short: "Relay a Bitcoin deposit transaction to mint the deposited amount",
+		Long: `Relay a Bitcoin transaction paying the bridge deposit address, with an OP_RETURN output
+committing to the 20 bytes EVM address of the recipient. ` + proofArgsLong,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := parseProofArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawCmd returns a CLI command to withdraw to a Bitcoin address
+func NewWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw BTC_ADDRESS AMOUNT",
+		Short: "Burn an amount, in satoshis, and queue its withdrawal to a Bitcoin address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFulfillWithdrawalsCmd returns a CLI command to mark withdrawals as paid out
+func NewFulfillWithdrawalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfill-withdrawals WITHDRAWAL_IDS " + proofArgsUsage,
+		Short: "Mark pending withdrawals as fulfilled by a Bitcoin payout transaction",
+		Long: `Mark the comma separated pending withdrawals as fulfilled by a Bitcoin transaction paying
+each of them out in a distinct output. All the inputs of the transaction must spend outputs of the
+deposit address, whose hex encoded transactions are passed with the --prev-txs flag. ` + proofArgsLong,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var ids []uint64
+			for _, s := range strings.Split(args[0], ",") {
+				id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid withdrawal id %q: %w", s, err)
+				}
+				ids = append(ids, id)
+			}
+
+			proof, err := parseProofArgs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			rawPrevTxs, err := cmd.Flags().GetStringSlice(flagPrevTxs)
+			if err != nil {
+				return err
+			}
+			prevTxs := make([][]byte, len(rawPrevTxs))
+			for i, raw := range rawPrevTxs {
+				if prevTxs[i], err = hex.DecodeString(raw); err != nil {
+					return fmt.Errorf("invalid previous transaction %d: %w", i, err)
+				}
+			}
+
+			msg := types.NewMsgFulfillWithdrawals(clientCtx.GetFromAddress(), ids, proof, prevTxs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagPrevTxs, nil, "Comma separated hex encoded transactions spent by the payout inputs")
+	_ = cmd.MarkFlagRequired(flagPrevTxs)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseProofArgs decodes the positional arguments of a Bitcoin transaction inclusion proof.
+func parseProofArgs(args []string) (types.BTCTxProof, error) {
+	rawTx, err := hex.DecodeString(args[0])
+	if err != nil {
+		return types.BTCTxProof{}, fmt.Errorf("invalid raw transaction: %w", err)
+	}
+
+	index, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		return types.BTCTxProof{}, fmt.Errorf("invalid transaction index: %w", err)
+	}
+
+	return types.BTCTxProof{
+		RawTx:       rawTx,
+		MerkleProof: args[3:],
+		Index:       uint32(index),
+		BlockHash:   args[1],
+	}, nil
+}
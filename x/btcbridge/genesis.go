@@ -0,0 +1,77 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package btcbridge
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/btcbridge/keeper"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis. The deposit address is
+// validated against the Bitcoin network of the light client, which must be initialized first.
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, deposit := range data.Deposits {
+		if err := k.SetDeposit(ctx, deposit); err != nil {
+			panic(errorsmod.Wrapf(err, "could not set deposit %s at genesis", deposit.Txid))
+		}
+	}
+
+	for _, withdrawal := range data.Withdrawals {
+		k.SetWithdrawal(ctx, withdrawal)
+		if withdrawal.IsPending() {
+			continue
+		}
+
+		txid, err := types.ParseHash(withdrawal.PayoutTxid)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPayoutOutpoint(ctx, *txid, withdrawal.PayoutVout)
+	}
+
+	k.SetNextWithdrawalID(ctx, data.NextWithdrawalId)
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the BTC bridge module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	deposits := []types.Deposit{}
+	k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	withdrawals := []types.Withdrawal{}
+	k.IterateWithdrawals(ctx, func(withdrawal types.Withdrawal) bool {
+		withdrawals = append(withdrawals, withdrawal)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), deposits, withdrawals, k.GetNextWithdrawalID(ctx))
+}
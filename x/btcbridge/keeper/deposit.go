@@ -0,0 +1,165 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+// ProcessDeposit verifies a Bitcoin deposit transaction against the light client headers and
+// mints the deposited amount to the EVM recipient. A deposit pays the deposit address, in one or
+// more outputs, and commits to the recipient address in a single OP_RETURN output.
+func (k Keeper) ProcessDeposit(ctx sdk.Context, proof types.BTCTxProof) (types.Deposit, error) {
+	params := k.GetParams(ctx)
+	if params.DepositAddress == "" {
+		return types.Deposit{}, types.ErrDepositsDisabled
+	}
+
+	depositScript, err := types.AddressScript(params.DepositAddress, k.lightClientKeeper.GetParams(ctx).ChainParams())
+	if err != nil {
+		return types.Deposit{}, errorsmod.Wrap(types.ErrDepositsDisabled, err.Error())
+	}
+
+	tx, blockHash, err := k.verifyTx(ctx, proof, params.MinConfirmations)
+	if err != nil {
+		return types.Deposit{}, err
+	}
+
+	txid := tx.TxHash()
+	if _, found := k.GetDeposit(ctx, &txid); found {
+		return types.Deposit{}, errorsmod.Wrapf(types.ErrDuplicateDeposit, "txid %s", txid)
+	}
+
+	recipient, amount, err := parseDeposit(tx, depositScript)
+	if err != nil {
+		return types.Deposit{}, err
+	}
+
+	coin, err := types.SatoshisToCoin(k.evmKeeper.GetParams(ctx), amount)
+	if err != nil {
+		return types.Deposit{}, err
+	}
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return types.Deposit{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient.Bytes(), coins); err != nil {
+		return types.Deposit{}, err
+	}
+
+	deposit := types.Deposit{
+		Txid:      txid.String(),
+		Recipient: recipient.Hex(),
+		Amount:    amount,
+		BlockHash: blockHash.String(),
+		Height:    ctx.BlockHeight(),
+	}
+	if err := k.SetDeposit(ctx, deposit); err != nil {
+		return types.Deposit{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeposit,
+			sdk.NewAttribute(types.AttributeKeyTxID, deposit.Txid),
+			sdk.NewAttribute(types.AttributeKeyRecipient, deposit.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+		),
+	)
+
+	return deposit, nil
+}
+
+// verifyTx checks the inclusion proof of a Bitcoin transaction and the confirmations of its block.
+func (k Keeper) verifyTx(ctx sdk.Context, proof types.BTCTxProof, minConfirmations uint64) (*wire.MsgTx, *chainhash.Hash, error) {
+	branch, blockHash, err := proof.Hashes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, confirmations, err := k.lightClientKeeper.VerifyTxInclusion(ctx, proof.RawTx, branch, proof.Index, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if confirmations < minConfirmations {
+		return nil, nil, errorsmod.Wrapf(
+			types.ErrInsufficientConfirmations,
+			"block %s has %d confirmations, required %d", blockHash, confirmations, minConfirmations,
+		)
+	}
+	return tx, blockHash, nil
+}
+
+// parseDeposit returns the recipient and the amount, in satoshis, of a deposit transaction.
+func parseDeposit(tx *wire.MsgTx, depositScript []byte) (common.Address, uint64, error) {
+	var (
+		recipient *common.Address
+		amount    uint64
+	)
+
+	for i, out := range tx.TxOut {
+		switch {
+		case bytes.Equal(out.PkScript, depositScript):
+			if out.Value <= 0 || out.Value > btcutil.MaxSatoshi {
+				return common.Address{}, 0, errorsmod.Wrapf(types.ErrInvalidDeposit, "output %d has an invalid value %d", i, out.Value)
+			}
+			amount += uint64(out.Value)
+			if amount > btcutil.MaxSatoshi {
+				return common.Address{}, 0, errorsmod.Wrap(types.ErrInvalidDeposit, "total value exceeds the bitcoin supply")
+			}
+		case len(out.PkScript) > 0 && out.PkScript[0] == txscript.OP_RETURN:
+			if recipient != nil {
+				return common.Address{}, 0, errorsmod.Wrap(types.ErrInvalidDeposit, "multiple OP_RETURN outputs")
+			}
+			address, ok := parseRecipient(out.PkScript)
+			if !ok {
+				return common.Address{}, 0, errorsmod.Wrapf(types.ErrInvalidDeposit, "output %d doesn't commit to an EVM address", i)
+			}
+			recipient = &address
+		}
+	}
+
+	if amount == 0 {
+		return common.Address{}, 0, errorsmod.Wrap(types.ErrInvalidDeposit, "no output pays the deposit address")
+	}
+	if recipient == nil {
+		return common.Address{}, 0, errorsmod.Wrap(types.ErrInvalidDeposit, "no recipient OP_RETURN output")
+	}
+	return *recipient, amount, nil
+}
+
+// parseRecipient decodes the EVM address pushed by an `OP_RETURN <20 bytes>` output script.
+func parseRecipient(script []byte) (common.Address, bool) {
+	if len(script) != 2+common.AddressLength ||
+		script[0] != txscript.OP_RETURN ||
+		script[1] != txscript.OP_DATA_20 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(script[2:]), true
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	k := suite.app.BTCBridgeKeeper
+	sender := tests.GenerateAddress()
+
+	deposit := suite.depositTx(sender, 100_000)
+	_, err := k.ProcessDeposit(suite.ctx, suite.mineTxs(minConfirmations, deposit)[0])
+	suite.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, 20_000)
+		suite.Require().NoError(err)
+	}
+	payout, prevTxs := suite.payoutTx(wire.NewTxOut(20_000, suite.script(withdrawalAddress)))
+	payoutProof := suite.mineTxs(minConfirmations, payout)[0]
+	suite.Require().NoError(k.FulfillWithdrawals(suite.ctx, []uint64{2}, payoutProof, prevTxs))
+
+	genesis := btcbridge.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Deposits, 1)
+	suite.Require().Len(genesis.Withdrawals, 3)
+	suite.Require().Equal(uint64(4), genesis.NextWithdrawalId)
+
+	// import in a fresh chain
+	suite.SetupTest()
+	k = suite.app.BTCBridgeKeeper
+	btcbridge.InitGenesis(suite.ctx, k, *genesis)
+	suite.Require().Equal(genesis, btcbridge.ExportGenesis(suite.ctx, k))
+
+	// the processed deposits and payouts are still recorded
+	txid := deposit.TxHash()
+	_, found := k.GetDeposit(suite.ctx, &txid)
+	suite.Require().True(found)
+	res, err := suite.queryClient.PendingWithdrawals(suite.ctx, &types.QueryPendingWithdrawalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Withdrawals, 2)
+	suite.Require().True(k.HasPayoutOutpoint(suite.ctx, payout.TxHash(), 0))
+
+	id, err := k.QueueWithdrawal(suite.ctx, sender, withdrawalAddress, 20_000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), id)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisInvalidNetwork() {
+	genesis := types.DefaultGenesisState()
+	genesis.Params.DepositAddress = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh"
+	suite.Require().NoError(genesis.Validate())
+
+	suite.Require().Panics(func() {
+		btcbridge.InitGenesis(suite.ctx, suite.app.BTCBridgeKeeper, *genesis)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Deposit implements the Query/Deposit gRPC method
+func (k Keeper) Deposit(c context.Context, req *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txid, err := types.ParseHash(req.Txid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deposit, found := k.GetDeposit(ctx, txid)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "deposit %s not found", req.Txid)
+	}
+
+	return &types.QueryDepositResponse{
+		Deposit: deposit,
+	}, nil
+}
+
+// Withdrawal implements the Query/Withdrawal gRPC method
+func (k Keeper) Withdrawal(c context.Context, req *types.QueryWithdrawalRequest) (*types.QueryWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawal, found := k.GetWithdrawal(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "withdrawal %d not found", req.Id)
+	}
+
+	return &types.QueryWithdrawalResponse{
+		Withdrawal: withdrawal,
+	}, nil
+}
+
+// PendingWithdrawals implements the Query/PendingWithdrawals gRPC method. It returns the queue of
+// the withdrawals waiting for a payout, ordered by id.
+func (k Keeper) PendingWithdrawals(c context.Context, req *types.QueryPendingWithdrawalsRequest) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingWithdrawal)
+
+	var withdrawals []types.Withdrawal
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		withdrawal, found := k.GetWithdrawal(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "pending withdrawal %d not found", sdk.BigEndianToUint64(key))
+		}
+		withdrawals = append(withdrawals, withdrawal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPendingWithdrawalsResponse{
+		Withdrawals: withdrawals,
+		Pagination:  pageRes,
+	}, nil
+}
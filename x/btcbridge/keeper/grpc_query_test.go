@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryDeposit() {
+	recipient := tests.GenerateAddress()
+	deposit, err := suite.app.BTCBridgeKeeper.ProcessDeposit(suite.ctx, suite.mineTxs(minConfirmations, suite.depositTx(recipient, 100_000))[0])
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Deposit(suite.ctx, &types.QueryDepositRequest{Txid: deposit.Txid})
+	suite.Require().NoError(err)
+	suite.Require().Equal(deposit, res.Deposit)
+
+	_, err = suite.queryClient.Deposit(suite.ctx, &types.QueryDepositRequest{Txid: chainhash.Hash{}.String()})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.Deposit(suite.ctx, &types.QueryDepositRequest{Txid: "txid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryWithdrawals() {
+	k := suite.app.BTCBridgeKeeper
+	sender := tests.GenerateAddress()
+
+	_, err := k.ProcessDeposit(suite.ctx, suite.mineTxs(minConfirmations, suite.depositTx(sender, 100_000))[0])
+	suite.Require().NoError(err)
+	for i := 0; i < 4; i++ {
+		_, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, 20_000)
+		suite.Require().NoError(err)
+	}
+
+	payout, prevTxs := suite.payoutTx(wire.NewTxOut(20_000, suite.script(withdrawalAddress)))
+	suite.Require().NoError(k.FulfillWithdrawals(suite.ctx, []uint64{2}, suite.mineTxs(minConfirmations, payout)[0], prevTxs))
+
+	res, err := suite.queryClient.Withdrawal(suite.ctx, &types.QueryWithdrawalRequest{Id: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED, res.Withdrawal.Status)
+
+	_, err = suite.queryClient.Withdrawal(suite.ctx, &types.QueryWithdrawalRequest{Id: 5})
+	suite.Require().Error(err)
+
+	// the pending queue is ordered by id and skips the fulfilled withdrawals
+	pending, err := suite.queryClient.PendingWithdrawals(suite.ctx, &types.QueryPendingWithdrawalsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(pending.Withdrawals, 2)
+	suite.Require().Equal(uint64(1), pending.Withdrawals[0].Id)
+	suite.Require().Equal(uint64(3), pending.Withdrawals[1].Id)
+	suite.Require().Equal(uint64(3), pending.Pagination.Total)
+
+	pending, err = suite.queryClient.PendingWithdrawals(suite.ctx, &types.QueryPendingWithdrawalsRequest{
+		Pagination: &query.PageRequest{Key: pending.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(pending.Withdrawals, 1)
+	suite.Require().Equal(uint64(4), pending.Withdrawals[0].Id)
+}
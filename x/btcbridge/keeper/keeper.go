@@ -0,0 +1,210 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+// Keeper grants access to the BTC bridge module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the BTC bridge Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	// keepers
+	bankKeeper        types.BankKeeper
+	evmKeeper         types.EVMKeeper
+	lightClientKeeper types.BTCLightClientKeeper
+}
+
+// NewKeeper generates new BTC bridge module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	bankKeeper types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+	lightClientKeeper types.BTCLightClientKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:               cdc,
+		storeKey:          storeKey,
+		authority:         authority,
+		bankKeeper:        bankKeeper,
+		evmKeeper:         evmKeeper,
+		lightClientKeeper: lightClientKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetParams returns the total set of BTC bridge parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the BTC bridge parameters to the param space. The deposit address must belong to
+// the Bitcoin network of the light client.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := params.ValidateNetwork(k.lightClientKeeper.GetParams(ctx).ChainParams()); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyParams, bz)
+	return nil
+}
+
+// GetDeposit returns a processed deposit by Bitcoin transaction id.
+func (k Keeper) GetDeposit(ctx sdk.Context, txid *chainhash.Hash) (types.Deposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeposit)
+	bz := store.Get(txid[:])
+	if len(bz) == 0 {
+		return types.Deposit{}, false
+	}
+
+	var deposit types.Deposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetDeposit stores a processed deposit by Bitcoin transaction id.
+func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) error {
+	txid, err := types.ParseHash(deposit.Txid)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeposit)
+	store.Set(txid[:], k.cdc.MustMarshal(&deposit))
+	return nil
+}
+
+// IterateDeposits iterates over all the processed deposits, until the callback returns true.
+func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetWithdrawal returns a withdrawal request by id.
+func (k Keeper) GetWithdrawal(ctx sdk.Context, id uint64) (types.Withdrawal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawal)
+	bz := store.Get(types.WithdrawalKey(id))
+	if len(bz) == 0 {
+		return types.Withdrawal{}, false
+	}
+
+	var withdrawal types.Withdrawal
+	k.cdc.MustUnmarshal(bz, &withdrawal)
+	return withdrawal, true
+}
+
+// SetWithdrawal stores a withdrawal request and keeps the pending queue in sync with its status.
+func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
+	key := types.WithdrawalKey(withdrawal.Id)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawal)
+	store.Set(key, k.cdc.MustMarshal(&withdrawal))
+
+	pending := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingWithdrawal)
+	if withdrawal.IsPending() {
+		pending.Set(key, []byte{1})
+	} else {
+		pending.Delete(key)
+	}
+}
+
+// IterateWithdrawals iterates over all the withdrawal requests, ordered by id, until the callback
+// returns true.
+func (k Keeper) IterateWithdrawals(ctx sdk.Context, cb func(withdrawal types.Withdrawal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawal)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.Withdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+		if cb(withdrawal) {
+			break
+		}
+	}
+}
+
+// GetNextWithdrawalID returns the id of the next withdrawal request.
+func (k Keeper) GetNextWithdrawalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextWithdrawalID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextWithdrawalID sets the id of the next withdrawal request.
+func (k Keeper) SetNextWithdrawalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextWithdrawalID, sdk.Uint64ToBigEndian(id))
+}
+
+// HasPayoutOutpoint returns true if a Bitcoin transaction output already paid out a withdrawal.
+func (k Keeper) HasPayoutOutpoint(ctx sdk.Context, txid chainhash.Hash, vout uint32) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPayoutOutpoint)
+	return store.Has(types.OutpointKey(txid, vout))
+}
+
+// SetPayoutOutpoint records a Bitcoin transaction output paying out a withdrawal.
+func (k Keeper) SetPayoutOutpoint(ctx sdk.Context, txid chainhash.Hash, vout uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPayoutOutpoint)
+	store.Set(types.OutpointKey(txid, vout), []byte{1})
+}
@@ -0,0 +1,423 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+const (
+	// depositAddress is the regtest taproot address of the federation
+	depositAddress = "bcrt1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqc8gma6"
+	// withdrawalAddress is a regtest segwit address of a user
+	withdrawalAddress = "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"
+	// minConfirmations is the number of confirmations required by the tests
+	minConfirmations = 2
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.EthermintApp
+	queryClient types.QueryClient
+	chainParams *chaincfg.Params
+	params      types.Params
+	prevOut     byte
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.chainParams = &chaincfg.RegressionNetParams
+	suite.params = types.NewParams(depositAddress, minConfirmations, types.DefaultMinWithdrawalAmount, types.DefaultWithdrawalFee, nil)
+
+	suite.app = app.Setup(false, func(app *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		lightClientGenesis := btclightclienttypes.NewNetworkGenesisState(btclightclienttypes.NewParams(suite.chainParams.Name))
+		genesis[btclightclienttypes.ModuleName] = app.AppCodec().MustMarshalJSON(lightClientGenesis)
+		bridgeGenesis := types.NewGenesisState(suite.params, nil, nil, 1)
+		genesis[types.ModuleName] = app.AppCodec().MustMarshalJSON(bridgeGenesis)
+		return genesis
+	})
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.BTCBridgeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// script returns the output script paying a regtest address.
+func (suite *KeeperTestSuite) script(address string) []byte {
+	script, err := types.AddressScript(address, suite.chainParams)
+	suite.Require().NoError(err)
+	return script
+}
+
+// newTx returns a transaction with the given outputs, spending a distinct outpoint.
+func (suite *KeeperTestSuite) newTx(outputs ...*wire.TxOut) *wire.MsgTx {
+	suite.prevOut++
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{suite.prevOut}, 0), nil, nil))
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	return tx
+}
+
+// payoutTx returns a transaction with the given outputs, spending a deposit output, along with
+// the serialized previous transaction.
+func (suite *KeeperTestSuite) payoutTx(outputs ...*wire.TxOut) (*wire.MsgTx, [][]byte) {
+	prevTx := suite.newTx(wire.NewTxOut(100_000, suite.script(depositAddress)))
+	prevTxHash := prevTx.TxHash()
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevTxHash, 0), nil, nil))
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	return tx, [][]byte{serializeTx(suite.T(), prevTx)}
+}
+
+// serializeTx returns the raw bytes of a transaction.
+func serializeTx(t *testing.T, tx *wire.MsgTx) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+	return buf.Bytes()
+}
+
+// depositTx returns a transaction depositing an amount, in satoshis, to an EVM recipient.
+func (suite *KeeperTestSuite) depositTx(recipient common.Address, amount int64) *wire.MsgTx {
+	return suite.newTx(
+		wire.NewTxOut(amount, suite.script(depositAddress)),
+		recipientOutput(recipient),
+	)
+}
+
+// recipientOutput returns the OP_RETURN output committing to an EVM recipient.
+func recipientOutput(recipient common.Address) *wire.TxOut {
+	return wire.NewTxOut(0, append([]byte{txscript.OP_RETURN, txscript.OP_DATA_20}, recipient.Bytes()...))
+}
+
+// mineTxs mines a regtest block including the transactions on top of the light client tip, with
+// the given number of confirmations, and returns the transaction inclusion proofs.
+func (suite *KeeperTestSuite) mineTxs(confirmations int, txs ...*wire.MsgTx) []types.BTCTxProof {
+	lightClient := suite.app.BTCLightClientKeeper
+
+	info, found := lightClient.GetTip(suite.ctx)
+	suite.Require().True(found)
+	tip, err := info.BlockHeader()
+	suite.Require().NoError(err)
+
+	txids := make([]chainhash.Hash, len(txs))
+	for i, tx := range txs {
+		txids[i] = tx.TxHash()
+	}
+	root, err := btclightclienttypes.ComputeMerkleRoot(txids[0], testutil.BTCMerkleBranch(txids, 0), 0)
+	suite.Require().NoError(err)
+
+	block := testutil.MineBTCHeader(tip, suite.chainParams.PowLimitBits, tip.Timestamp.Add(10*time.Minute), root)
+	headers := append([]*wire.BlockHeader{block}, testutil.MineBTCHeaders(block, suite.chainParams.PowLimitBits, confirmations-1, 10*time.Minute)...)
+	suite.Require().NoError(lightClient.InsertBlockHeaders(suite.ctx, headers))
+
+	proofs := make([]types.BTCTxProof, len(txs))
+	for i, tx := range txs {
+		proofs[i] = types.NewBTCTxProof(serializeTx(suite.T(), tx), testutil.BTCMerkleBranch(txids, i), uint32(i), block.BlockHash())
+	}
+	return proofs
+}
+
+// balance returns the EVM balance of an address, in wei.
+func (suite *KeeperTestSuite) balance(address common.Address) *big.Int {
+	return suite.app.EvmKeeper.GetBalance(suite.ctx, address)
+}
+
+// sats returns an amount of satoshis in wei.
+func sats(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), types.WeiPerSatoshi)
+}
+
+func (suite *KeeperTestSuite) TestDeposit() {
+	k := suite.app.BTCBridgeKeeper
+	recipient := tests.GenerateAddress()
+
+	deposit := suite.depositTx(recipient, 150_000)
+	split := suite.newTx(
+		wire.NewTxOut(20_000, suite.script(depositAddress)),
+		wire.NewTxOut(5_000, suite.script(withdrawalAddress)),
+		recipientOutput(recipient),
+		wire.NewTxOut(30_000, suite.script(depositAddress)),
+	)
+	noRecipient := suite.newTx(wire.NewTxOut(1_000, suite.script(depositAddress)))
+	twoRecipients := suite.newTx(
+		wire.NewTxOut(1_000, suite.script(depositAddress)),
+		recipientOutput(recipient),
+		recipientOutput(recipient),
+	)
+	shortRecipient := suite.newTx(
+		wire.NewTxOut(1_000, suite.script(depositAddress)),
+		wire.NewTxOut(0, []byte{txscript.OP_RETURN, txscript.OP_DATA_1, 1}),
+	)
+	noDeposit := suite.newTx(wire.NewTxOut(1_000, suite.script(withdrawalAddress)), recipientOutput(recipient))
+	proofs := suite.mineTxs(minConfirmations, deposit, split, noRecipient, twoRecipients, shortRecipient, noDeposit)
+
+	res, err := k.ProcessDeposit(suite.ctx, proofs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Deposit{
+		Txid:      deposit.TxHash().String(),
+		Recipient: recipient.Hex(),
+		Amount:    150_000,
+		BlockHash: proofs[0].BlockHash,
+		Height:    1,
+	}, res)
+	suite.Require().Equal(sats(150_000), suite.balance(recipient))
+
+	stored, found := k.GetDeposit(suite.ctx, &chainhash.Hash{})
+	suite.Require().False(found)
+	txid := deposit.TxHash()
+	stored, found = k.GetDeposit(suite.ctx, &txid)
+	suite.Require().True(found)
+	suite.Require().Equal(res, stored)
+
+	// a deposit is processed once
+	_, err = k.ProcessDeposit(suite.ctx, proofs[0])
+	suite.Require().ErrorIs(err, types.ErrDuplicateDeposit)
+
+	// the deposit outputs are summed
+	res, err = k.ProcessDeposit(suite.ctx, proofs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(50_000), res.Amount)
+	suite.Require().Equal(sats(200_000), suite.balance(recipient))
+
+	for i, expErr := range map[int]string{
+		2: "no recipient",
+		3: "multiple OP_RETURN",
+		4: "doesn't commit to an EVM address",
+		5: "no output pays the deposit address",
+	} {
+		_, err = k.ProcessDeposit(suite.ctx, proofs[i])
+		suite.Require().ErrorIs(err, types.ErrInvalidDeposit)
+		suite.Require().ErrorContains(err, expErr)
+	}
+	suite.Require().Equal(sats(200_000), suite.balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestDepositVerification() {
+	k := suite.app.BTCBridgeKeeper
+	recipient := tests.GenerateAddress()
+
+	unconfirmed := suite.mineTxs(1, suite.depositTx(recipient, 100_000), suite.newTx())[0]
+	_, err := k.ProcessDeposit(suite.ctx, unconfirmed)
+	suite.Require().ErrorIs(err, types.ErrInsufficientConfirmations)
+
+	// the deposit is processed once confirmed
+	suite.mineTxs(1, suite.newTx())
+	_, err = k.ProcessDeposit(suite.ctx, unconfirmed)
+	suite.Require().NoError(err)
+
+	// invalid merkle proof
+	proof := suite.mineTxs(minConfirmations, suite.depositTx(recipient, 100_000), suite.newTx())[0]
+	proof.Index = 1
+	_, err = k.ProcessDeposit(suite.ctx, proof)
+	suite.Require().ErrorIs(err, btclightclienttypes.ErrInvalidMerkleProof)
+
+	// unknown block
+	proof.BlockHash = chainhash.Hash{1}.String()
+	_, err = k.ProcessDeposit(suite.ctx, proof)
+	suite.Require().ErrorIs(err, btclightclienttypes.ErrHeaderNotFound)
+
+	// deposits are disabled without deposit address
+	params := suite.params
+	params.DepositAddress = ""
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	proof = suite.mineTxs(minConfirmations, suite.depositTx(recipient, 100_000))[0]
+	_, err = k.ProcessDeposit(suite.ctx, proof)
+	suite.Require().ErrorIs(err, types.ErrDepositsDisabled)
+
+	suite.Require().Equal(sats(100_000), suite.balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestWithdraw() {
+	k := suite.app.BTCBridgeKeeper
+	sender := tests.GenerateAddress()
+
+	_, err := k.ProcessDeposit(suite.ctx, suite.mineTxs(minConfirmations, suite.depositTx(sender, 100_000))[0])
+	suite.Require().NoError(err)
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+	tip, found := suite.app.BTCLightClientKeeper.GetTip(suite.ctx)
+	suite.Require().True(found)
+
+	id, err := k.Withdraw(suite.ctx, sender, withdrawalAddress, 30_000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), id)
+	suite.Require().Equal(sats(70_000), suite.balance(sender))
+	suite.Require().Equal(supply.Amount.Sub(sdk.NewIntFromBigInt(sats(30_000))), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+	withdrawal, found := k.GetWithdrawal(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Withdrawal{
+		Id:         1,
+		Sender:     sender.Hex(),
+		BtcAddress: withdrawalAddress,
+		Amount:     30_000,
+		Status:     types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+		Height:     1,
+		BtcHeight:  tip.Height,
+	}, withdrawal)
+
+	id, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, 20_000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), id)
+
+	testCases := []struct {
+		name    string
+		address string
+		amount  uint64
+	}{
+		{"mainnet address", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh", 10_000},
+		{"invalid address", "bcrt1invalid", 10_000},
+		{"below minimum", withdrawalAddress, types.DefaultMinWithdrawalAmount - 1},
+	}
+	for _, tc := range testCases {
+		_, err = k.Withdraw(suite.ctx, sender, tc.address, tc.amount)
+		suite.Require().ErrorIs(err, types.ErrInvalidWithdrawal, tc.name)
+	}
+
+	// insufficient funds
+	_, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, 60_000)
+	suite.Require().Error(err)
+
+	suite.Require().Equal(sats(50_000), suite.balance(sender))
+	suite.Require().Equal(uint64(3), k.GetNextWithdrawalID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestFulfillWithdrawals() {
+	k := suite.app.BTCBridgeKeeper
+	sender := tests.GenerateAddress()
+	fee := int64(types.DefaultWithdrawalFee)
+
+	_, err := k.ProcessDeposit(suite.ctx, suite.mineTxs(minConfirmations, suite.depositTx(sender, 100_000))[0])
+	suite.Require().NoError(err)
+
+	// a payout mined before the withdrawal requests
+	early, earlyPrevTxs := suite.payoutTx(wire.NewTxOut(30_000, suite.script(withdrawalAddress)))
+	earlyProof := suite.mineTxs(minConfirmations, early)[0]
+
+	for _, amount := range []uint64{30_000, 30_000, 20_000} {
+		_, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, amount)
+		suite.Require().NoError(err)
+	}
+
+	payout, prevTxs := suite.payoutTx(
+		wire.NewTxOut(30_000-fee, suite.script(withdrawalAddress)),
+		wire.NewTxOut(40_000, suite.script(depositAddress)),
+		wire.NewTxOut(30_000-fee, suite.script(withdrawalAddress)),
+		wire.NewTxOut(20_000-fee-1, suite.script(withdrawalAddress)),
+	)
+	underpaid, underpaidPrevTxs := suite.payoutTx(wire.NewTxOut(20_000-fee-1, suite.script(withdrawalAddress)))
+	// a payment to the withdrawal address, not spending the deposit address
+	foreignPrevTx := suite.newTx(wire.NewTxOut(100_000, suite.script(withdrawalAddress)))
+	foreignPrevTxHash := foreignPrevTx.TxHash()
+	foreign := wire.NewMsgTx(wire.TxVersion)
+	foreign.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&foreignPrevTxHash, 0), nil, nil))
+	foreign.AddTxOut(wire.NewTxOut(30_000, suite.script(withdrawalAddress)))
+	proofs := suite.mineTxs(minConfirmations, payout, underpaid, foreign)
+
+	// each withdrawal needs a distinct output
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1, 2, 3}, proofs[0], prevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{3}, proofs[1], underpaidPrevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{4}, proofs[0], prevTxs)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalNotFound)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1, 1}, proofs[0], prevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+
+	// the inputs must spend the deposit address
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1}, proofs[2], [][]byte{serializeTx(suite.T(), foreignPrevTx)})
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1}, proofs[0], nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1}, proofs[0], underpaidPrevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+
+	// the payout must be mined after the withdrawal request
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1}, earlyProof, earlyPrevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1, 2}, proofs[0], prevTxs)
+	suite.Require().NoError(err)
+
+	for id, vout := range map[uint64]uint32{1: 0, 2: 2} {
+		withdrawal, found := k.GetWithdrawal(suite.ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(types.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED, withdrawal.Status)
+		suite.Require().Equal(payout.TxHash().String(), withdrawal.PayoutTxid)
+		suite.Require().Equal(vout, withdrawal.PayoutVout)
+		suite.Require().True(k.HasPayoutOutpoint(suite.ctx, payout.TxHash(), vout))
+	}
+
+	// a fulfilled withdrawal can't be fulfilled again
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{1}, proofs[0], prevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+
+	// an output can't pay out several withdrawals
+	_, err = k.Withdraw(suite.ctx, sender, withdrawalAddress, 20_000)
+	suite.Require().NoError(err)
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{4}, proofs[0], prevTxs)
+	suite.Require().ErrorIs(err, types.ErrInvalidPayout)
+
+	// the payout must be confirmed
+	unconfirmedTx, unconfirmedPrevTxs := suite.payoutTx(wire.NewTxOut(20_000, suite.script(withdrawalAddress)))
+	unconfirmed := suite.mineTxs(1, unconfirmedTx)[0]
+	err = k.FulfillWithdrawals(suite.ctx, []uint64{3}, unconfirmed, unconfirmedPrevTxs)
+	suite.Require().ErrorIs(err, types.ErrInsufficientConfirmations)
+
+	withdrawal, _ := k.GetWithdrawal(suite.ctx, 3)
+	suite.Require().True(withdrawal.IsPending())
+}
+
+func (suite *KeeperTestSuite) TestSetParams() {
+	k := suite.app.BTCBridgeKeeper
+	suite.Require().Equal(suite.params, k.GetParams(suite.ctx))
+
+	params := suite.params
+	params.DepositAddress = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh"
+	suite.Require().Error(k.SetParams(suite.ctx, params))
+
+	params = suite.params
+	params.MinConfirmations = 0
+	suite.Require().Error(k.SetParams(suite.ctx, params))
+
+	params = suite.params
+	params.Relayers = []string{"invalid"}
+	suite.Require().Error(k.SetParams(suite.ctx, params))
+
+	suite.Require().Equal(suite.params, k.GetParams(suite.ctx))
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the BTC bridge MsgServer interface. The Msg
+// methods are not implemented by the Keeper as they collide with its query and bridge methods.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// Deposit implements the gRPC MsgServer interface. It verifies the relayed Bitcoin deposit and
+// mints the deposited amount to its EVM recipient. The signer must be a relayer.
+func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsRelayer(msg.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedRelayer, "%s is not a relayer", msg.Signer)
+	}
+
+	deposit, err := k.Keeper.ProcessDeposit(ctx, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	)
+
+	return &types.MsgDepositResponse{Deposit: deposit}, nil
+}
+
+// Withdraw implements the gRPC MsgServer interface. It burns the withdrawn amount from the EVM
+// address of the sender, as mapped for MsgEthereumCall, and queues the withdrawal.
+func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.Withdraw(ctx, evmtypes.SenderToEVMAddress(sender), msg.BtcAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgWithdrawResponse{Id: id}, nil
+}
+
+// FulfillWithdrawals implements the gRPC MsgServer interface. It marks the withdrawals paid out
+// by the proven Bitcoin transaction as fulfilled. The signer must be a relayer.
+func (k msgServer) FulfillWithdrawals(goCtx context.Context, msg *types.MsgFulfillWithdrawals) (*types.MsgFulfillWithdrawalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsRelayer(msg.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedRelayer, "%s is not a relayer", msg.Signer)
+	}
+
+	if err := k.Keeper.FulfillWithdrawals(ctx, msg.WithdrawalIds, msg.Proof, msg.PrevTxs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	)
+
+	return &types.MsgFulfillWithdrawalsResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
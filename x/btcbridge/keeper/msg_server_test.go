@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge/keeper"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	server := keeper.NewMsgServerImpl(suite.app.BTCBridgeKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := tests.GenerateAddress()
+
+	proof := suite.mineTxs(minConfirmations, suite.depositTx(recipient, 100_000))[0]
+
+	// only the relayers can relay deposits
+	_, err := server.Deposit(ctx, types.NewMsgDeposit(signer, proof))
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedRelayer)
+
+	params := suite.app.BTCBridgeKeeper.GetParams(suite.ctx)
+	params.Relayers = []string{signer.String()}
+	suite.Require().NoError(suite.app.BTCBridgeKeeper.SetParams(suite.ctx, params))
+
+	deposit, err := server.Deposit(ctx, types.NewMsgDeposit(signer, proof))
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipient.Hex(), deposit.Deposit.Recipient)
+
+	withdraw, err := server.Withdraw(ctx, types.NewMsgWithdraw(recipient.Bytes(), withdrawalAddress, 40_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), withdraw.Id)
+	suite.Require().Equal(sats(60_000), suite.balance(recipient))
+
+	withdrawal, found := suite.app.BTCBridgeKeeper.GetWithdrawal(suite.ctx, withdraw.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(common.BytesToAddress(recipient.Bytes()).Hex(), withdrawal.Sender)
+
+	payout, prevTxs := suite.payoutTx(wire.NewTxOut(40_000, suite.script(withdrawalAddress)))
+	msg := types.NewMsgFulfillWithdrawals(signer, []uint64{withdraw.Id}, suite.mineTxs(minConfirmations, payout)[0], prevTxs)
+
+	// only the relayers can fulfill withdrawals
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err = server.FulfillWithdrawals(ctx, types.NewMsgFulfillWithdrawals(other, msg.WithdrawalIds, msg.Proof, msg.PrevTxs))
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedRelayer)
+
+	_, err = server.FulfillWithdrawals(ctx, msg)
+	suite.Require().NoError(err)
+
+	withdrawal, _ = suite.app.BTCBridgeKeeper.GetWithdrawal(suite.ctx, withdraw.Id)
+	suite.Require().False(withdrawal.IsPending())
+}
+
+func (suite *KeeperTestSuite) TestMsgServerWithdrawLongSender() {
+	server := keeper.NewMsgServerImpl(suite.app.BTCBridgeKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	params := suite.app.BTCBridgeKeeper.GetParams(suite.ctx)
+	params.Relayers = []string{signer.String()}
+	suite.Require().NoError(suite.app.BTCBridgeKeeper.SetParams(suite.ctx, params))
+
+	// a 32 bytes account, e.g. an interchain account, withdraws from its mapped EVM address
+	sender := sdk.AccAddress(common.HexToHash("0x01").Bytes())
+	evmSender := evmtypes.SenderToEVMAddress(sender)
+	proof := suite.mineTxs(minConfirmations, suite.depositTx(evmSender, 100_000))[0]
+	_, err := server.Deposit(ctx, types.NewMsgDeposit(signer, proof))
+	suite.Require().NoError(err)
+
+	withdraw, err := server.Withdraw(ctx, types.NewMsgWithdraw(sender, withdrawalAddress, 40_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sats(60_000), suite.balance(evmSender))
+	suite.Require().Equal(sats(0), suite.balance(common.BytesToAddress(sender)))
+
+	withdrawal, found := suite.app.BTCBridgeKeeper.GetWithdrawal(suite.ctx, withdraw.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(evmSender.Hex(), withdrawal.Sender)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	server := keeper.NewMsgServerImpl(suite.app.BTCBridgeKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	params := types.DefaultParams()
+
+	_, err := server.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+		Params:    params,
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.BTCBridgeKeeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// Withdraw burns an amount, in satoshis, from the sender balance and queues its withdrawal to a
+// Bitcoin address.
+func (k Keeper) Withdraw(ctx sdk.Context, sender common.Address, btcAddress string, amount uint64) (uint64, error) {
+	if err := k.ValidateWithdrawal(ctx, btcAddress, amount); err != nil {
+		return 0, err
+	}
+
+	coin, err := types.SatoshisToCoin(k.evmKeeper.GetParams(ctx), amount)
+	if err != nil {
+		return 0, err
+	}
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender.Bytes(), types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	return k.QueueWithdrawal(ctx, sender, btcAddress, amount)
+}
+
+// ValidateWithdrawal checks the Bitcoin address and the amount, in satoshis, of a withdrawal.
+func (k Keeper) ValidateWithdrawal(ctx sdk.Context, btcAddress string, amount uint64) error {
+	if _, err := types.AddressScript(btcAddress, k.lightClientKeeper.GetParams(ctx).ChainParams()); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawal, "invalid bitcoin address: %s", err)
+	}
+
+	params := k.GetParams(ctx)
+	if amount < params.MinWithdrawalAmount {
+		return errorsmod.Wrapf(
+			types.ErrInvalidWithdrawal,
+			"amount %d is below the minimum %d", amount, params.MinWithdrawalAmount,
+		)
+	}
+
+	_, err := types.SatoshisToCoin(k.evmKeeper.GetParams(ctx), amount)
+	return err
+}
+
+// QueueWithdrawal queues the withdrawal of an amount, in satoshis, to a Bitcoin address and
+// returns its id. The amount must have been burned by the caller.
+func (k Keeper) QueueWithdrawal(ctx sdk.Context, sender common.Address, btcAddress string, amount uint64) (uint64, error) {
+	if err := k.ValidateWithdrawal(ctx, btcAddress, amount); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextWithdrawalID(ctx)
+	k.SetNextWithdrawalID(ctx, id+1)
+
+	// the payout must be included in a block above the current tip
+	var btcHeight uint64
+	if tip, found := k.lightClientKeeper.GetTip(ctx); found {
+		btcHeight = tip.Height
+	}
+
+	k.SetWithdrawal(ctx, types.Withdrawal{
+		Id:         id,
+		Sender:     sender.Hex(),
+		BtcAddress: btcAddress,
+		Amount:     amount,
+		Status:     types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+		Height:     ctx.BlockHeight(),
+		BtcHeight:  btcHeight,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequested,
+			sdk.NewAttribute(types.AttributeKeyWithdrawalID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyBTCAddress, btcAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+		),
+	)
+
+	return id, nil
+}
+
+// FulfillWithdrawals marks pending withdrawals as paid out by a Bitcoin transaction, verified
+// against the light client headers. The transaction must only spend outputs of the deposit
+// address, given the previous transactions of its inputs, and be included in a block above the
+// light client tip at the time of the withdrawal requests. Each withdrawal must be paid by a
+// distinct output of the transaction, whose value is at least the withdrawal amount minus the
+// withdrawal fee.
+func (k Keeper) FulfillWithdrawals(ctx sdk.Context, ids []uint64, proof types.BTCTxProof, prevTxs [][]byte) error {
+	params := k.GetParams(ctx)
+	chainParams := k.lightClientKeeper.GetParams(ctx).ChainParams()
+
+	if params.DepositAddress == "" {
+		return errorsmod.Wrap(types.ErrInvalidPayout, "no deposit address")
+	}
+	depositScript, err := types.AddressScript(params.DepositAddress, chainParams)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPayout, err.Error())
+	}
+
+	tx, blockHash, err := k.verifyTx(ctx, proof, params.MinConfirmations)
+	if err != nil {
+		return err
+	}
+	txid := tx.TxHash()
+
+	header, found := k.lightClientKeeper.GetHeader(ctx, blockHash)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidPayout, "block %s not found", blockHash)
+	}
+
+	if err := verifyPayoutInputs(tx, prevTxs, depositScript); err != nil {
+		return err
+	}
+
+	used := make(map[int]bool, len(ids))
+	seen := make(map[uint64]bool, len(ids))
+	withdrawals := make([]types.Withdrawal, len(ids))
+	for i, id := range ids {
+		if seen[id] {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "duplicated withdrawal %d", id)
+		}
+		seen[id] = true
+
+		withdrawal, found := k.GetWithdrawal(ctx, id)
+		if !found {
+			return errorsmod.Wrapf(types.ErrWithdrawalNotFound, "id %d", id)
+		}
+		if !withdrawal.IsPending() {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "withdrawal %d is not pending", id)
+		}
+		if header.Height <= withdrawal.BtcHeight {
+			return errorsmod.Wrapf(
+				types.ErrInvalidPayout,
+				"payout block %d is not above the tip %d when withdrawal %d was requested", header.Height, withdrawal.BtcHeight, id,
+			)
+		}
+
+		script, err := types.AddressScript(withdrawal.BtcAddress, chainParams)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "withdrawal %d: %s", id, err)
+		}
+
+		minValue := withdrawal.Amount - params.WithdrawalFee
+		if params.WithdrawalFee >= withdrawal.Amount {
+			minValue = 0
+		}
+
+		vout := -1
+		for j, out := range tx.TxOut {
+			if used[j] || out.Value <= 0 || uint64(out.Value) < minValue || !bytes.Equal(out.PkScript, script) {
+				continue
+			}
+			if k.HasPayoutOutpoint(ctx, txid, uint32(j)) {
+				continue
+			}
+			vout = j
+			break
+		}
+		if vout < 0 {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "no output of %s pays out withdrawal %d", txid, id)
+		}
+		used[vout] = true
+
+		withdrawal.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED
+		withdrawal.PayoutTxid = txid.String()
+		withdrawal.PayoutVout = uint32(vout)
+		withdrawals[i] = withdrawal
+	}
+
+	for _, withdrawal := range withdrawals {
+		k.SetWithdrawal(ctx, withdrawal)
+		k.SetPayoutOutpoint(ctx, txid, withdrawal.PayoutVout)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawalFulfilled,
+				sdk.NewAttribute(types.AttributeKeyWithdrawalID, strconv.FormatUint(withdrawal.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyTxID, withdrawal.PayoutTxid),
+			),
+		)
+	}
+
+	return nil
+}
+
+// verifyPayoutInputs checks that all the inputs of a payout transaction spend outputs of the
+// deposit address. The previous transactions are authenticated by the ids of the spent outpoints.
+func verifyPayoutInputs(tx *wire.MsgTx, rawPrevTxs [][]byte, depositScript []byte) error {
+	prevTxs := make(map[chainhash.Hash]*wire.MsgTx, len(rawPrevTxs))
+	for i, raw := range rawPrevTxs {
+		prevTx, err := btclightclienttypes.ParseTx(raw)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "previous transaction %d: %s", i, err)
+		}
+		prevTxs[prevTx.TxHash()] = prevTx
+	}
+
+	for i, in := range tx.TxIn {
+		prevTx, found := prevTxs[in.PreviousOutPoint.Hash]
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "input %d: missing previous transaction %s", i, in.PreviousOutPoint.Hash)
+		}
+		index := in.PreviousOutPoint.Index
+		if int(index) >= len(prevTx.TxOut) || !bytes.Equal(prevTx.TxOut[index].PkScript, depositScript) {
+			return errorsmod.Wrapf(types.ErrInvalidPayout, "input %d doesn't spend the deposit address", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/btcbridge/keeper"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+const (
+	// WithdrawMethod is the name of the withdrawal request method.
+	WithdrawMethod = "withdraw"
+	// WithdrawGas is the gas charged for a withdrawal request, which burns the value and stores the
+	// request.
+	WithdrawGas uint64 = 50_000
+)
+
+// Address is the address of the BTC bridge precompiled contract.
+var Address = common.HexToAddress("0x0000000000000000000000000000000000000901")
+
+// ABI is the Solidity interface of the BTC bridge precompiled contract:
+//
+//	interface IBTCBridge {
+//	    function withdraw(string calldata btcAddress) external payable returns (uint64 id);
+//	}
+//
+// The value sent is burned and withdrawn to the Bitcoin address, it must be a whole number of
+// satoshis, i.e. a multiple of 10^10 wei.
+var ABI abi.ABI
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader([]byte(abiJSON)))
+	if err != nil {
+		panic(err)
+	}
+}
+
+const abiJSON = `[
+  {
+    "type": "function",
+    "name": "withdraw",
+    "stateMutability": "payable",
+    "inputs": [
+      {"name": "btcAddress", "type": "string"}
+    ],
+    "outputs": [
+      {"name": "id", "type": "uint64"}
+    ]
+  }
+]`
+
+var _ evm.StatefulPrecompiledContract = (*Precompile)(nil)
+
+// Precompile is a stateful precompiled contract queuing withdrawals to Bitcoin, paid by the value
+// of the call.
+type Precompile struct {
+	keeper keeper.Keeper
+}
+
+// NewPrecompile creates a new BTC bridge precompiled contract.
+func NewPrecompile(k keeper.Keeper) *Precompile {
+	return &Precompile{
+		keeper: k,
+	}
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract.
+func (p *Precompile) RequiredGas(_ []byte) uint64 {
+	return WithdrawGas
+}
+
+// Address returns the address of the precompiled contract.
+func (p *Precompile) Address() common.Address {
+	return Address
+}
+
+// Run returns an error, the precompiled contract needs the state to be executed.
+func (p *Precompile) Run(_ *vm.EVM, _ *vm.Contract, _ bool) ([]byte, error) {
+	return nil, errors.New("btc bridge precompile must be run with RunStateful")
+}
+
+// RunStateful burns the value of the call and queues its withdrawal to a Bitcoin address, on
+// behalf of the transaction sender. It returns the withdrawal id.
+//
+// The withdrawal is stored by the bridge keeper, whose writes are not reverted with the EVM
+// state, so the precompile must be called by the transaction sender: a contract could revert
+// after the withdrawal is queued, refunding the burned value.
+func (p *Precompile) RunStateful(evm evm.EVM, caller, addr common.Address, input []byte, value *big.Int) ([]byte, error) {
+	if caller != evm.TxContext().Origin {
+		return nil, errors.New("btc bridge withdrawal must be called by the transaction sender")
+	}
+
+	stateDB, ok := evm.StateDB().(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("unsupported state database %T", evm.StateDB())
+	}
+
+	btcAddress, err := unpackWithdraw(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil || value.Sign() <= 0 {
+		return nil, errors.New("btc bridge withdrawal requires a value")
+	}
+	amount, remainder := new(big.Int).QuoRem(value, types.WeiPerSatoshi, new(big.Int))
+	if remainder.Sign() != 0 || !amount.IsUint64() {
+		return nil, fmt.Errorf("withdrawal value %s is not a whole number of satoshis", value)
+	}
+
+	ctx := stateDB.Context()
+	if err := p.keeper.ValidateWithdrawal(ctx, btcAddress, amount.Uint64()); err != nil {
+		return nil, err
+	}
+
+	// the value has been transferred to the precompile, burn it before the withdrawal is stored
+	// as the store writes are not reverted with the state.
+	stateDB.SubBalance(addr, value)
+
+	id, err := p.keeper.QueueWithdrawal(ctx, caller, btcAddress, amount.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	return ABI.Methods[WithdrawMethod].Outputs.Pack(id)
+}
+
+// unpackWithdraw decodes the call data of the withdraw method.
+func unpackWithdraw(input []byte) (string, error) {
+	method := ABI.Methods[WithdrawMethod]
+	if len(input) < 4 || !bytes.Equal(input[:4], method.ID) {
+		return "", errors.New("unknown btc bridge precompile method")
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return "", err
+	}
+	btcAddress, ok := values[0].(string)
+	if !ok {
+		return "", errors.New("invalid bitcoin address argument")
+	}
+	return btcAddress, nil
+}
@@ -0,0 +1,170 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge/precompile"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const withdrawalAddress = "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	app    *app.EthermintApp
+	sender common.Address
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false, func(app *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		gs := btclightclienttypes.NewNetworkGenesisState(btclightclienttypes.NewParams(chaincfg.RegressionNetParams.Name))
+		genesis[btclightclienttypes.ModuleName] = app.AppCodec().MustMarshalJSON(gs)
+		return genesis
+	})
+	header := tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	}
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	proposer, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	header.ProposerAddress = proposer
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+
+	suite.sender = tests.GenerateAddress()
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.sender, sats(100_000)))
+}
+
+// sats returns an amount of satoshis in wei.
+func sats(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), types.WeiPerSatoshi)
+}
+
+func (suite *PrecompileTestSuite) pack(btcAddress string) []byte {
+	input, err := precompile.ABI.Pack(precompile.WithdrawMethod, btcAddress)
+	suite.Require().NoError(err)
+	return input
+}
+
+// withdraw calls the precompile from the sender and commits the state.
+func (suite *PrecompileTestSuite) withdraw(input []byte, value *big.Int) *evmtypes.MsgEthereumTxResponse {
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.sender)
+	msg := ethtypes.NewMessage(suite.sender, &precompile.Address, nonce, value, 100_000, big.NewInt(0), nil, nil, input, nil, true)
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
+
+func (suite *PrecompileTestSuite) TestWithdraw() {
+	k := suite.app.BTCBridgeKeeper
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+	res := suite.withdraw(suite.pack(withdrawalAddress), sats(30_000))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().GreaterOrEqual(res.GasUsed, precompile.WithdrawGas)
+
+	values, err := precompile.ABI.Unpack(precompile.WithdrawMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), values[0])
+
+	// the value is burned
+	suite.Require().Equal(sats(70_000), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.sender))
+	suite.Require().Equal(big.NewInt(0), suite.app.EvmKeeper.GetBalance(suite.ctx, precompile.Address))
+	suite.Require().Equal(supply.Amount.Sub(sdk.NewIntFromBigInt(sats(30_000))), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+	withdrawal, found := k.GetWithdrawal(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Withdrawal{
+		Id:         1,
+		Sender:     suite.sender.Hex(),
+		BtcAddress: withdrawalAddress,
+		Amount:     30_000,
+		Status:     types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+		Height:     1,
+	}, withdrawal)
+}
+
+func (suite *PrecompileTestSuite) TestWithdrawInvalid() {
+	testCases := []struct {
+		name  string
+		input []byte
+		value *big.Int
+	}{
+		{"no value", suite.pack(withdrawalAddress), big.NewInt(0)},
+		{"fraction of satoshi", suite.pack(withdrawalAddress), new(big.Int).Add(sats(30_000), big.NewInt(1))},
+		{"below minimum", suite.pack(withdrawalAddress), sats(int64(types.DefaultMinWithdrawalAmount) - 1)},
+		{"mainnet address", suite.pack("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh"), sats(30_000)},
+		{"invalid input", []byte{1, 2, 3, 4, 5}, sats(30_000)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res := suite.withdraw(tc.input, tc.value)
+			suite.Require().True(res.Failed())
+
+			// the value is refunded and no withdrawal is queued
+			suite.Require().Equal(sats(100_000), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.sender))
+			suite.Require().Equal(uint64(1), suite.app.BTCBridgeKeeper.GetNextWithdrawalID(suite.ctx))
+		})
+	}
+}
+
+// forwarderCode is the init code of a contract forwarding its call data and value to the
+// precompile, and returning or reverting with the precompile output:
+//
+//	CALLDATASIZE PUSH1 0 PUSH1 0 CALLDATACOPY
+//	PUSH1 0 PUSH1 0 CALLDATASIZE PUSH1 0 CALLVALUE PUSH2 0x0901 GAS CALL
+//	RETURNDATASIZE PUSH1 0 PUSH1 0 RETURNDATACOPY
+//	PUSH1 0x20 JUMPI RETURNDATASIZE PUSH1 0 REVERT
+//	JUMPDEST RETURNDATASIZE PUSH1 0 RETURN
+var forwarderCode = common.FromHex(
+	"6025600c60003960256000f3" +
+		"36600060003760006000366000346109015af13d600060003e6020573d6000fd5b3d6000f3",
+)
+
+func (suite *PrecompileTestSuite) TestWithdrawFromContract() {
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.sender)
+	msg := ethtypes.NewMessage(suite.sender, nil, nonce, big.NewInt(0), 100_000, big.NewInt(0), nil, nil, forwarderCode, nil, true)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	forwarder := crypto.CreateAddress(suite.sender, nonce)
+
+	// the withdrawal is on behalf of the transaction sender, the calls from contracts revert
+	nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.sender)
+	msg = ethtypes.NewMessage(suite.sender, &forwarder, nonce, sats(30_000), 200_000, big.NewInt(0), nil, nil, suite.pack(withdrawalAddress), nil, true)
+	res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+
+	suite.Require().Equal(sats(100_000), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.sender))
+	suite.Require().Equal(big.NewInt(0), suite.app.EvmKeeper.GetBalance(suite.ctx, forwarder))
+	suite.Require().Equal(uint64(1), suite.app.BTCBridgeKeeper.GetNextWithdrawalID(suite.ctx))
+}
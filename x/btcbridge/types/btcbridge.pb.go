@@ -0,0 +1,1711 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/btcbridge/v1/btcbridge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WithdrawalStatus defines the status of a withdrawal request
+type WithdrawalStatus int32
+
+const (
+	// WITHDRAWAL_STATUS_UNSPECIFIED defines an invalid status
+	WithdrawalStatus_WITHDRAWAL_STATUS_UNSPECIFIED WithdrawalStatus = 0
+	// WITHDRAWAL_STATUS_PENDING defines a queued withdrawal waiting for a payout
+	WithdrawalStatus_WITHDRAWAL_STATUS_PENDING WithdrawalStatus = 1
+	// WITHDRAWAL_STATUS_FULFILLED defines a withdrawal paid out on Bitcoin
+	WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED WithdrawalStatus = 2
+)
+
+var WithdrawalStatus_name = map[int32]string{
+	0: "WITHDRAWAL_STATUS_UNSPECIFIED",
+	1: "WITHDRAWAL_STATUS_PENDING",
+	2: "WITHDRAWAL_STATUS_FULFILLED",
+}
+
+var WithdrawalStatus_value = map[string]int32{
+	"WITHDRAWAL_STATUS_UNSPECIFIED": 0,
+	"WITHDRAWAL_STATUS_PENDING":     1,
+	"WITHDRAWAL_STATUS_FULFILLED":   2,
+}
+
+func (x WithdrawalStatus) String() string {
+	return proto.EnumName(WithdrawalStatus_name, int32(x))
+}
+
+func (WithdrawalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0656128fb41f1694, []int{0}
+}
+
+// Params defines the BTC bridge module parameters
+type Params struct {
+	// deposit_address is the Bitcoin address of the federation, e.g. a taproot
+	// address, that receives the deposits. Deposits are disabled if empty.
+	DepositAddress string `protobuf:"bytes,1,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty"`
+	// min_confirmations is the number of confirmations of the Bitcoin block
+	// including a deposit or a withdrawal payout required to process it.
+	MinConfirmations uint64 `protobuf:"varint,2,opt,name=min_confirmations,json=minConfirmations,proto3" json:"min_confirmations,omitempty"`
+	// min_withdrawal_amount is the minimum amount, in satoshis, of a withdrawal.
+	MinWithdrawalAmount uint64 `protobuf:"varint,3,opt,name=min_withdrawal_amount,json=minWithdrawalAmount,proto3" json:"min_withdrawal_amount,omitempty"`
+	// withdrawal_fee is the maximum amount, in satoshis, that the signers can
+	// deduct from a withdrawal payout to pay the Bitcoin network fees.
+	WithdrawalFee uint64 `protobuf:"varint,4,opt,name=withdrawal_fee,json=withdrawalFee,proto3" json:"withdrawal_fee,omitempty"`
+	// relayers are the addresses of the federation members allowed to relay the
+	// deposits and the withdrawal payouts. Neither can be relayed if empty.
+	Relayers []string `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0656128fb41f1694, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDepositAddress() string {
+	if m != nil {
+		return m.DepositAddress
+	}
+	return ""
+}
+
+func (m *Params) GetMinConfirmations() uint64 {
+	if m != nil {
+		return m.MinConfirmations
+	}
+	return 0
+}
+
+func (m *Params) GetMinWithdrawalAmount() uint64 {
+	if m != nil {
+		return m.MinWithdrawalAmount
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalFee() uint64 {
+	if m != nil {
+		return m.WithdrawalFee
+	}
+	return 0
+}
+
+func (m *Params) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+// BTCTxProof is the inclusion proof of a Bitcoin transaction in a block stored
+// by the Bitcoin light client.
+type BTCTxProof struct {
+	// raw_tx is the serialized Bitcoin transaction.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// merkle_proof is the merkle branch of the transaction, from the leaf level,
+	// hex encoded in the Bitcoin (reversed) byte order.
+	MerkleProof []string `protobuf:"bytes,2,rep,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
+	// index is the position of the transaction in the block.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// block_hash is the hash of the block including the transaction, hex encoded
+	// in the Bitcoin (reversed) byte order.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *BTCTxProof) Reset()         { *m = BTCTxProof{} }
+func (m *BTCTxProof) String() string { return proto.CompactTextString(m) }
+func (*BTCTxProof) ProtoMessage()    {}
+func (*BTCTxProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0656128fb41f1694, []int{1}
+}
+func (m *BTCTxProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTxProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTxProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTxProof.Merge(m, src)
+}
+func (m *BTCTxProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTxProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTxProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTxProof proto.InternalMessageInfo
+
+func (m *BTCTxProof) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *BTCTxProof) GetMerkleProof() []string {
+	if m != nil {
+		return m.MerkleProof
+	}
+	return nil
+}
+
+func (m *BTCTxProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BTCTxProof) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// Deposit is a processed Bitcoin deposit
+type Deposit struct {
+	// txid is the Bitcoin transaction id, hex encoded in the Bitcoin byte order.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// recipient is the hex address of the EVM account credited.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the deposited amount in satoshis.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// block_hash is the hash of the Bitcoin block including the deposit.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// height is the height of the block the deposit was processed at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0656128fb41f1694, []int{2}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *Deposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Deposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Deposit) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Deposit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Withdrawal is a withdrawal request, whose amount has been burned
+type Withdrawal struct {
+	// id is the sequence number of the withdrawal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the hex address of the EVM account debited.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// btc_address is the Bitcoin address to pay out.
+	BtcAddress string `protobuf:"bytes,3,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// amount is the withdrawn amount in satoshis.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// status is the withdrawal status.
+	Status WithdrawalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ethermint.btcbridge.v1.WithdrawalStatus" json:"status,omitempty"`
+	// height is the height of the block the withdrawal was requested at.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// payout_txid is the id of the Bitcoin transaction paying out a fulfilled
+	// withdrawal, hex encoded in the Bitcoin byte order.
+	PayoutTxid string `protobuf:"bytes,7,opt,name=payout_txid,json=payoutTxid,proto3" json:"payout_txid,omitempty"`
+	// payout_vout is the index of the payout output in the transaction.
+	PayoutVout uint32 `protobuf:"varint,8,opt,name=payout_vout,json=payoutVout,proto3" json:"payout_vout,omitempty"`
+	// btc_height is the height of the Bitcoin light client tip when the
+	// withdrawal was requested, its payout must be included in a later block.
+	BtcHeight uint64 `protobuf:"varint,9,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+}
+
+func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
+func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
+func (*Withdrawal) ProtoMessage()    {}
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0656128fb41f1694, []int{3}
+}
+func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Withdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Withdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Withdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawal.Merge(m, src)
+}
+func (m *Withdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Withdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
+
+func (m *Withdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetStatus() WithdrawalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return WithdrawalStatus_WITHDRAWAL_STATUS_UNSPECIFIED
+}
+
+func (m *Withdrawal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetPayoutTxid() string {
+	if m != nil {
+		return m.PayoutTxid
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetPayoutVout() uint32 {
+	if m != nil {
+		return m.PayoutVout
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethermint.btcbridge.v1.WithdrawalStatus", WithdrawalStatus_name, WithdrawalStatus_value)
+	proto.RegisterType((*Params)(nil), "ethermint.btcbridge.v1.Params")
+	proto.RegisterType((*BTCTxProof)(nil), "ethermint.btcbridge.v1.BTCTxProof")
+	proto.RegisterType((*Deposit)(nil), "ethermint.btcbridge.v1.Deposit")
+	proto.RegisterType((*Withdrawal)(nil), "ethermint.btcbridge.v1.Withdrawal")
+}
+
+func init() {
+	proto.RegisterFile("ethermint/btcbridge/v1/btcbridge.proto", fileDescriptor_0656128fb41f1694)
+}
+
+var fileDescriptor_0656128fb41f1694 = []byte{
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0x63, 0xe7, 0xa3, 0xf5, 0x6d, 0x9b, 0x7f, 0xfe, 0x03, 0xad, 0x4c, 0xa1, 0x69, 0x1a,
+	0x09, 0x88, 0x00, 0x25, 0x6a, 0x79, 0x01, 0xd2, 0x26, 0xa1, 0x91, 0xa2, 0x2a, 0x72, 0x5c, 0x2a,
+	0xb1, 0xb1, 0xc6, 0xf6, 0xb4, 0x1e, 0x35, 0xf6, 0x44, 0x33, 0x93, 0x2f, 0xf1, 0x06, 0xac, 0x78,
+	0x0c, 0x1e, 0x85, 0x65, 0x37, 0x48, 0x2c, 0x51, 0xfb, 0x22, 0x28, 0x63, 0x13, 0xa7, 0x14, 0x89,
+	0x5d, 0xee, 0xb9, 0xe7, 0x4e, 0x7e, 0x73, 0x3c, 0x17, 0x5e, 0x10, 0x19, 0x10, 0x1e, 0xd2, 0x48,
+	0x36, 0x5c, 0xe9, 0xb9, 0x9c, 0xfa, 0x57, 0xa4, 0x31, 0x39, 0x4c, 0x8b, 0xfa, 0x88, 0x33, 0xc9,
+	0xd0, 0xce, 0xd2, 0x57, 0x4f, 0x5b, 0x93, 0xc3, 0xea, 0x77, 0x0d, 0x0a, 0x7d, 0xcc, 0x71, 0x28,
+	0xd0, 0x4b, 0xf8, 0xcf, 0x27, 0x23, 0x26, 0xa8, 0x74, 0xb0, 0xef, 0x73, 0x22, 0x84, 0xa9, 0x55,
+	0xb4, 0x9a, 0x61, 0x15, 0x13, 0xb9, 0x19, 0xab, 0xe8, 0x35, 0xfc, 0x1f, 0xd2, 0xc8, 0xf1, 0x58,
+	0x74, 0x49, 0x79, 0x88, 0x25, 0x65, 0x91, 0x30, 0xf5, 0x8a, 0x56, 0xcb, 0x59, 0xa5, 0x90, 0x46,
+	0x27, 0xab, 0x3a, 0x3a, 0x82, 0xed, 0x85, 0x79, 0x4a, 0x65, 0xe0, 0x73, 0x3c, 0xc5, 0x43, 0x07,
+	0x87, 0x6c, 0x1c, 0x49, 0x33, 0xab, 0x06, 0x1e, 0x85, 0x34, 0xba, 0x58, 0xf6, 0x9a, 0xaa, 0x85,
+	0x9e, 0x43, 0x71, 0xc5, 0x7f, 0x49, 0x88, 0x99, 0x53, 0xe6, 0xad, 0x54, 0xed, 0x10, 0x82, 0x76,
+	0x61, 0x9d, 0x93, 0x21, 0x9e, 0x13, 0x2e, 0xcc, 0x7c, 0x25, 0x5b, 0x33, 0xac, 0x65, 0x5d, 0xfd,
+	0x04, 0x70, 0x6c, 0x9f, 0xd8, 0xb3, 0x3e, 0x67, 0xec, 0x12, 0x6d, 0x43, 0x81, 0xe3, 0xa9, 0x23,
+	0x67, 0xea, 0x46, 0x9b, 0x56, 0x9e, 0xe3, 0xa9, 0x3d, 0x43, 0x07, 0xb0, 0x19, 0x12, 0x7e, 0x3d,
+	0x24, 0xce, 0x68, 0x61, 0x33, 0x75, 0x75, 0xc8, 0x46, 0xac, 0xc5, 0x93, 0x8f, 0x21, 0x4f, 0x23,
+	0x9f, 0xcc, 0x14, 0xee, 0x96, 0x15, 0x17, 0x68, 0x0f, 0xc0, 0x1d, 0x32, 0xef, 0xda, 0x09, 0xb0,
+	0x08, 0x14, 0x9c, 0x61, 0x19, 0x4a, 0x39, 0xc5, 0x22, 0xa8, 0x7e, 0xd6, 0x60, 0xad, 0x15, 0x67,
+	0x86, 0x10, 0xe4, 0xe4, 0x8c, 0xfa, 0x49, 0x94, 0xea, 0x37, 0x7a, 0x06, 0x06, 0x27, 0x1e, 0x1d,
+	0x51, 0x12, 0x49, 0x15, 0x9c, 0x61, 0xa5, 0x02, 0xda, 0x81, 0xc2, 0xbd, 0x88, 0x92, 0xea, 0x1f,
+	0x7f, 0xba, 0x18, 0x0b, 0x08, 0xbd, 0x0a, 0xa4, 0x99, 0xaf, 0x68, 0xb5, 0xac, 0x95, 0x54, 0xd5,
+	0xaf, 0x3a, 0x40, 0x9a, 0x30, 0x2a, 0x82, 0x9e, 0xd0, 0xe4, 0x2c, 0x9d, 0xfa, 0x8b, 0x31, 0x41,
+	0x22, 0x9f, 0xf0, 0x04, 0x24, 0xa9, 0xd0, 0x3e, 0x6c, 0xb8, 0xd2, 0x5b, 0xbe, 0x84, 0xac, 0x6a,
+	0x82, 0x2b, 0xbd, 0xdf, 0xaf, 0x20, 0xc5, 0xcc, 0xdd, 0xc3, 0x7c, 0x07, 0x05, 0x21, 0xb1, 0x1c,
+	0x0b, 0xc5, 0x51, 0x3c, 0xaa, 0xd5, 0xff, 0xfe, 0xf4, 0xea, 0x29, 0xd4, 0x40, 0xf9, 0xad, 0x64,
+	0x6e, 0xe5, 0x26, 0x85, 0xd5, 0x9b, 0x2c, 0x90, 0x46, 0x78, 0xce, 0xc6, 0xd2, 0x51, 0x89, 0xae,
+	0xc5, 0x48, 0xb1, 0x64, 0x2f, 0x72, 0x4d, 0x0d, 0x13, 0x36, 0x96, 0xe6, 0xba, 0xfa, 0x64, 0x89,
+	0xe1, 0x03, 0x1b, 0xc7, 0x11, 0x4a, 0xcf, 0x49, 0x4e, 0x37, 0x14, 0xb7, 0xe1, 0x4a, 0xef, 0x54,
+	0x09, 0xaf, 0xe6, 0x50, 0xfa, 0x13, 0x0a, 0x1d, 0xc0, 0xde, 0x45, 0xd7, 0x3e, 0x6d, 0x59, 0xcd,
+	0x8b, 0x66, 0xcf, 0x19, 0xd8, 0x4d, 0xfb, 0x7c, 0xe0, 0x9c, 0x9f, 0x0d, 0xfa, 0xed, 0x93, 0x6e,
+	0xa7, 0xdb, 0x6e, 0x95, 0x32, 0x68, 0x0f, 0x9e, 0x3c, 0xb4, 0xf4, 0xdb, 0x67, 0xad, 0xee, 0xd9,
+	0xfb, 0x92, 0x86, 0xf6, 0xe1, 0xe9, 0xc3, 0x76, 0xe7, 0xbc, 0xd7, 0xe9, 0xf6, 0x7a, 0xed, 0x56,
+	0x49, 0xdf, 0xd5, 0x4b, 0x99, 0xe3, 0xce, 0xb7, 0xdb, 0xb2, 0x76, 0x73, 0x5b, 0xd6, 0x7e, 0xde,
+	0x96, 0xb5, 0x2f, 0x77, 0xe5, 0xcc, 0xcd, 0x5d, 0x39, 0xf3, 0xe3, 0xae, 0x9c, 0xf9, 0xf8, 0xe6,
+	0x8a, 0xca, 0x60, 0xec, 0xd6, 0x3d, 0x16, 0x36, 0xc8, 0x24, 0x64, 0xa2, 0x91, 0xae, 0xfc, 0x6c,
+	0x65, 0xe9, 0xe5, 0x7c, 0x44, 0x84, 0x5b, 0x50, 0xeb, 0xfe, 0xf6, 0xd7, 0x00, 0x37, 0x9e, 0xbc,
+	0x80, 0x18, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.WithdrawalFee != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.WithdrawalFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinWithdrawalAmount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MinWithdrawalAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinConfirmations != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.MinConfirmations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositAddress) > 0 {
+		i -= len(m.DepositAddress)
+		copy(dAtA[i:], m.DepositAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.DepositAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTxProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTxProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTxProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleProof) > 0 {
+		for iNdEx := len(m.MerkleProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MerkleProof[iNdEx])
+			copy(dAtA[i:], m.MerkleProof[iNdEx])
+			i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.MerkleProof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RawTx) > 0 {
+		i -= len(m.RawTx)
+		copy(dAtA[i:], m.RawTx)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.RawTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Withdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Withdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PayoutVout != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.PayoutVout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PayoutTxid) > 0 {
+		i -= len(m.PayoutTxid)
+		copy(dAtA[i:], m.PayoutTxid)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.PayoutTxid)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBtcbridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBtcbridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcbridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcbridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.MinConfirmations != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MinConfirmations))
+	}
+	if m.MinWithdrawalAmount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.MinWithdrawalAmount))
+	}
+	if m.WithdrawalFee != 0 {
+		n += 1 + sovBtcbridge(uint64(m.WithdrawalFee))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCTxProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RawTx)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if len(m.MerkleProof) > 0 {
+		for _, s := range m.MerkleProof {
+			l = len(s)
+			n += 1 + l + sovBtcbridge(uint64(l))
+		}
+	}
+	if m.Index != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Index))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Amount))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Withdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Amount))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcbridge(uint64(m.Height))
+	}
+	l = len(m.PayoutTxid)
+	if l > 0 {
+		n += 1 + l + sovBtcbridge(uint64(l))
+	}
+	if m.PayoutVout != 0 {
+		n += 1 + sovBtcbridge(uint64(m.PayoutVout))
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovBtcbridge(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func sovBtcbridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcbridge(x uint64) (n int) {
+	return sovBtcbridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConfirmations", wireType)
+			}
+			m.MinConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawalAmount", wireType)
+			}
+			m.MinWithdrawalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinWithdrawalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalFee", wireType)
+			}
+			m.WithdrawalFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTxProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTxProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTxProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawTx = append(m.RawTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawTx == nil {
+				m.RawTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleProof = append(m.MerkleProof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Withdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutTxid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutTxid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutVout", wireType)
+			}
+			m.PayoutVout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutVout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBtcbridge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBtcbridge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcbridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBtcbridge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBtcbridge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBtcbridge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBtcbridge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBtcbridge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBtcbridge = fmt.Errorf("proto: unexpected end of group")
+)
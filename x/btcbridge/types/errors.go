@@ -0,0 +1,61 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidDeposit = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrDepositsDisabled
+	codeErrDuplicateDeposit
+	codeErrInvalidWithdrawal
+	codeErrWithdrawalNotFound
+	codeErrInvalidPayout
+	codeErrInsufficientConfirmations
+	codeErrUnsupportedDenom
+	codeErrUnauthorizedRelayer
+)
+
+var (
+	// ErrInvalidDeposit returns an error if a Bitcoin transaction is not a valid deposit.
+	ErrInvalidDeposit = errorsmod.Register(ModuleName, codeErrInvalidDeposit, "invalid bitcoin deposit")
+
+	// ErrDepositsDisabled returns an error if no deposit address is set.
+	ErrDepositsDisabled = errorsmod.Register(ModuleName, codeErrDepositsDisabled, "bitcoin deposits are disabled")
+
+	// ErrDuplicateDeposit returns an error if a deposit has already been processed.
+	ErrDuplicateDeposit = errorsmod.Register(ModuleName, codeErrDuplicateDeposit, "bitcoin deposit already processed")
+
+	// ErrInvalidWithdrawal returns an error if a withdrawal request is invalid.
+	ErrInvalidWithdrawal = errorsmod.Register(ModuleName, codeErrInvalidWithdrawal, "invalid bitcoin withdrawal")
+
+	// ErrWithdrawalNotFound returns an error if a withdrawal request is not stored.
+	ErrWithdrawalNotFound = errorsmod.Register(ModuleName, codeErrWithdrawalNotFound, "bitcoin withdrawal not found")
+
+	// ErrInvalidPayout returns an error if a Bitcoin transaction doesn't pay out the withdrawals.
+	ErrInvalidPayout = errorsmod.Register(ModuleName, codeErrInvalidPayout, "invalid bitcoin withdrawal payout")
+
+	// ErrInsufficientConfirmations returns an error if a Bitcoin block doesn't have enough confirmations.
+	ErrInsufficientConfirmations = errorsmod.Register(ModuleName, codeErrInsufficientConfirmations, "insufficient bitcoin block confirmations")
+
+	// ErrUnsupportedDenom returns an error if the evm denom can't represent satoshis.
+	ErrUnsupportedDenom = errorsmod.Register(ModuleName, codeErrUnsupportedDenom, "evm denom doesn't support satoshi amounts")
+
+	// ErrUnauthorizedRelayer returns an error if the signer of a deposit or a withdrawal payout is not a relayer.
+	ErrUnauthorizedRelayer = errorsmod.Register(ModuleName, codeErrUnauthorizedRelayer, "unauthorized relayer")
+)
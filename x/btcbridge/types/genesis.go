@@ -0,0 +1,137 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// DefaultGenesisState sets default BTC bridge genesis state, with the deposits disabled.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, 1)
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, deposits []Deposit, withdrawals []Withdrawal, nextWithdrawalID uint64) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		Deposits:         deposits,
+		Withdrawals:      withdrawals,
+		NextWithdrawalId: nextWithdrawalID,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDeposits := make(map[string]bool, len(gs.Deposits))
+	for _, deposit := range gs.Deposits {
+		if err := deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit %s: %w", deposit.Txid, err)
+		}
+		if seenDeposits[deposit.Txid] {
+			return fmt.Errorf("duplicated deposit %s", deposit.Txid)
+		}
+		seenDeposits[deposit.Txid] = true
+	}
+
+	if gs.NextWithdrawalId == 0 {
+		return fmt.Errorf("next withdrawal id cannot be zero")
+	}
+
+	seenWithdrawals := make(map[uint64]bool, len(gs.Withdrawals))
+	seenPayouts := make(map[string]bool, len(gs.Withdrawals))
+	for _, withdrawal := range gs.Withdrawals {
+		if err := withdrawal.Validate(); err != nil {
+			return fmt.Errorf("invalid withdrawal %d: %w", withdrawal.Id, err)
+		}
+		if seenWithdrawals[withdrawal.Id] {
+			return fmt.Errorf("duplicated withdrawal %d", withdrawal.Id)
+		}
+		if withdrawal.Id >= gs.NextWithdrawalId {
+			return fmt.Errorf("withdrawal %d is not below the next withdrawal id %d", withdrawal.Id, gs.NextWithdrawalId)
+		}
+		seenWithdrawals[withdrawal.Id] = true
+
+		if withdrawal.Status == WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED {
+			payout := fmt.Sprintf("%s:%d", withdrawal.PayoutTxid, withdrawal.PayoutVout)
+			if seenPayouts[payout] {
+				return fmt.Errorf("duplicated withdrawal payout %s", payout)
+			}
+			seenPayouts[payout] = true
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the deposit fields.
+func (d Deposit) Validate() error {
+	if _, err := ParseHash(d.Txid); err != nil {
+		return fmt.Errorf("invalid txid: %w", err)
+	}
+	if _, err := ParseHash(d.BlockHash); err != nil {
+		return fmt.Errorf("invalid block hash: %w", err)
+	}
+	if err := ethermint.ValidateAddress(d.Recipient); err != nil {
+		return err
+	}
+	if d.Amount == 0 {
+		return fmt.Errorf("amount cannot be zero")
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the withdrawal fields.
+func (w Withdrawal) Validate() error {
+	if w.Id == 0 {
+		return fmt.Errorf("id cannot be zero")
+	}
+	if err := ethermint.ValidateAddress(w.Sender); err != nil {
+		return err
+	}
+	if w.BtcAddress == "" {
+		return fmt.Errorf("empty bitcoin address")
+	}
+	if w.Amount == 0 {
+		return fmt.Errorf("amount cannot be zero")
+	}
+
+	switch w.Status {
+	case WithdrawalStatus_WITHDRAWAL_STATUS_PENDING:
+		if w.PayoutTxid != "" {
+			return fmt.Errorf("pending withdrawal has a payout")
+		}
+	case WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED:
+		if _, err := ParseHash(w.PayoutTxid); err != nil {
+			return fmt.Errorf("invalid payout txid: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid status %s", w.Status)
+	}
+	return nil
+}
+
+// IsPending returns true if the withdrawal is waiting for a payout.
+func (w Withdrawal) IsPending() bool {
+	return w.Status == WithdrawalStatus_WITHDRAWAL_STATUS_PENDING
+}
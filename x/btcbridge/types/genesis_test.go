@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	txid := chainhash.Hash{1}
+	deposit := types.Deposit{
+		Txid:      txid.String(),
+		Recipient: tests.GenerateAddress().Hex(),
+		Amount:    100_000,
+		BlockHash: chainhash.Hash{2}.String(),
+		Height:    1,
+	}
+	pending := types.Withdrawal{
+		Id:         1,
+		Sender:     tests.GenerateAddress().Hex(),
+		BtcAddress: "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+		Amount:     20_000,
+		Status:     types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+		Height:     1,
+	}
+	fulfilled := pending
+	fulfilled.Id = 2
+	fulfilled.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED
+	fulfilled.PayoutTxid = txid.String()
+
+	withStatus := func(w types.Withdrawal, status types.WithdrawalStatus) types.Withdrawal {
+		w.Status = status
+		return w
+	}
+	params := types.DefaultParams()
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"deposits and withdrawals", types.NewGenesisState(params, []types.Deposit{deposit}, []types.Withdrawal{pending, fulfilled}, 3), true},
+		{"invalid params", types.NewGenesisState(types.NewParams("", 0, 10_000, 1_000, nil), nil, nil, 1), false},
+		{"fee above min withdrawal", types.NewGenesisState(types.NewParams("", 6, 1_000, 1_000, nil), nil, nil, 1), false},
+		{"duplicate deposit", types.NewGenesisState(params, []types.Deposit{deposit, deposit}, nil, 1), false},
+		{"invalid deposit recipient", types.NewGenesisState(params, []types.Deposit{{Txid: deposit.Txid, Recipient: "0x", Amount: 1, BlockHash: deposit.BlockHash}}, nil, 1), false},
+		{"zero next withdrawal id", types.NewGenesisState(params, nil, nil, 0), false},
+		{"withdrawal id above next id", types.NewGenesisState(params, nil, []types.Withdrawal{pending, fulfilled}, 2), false},
+		{"duplicate withdrawal", types.NewGenesisState(params, nil, []types.Withdrawal{pending, pending}, 3), false},
+		{"unspecified status", types.NewGenesisState(params, nil, []types.Withdrawal{withStatus(pending, types.WithdrawalStatus_WITHDRAWAL_STATUS_UNSPECIFIED)}, 3), false},
+		{"fulfilled without payout", types.NewGenesisState(params, nil, []types.Withdrawal{withStatus(pending, types.WithdrawalStatus_WITHDRAWAL_STATUS_FULFILLED)}, 3), false},
+		{"pending with payout", types.NewGenesisState(params, nil, []types.Withdrawal{withStatus(fulfilled, types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING)}, 3), false},
+		{
+			"duplicate payout",
+			types.NewGenesisState(params, nil, []types.Withdrawal{fulfilled, func() types.Withdrawal { w := fulfilled; w.Id = 1; return w }()}, 3),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// BankKeeper defines the expected interface needed to mint and burn the deposits and withdrawals.
+type BankKeeper interface {
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected interface needed to retrieve the evm denom.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// BTCLightClientKeeper defines the expected interface needed to verify Bitcoin transactions.
+type BTCLightClientKeeper interface {
+	GetParams(ctx sdk.Context) btclightclienttypes.Params
+	GetTip(ctx sdk.Context) (btclightclienttypes.BTCHeaderInfo, bool)
+	GetHeader(ctx sdk.Context, hash *chainhash.Hash) (btclightclienttypes.BTCHeaderInfo, bool)
+	VerifyTxInclusion(
+		ctx sdk.Context,
+		rawTx []byte,
+		branch []chainhash.Hash,
+		index uint32,
+		blockHash *chainhash.Hash,
+	) (*wire.MsgTx, uint64, error)
+}
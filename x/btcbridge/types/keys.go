@@ -0,0 +1,63 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "btcbridge"
+
+	// StoreKey key for the BTC bridge deposits and withdrawals.
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the btcbridge persistent store
+const (
+	prefixParams = iota + 1
+	prefixDeposit
+	prefixWithdrawal
+	prefixPendingWithdrawal
+	prefixPayoutOutpoint
+	prefixNextWithdrawalID
+)
+
+// KVStore key prefixes
+var (
+	KeyParams                  = []byte{prefixParams}
+	KeyPrefixDeposit           = []byte{prefixDeposit}
+	KeyPrefixWithdrawal        = []byte{prefixWithdrawal}
+	KeyPrefixPendingWithdrawal = []byte{prefixPendingWithdrawal}
+	KeyPrefixPayoutOutpoint    = []byte{prefixPayoutOutpoint}
+	KeyNextWithdrawalID        = []byte{prefixNextWithdrawalID}
+)
+
+// WithdrawalKey returns the key of a withdrawal, under the withdrawal or pending withdrawal prefix.
+func WithdrawalKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// OutpointKey returns the key of a Bitcoin transaction output, under the payout outpoint prefix.
+func OutpointKey(txid chainhash.Hash, vout uint32) []byte {
+	return append(txid[:], sdk.Uint64ToBigEndian(uint64(vout))...)
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgDeposit{}
+	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgFulfillWithdrawals{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	// TypeMsgDeposit defines the type string of a deposit relay message
+	TypeMsgDeposit = "deposit"
+	// TypeMsgWithdraw defines the type string of a withdrawal request message
+	TypeMsgWithdraw = "withdraw"
+	// TypeMsgFulfillWithdrawals defines the type string of a withdrawal payout message
+	TypeMsgFulfillWithdrawals = "fulfill_withdrawals"
+
+	// MaxWithdrawalsPerPayout is the maximum number of withdrawals fulfilled by a single message.
+	MaxWithdrawalsPerPayout = 256
+)
+
+// NewMsgDeposit returns a new MsgDeposit relaying the given deposit proof.
+func NewMsgDeposit(signer sdk.AccAddress, proof BTCTxProof) *MsgDeposit {
+	return &MsgDeposit{
+		Signer: signer.String(),
+		Proof:  proof,
+	}
+}
+
+// Route returns the route value of a MsgDeposit.
+func (m MsgDeposit) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgDeposit.
+func (m MsgDeposit) Type() string { return TypeMsgDeposit }
+
+// GetSigners returns the expected signers for a MsgDeposit message.
+func (m MsgDeposit) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if err := m.Proof.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDeposit, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgWithdraw returns a new MsgWithdraw of an amount in satoshis to a Bitcoin address.
+func NewMsgWithdraw(sender sdk.AccAddress, btcAddress string, amount uint64) *MsgWithdraw {
+	return &MsgWithdraw{
+		Sender:     sender.String(),
+		BtcAddress: btcAddress,
+		Amount:     amount,
+	}
+}
+
+// Route returns the route value of a MsgWithdraw.
+func (m MsgWithdraw) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgWithdraw.
+func (m MsgWithdraw) Type() string { return TypeMsgWithdraw }
+
+// GetSigners returns the expected signers for a MsgWithdraw message.
+func (m MsgWithdraw) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data. The Bitcoin address is validated
+// against the light client network by the keeper.
+func (m *MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.BtcAddress == "" {
+		return errorsmod.Wrap(ErrInvalidWithdrawal, "empty bitcoin address")
+	}
+
+	if m.Amount == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, "amount cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgFulfillWithdrawals returns a new MsgFulfillWithdrawals marking the given withdrawals as
+// paid out by the proven transaction, spending outputs of the given previous transactions.
+func NewMsgFulfillWithdrawals(signer sdk.AccAddress, ids []uint64, proof BTCTxProof, prevTxs [][]byte) *MsgFulfillWithdrawals {
+	return &MsgFulfillWithdrawals{
+		Signer:        signer.String(),
+		WithdrawalIds: ids,
+		Proof:         proof,
+		PrevTxs:       prevTxs,
+	}
+}
+
+// Route returns the route value of a MsgFulfillWithdrawals.
+func (m MsgFulfillWithdrawals) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgFulfillWithdrawals.
+func (m MsgFulfillWithdrawals) Type() string { return TypeMsgFulfillWithdrawals }
+
+// GetSigners returns the expected signers for a MsgFulfillWithdrawals message.
+func (m MsgFulfillWithdrawals) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFulfillWithdrawals) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if len(m.WithdrawalIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayout, "no withdrawals")
+	}
+
+	if len(m.WithdrawalIds) > MaxWithdrawalsPerPayout {
+		return errorsmod.Wrapf(ErrInvalidPayout, "too many withdrawals, got %d, max %d", len(m.WithdrawalIds), MaxWithdrawalsPerPayout)
+	}
+
+	seen := make(map[uint64]bool, len(m.WithdrawalIds))
+	for _, id := range m.WithdrawalIds {
+		if seen[id] {
+			return errorsmod.Wrapf(ErrInvalidPayout, "duplicated withdrawal %d", id)
+		}
+		seen[id] = true
+	}
+
+	if err := m.Proof.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPayout, err.Error())
+	}
+
+	if len(m.PrevTxs) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayout, "no previous transactions")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFulfillWithdrawals) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btcbridge/types"
+)
+
+func testProof(t *testing.T) types.BTCTxProof {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+	return types.NewBTCTxProof(buf.Bytes(), []chainhash.Hash{{2}, {3}}, 1, chainhash.Hash{4})
+}
+
+func TestBTCTxProof_Hashes(t *testing.T) {
+	proof := testProof(t)
+	require.NoError(t, proof.Validate())
+
+	branch, blockHash, err := proof.Hashes()
+	require.NoError(t, err)
+	require.Equal(t, []chainhash.Hash{{2}, {3}}, branch)
+	require.Equal(t, chainhash.Hash{4}, *blockHash)
+
+	proof.BlockHash = ""
+	require.Error(t, proof.Validate())
+}
+
+func TestMsgDeposit_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgDeposit)
+		expPass  bool
+	}{
+		{"valid", func(*types.MsgDeposit) {}, true},
+		{"invalid signer", func(msg *types.MsgDeposit) { msg.Signer = "invalid" }, false},
+		{"invalid tx", func(msg *types.MsgDeposit) { msg.Proof.RawTx = msg.Proof.RawTx[1:] }, false},
+		{"short merkle hash", func(msg *types.MsgDeposit) { msg.Proof.MerkleProof[0] = "02" }, false},
+		{"proof too deep", func(msg *types.MsgDeposit) { msg.Proof.MerkleProof = make([]string, 33) }, false},
+		{"invalid block hash", func(msg *types.MsgDeposit) { msg.Proof.BlockHash = "block" }, false},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgDeposit(signer, testProof(t))
+		tc.malleate(msg)
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgWithdraw_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgWithdraw
+		expPass bool
+	}{
+		{"valid", types.NewMsgWithdraw(sender, "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", 10_000), true},
+		{"invalid sender", &types.MsgWithdraw{Sender: "invalid", BtcAddress: "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", Amount: 10_000}, false},
+		{"empty address", types.NewMsgWithdraw(sender, "", 10_000), false},
+		{"zero amount", types.NewMsgWithdraw(sender, "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgFulfillWithdrawals_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		ids      []uint64
+		malleate func(msg *types.MsgFulfillWithdrawals)
+		expPass  bool
+	}{
+		{"valid", []uint64{1, 2}, func(*types.MsgFulfillWithdrawals) {}, true},
+		{"invalid signer", []uint64{1}, func(msg *types.MsgFulfillWithdrawals) { msg.Signer = "invalid" }, false},
+		{"no withdrawals", nil, func(*types.MsgFulfillWithdrawals) {}, false},
+		{"duplicate withdrawal", []uint64{1, 1}, func(*types.MsgFulfillWithdrawals) {}, false},
+		{"too many withdrawals", make([]uint64, types.MaxWithdrawalsPerPayout+1), func(*types.MsgFulfillWithdrawals) {}, false},
+		{"invalid proof", []uint64{1}, func(msg *types.MsgFulfillWithdrawals) { msg.Proof.RawTx = nil }, false},
+		{"no previous transactions", []uint64{1}, func(msg *types.MsgFulfillWithdrawals) { msg.PrevTxs = nil }, false},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgFulfillWithdrawals(signer, tc.ids, testProof(t), [][]byte{testProof(t).RawTx})
+		tc.malleate(msg)
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// BTCDecimals is the number of decimals of the satoshi amounts.
+	BTCDecimals = uint32(8)
+
+	// DefaultMinConfirmations is the number of confirmations usually required by the Bitcoin
+	// exchanges.
+	DefaultMinConfirmations = uint64(6)
+	// DefaultMinWithdrawalAmount is the default minimum withdrawal amount in satoshis.
+	DefaultMinWithdrawalAmount = uint64(10_000)
+	// DefaultWithdrawalFee is the default maximum network fee of a withdrawal payout in satoshis.
+	DefaultWithdrawalFee = uint64(1_000)
+)
+
+// WeiPerSatoshi is the amount of EVM balance units (i.e wei) per satoshi.
+var WeiPerSatoshi = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(evmtypes.EVMDecimals-BTCDecimals)), nil)
+
+// NewParams creates a new Params instance
+func NewParams(depositAddress string, minConfirmations, minWithdrawalAmount, withdrawalFee uint64, relayers []string) Params {
+	return Params{
+		DepositAddress:      depositAddress,
+		MinConfirmations:    minConfirmations,
+		MinWithdrawalAmount: minWithdrawalAmount,
+		WithdrawalFee:       withdrawalFee,
+		Relayers:            relayers,
+	}
+}
+
+// DefaultParams returns the default BTC bridge module parameters, with the deposits and the
+// withdrawal payouts disabled.
+func DefaultParams() Params {
+	return NewParams("", DefaultMinConfirmations, DefaultMinWithdrawalAmount, DefaultWithdrawalFee, nil)
+}
+
+// Validate performs basic validation on the module parameters. The deposit address is validated
+// against the Bitcoin network of the light client by ValidateNetwork.
+func (p Params) Validate() error {
+	if p.MinConfirmations == 0 {
+		return fmt.Errorf("min confirmations cannot be zero")
+	}
+	if p.MinWithdrawalAmount <= p.WithdrawalFee {
+		return fmt.Errorf(
+			"min withdrawal amount must be greater than the withdrawal fee: %d <= %d",
+			p.MinWithdrawalAmount, p.WithdrawalFee,
+		)
+	}
+
+	seen := make(map[string]bool, len(p.Relayers))
+	for _, relayer := range p.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return errorsmod.Wrapf(err, "invalid relayer address %s", relayer)
+		}
+		if seen[relayer] {
+			return fmt.Errorf("duplicated relayer %s", relayer)
+		}
+		seen[relayer] = true
+	}
+	return nil
+}
+
+// IsRelayer returns true if the address is allowed to relay the deposits and the withdrawal payouts.
+func (p Params) IsRelayer(address string) bool {
+	for _, relayer := range p.Relayers {
+		if relayer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateNetwork checks that the deposit address, if set, belongs to the given Bitcoin network.
+func (p Params) ValidateNetwork(chainParams *chaincfg.Params) error {
+	if p.DepositAddress == "" {
+		return nil
+	}
+	if _, err := AddressScript(p.DepositAddress, chainParams); err != nil {
+		return errorsmod.Wrap(err, "invalid deposit address")
+	}
+	return nil
+}
+
+// AddressScript returns the output script paying a Bitcoin address of the given network.
+func AddressScript(address string, chainParams *chaincfg.Params) ([]byte, error) {
+	addr, err := btcutil.DecodeAddress(address, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IsForNet(chainParams) {
+		return nil, fmt.Errorf("address %s is not a %s address", address, chainParams.Name)
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+// SatoshisToCoin returns the evm denom coin worth an amount of satoshis. The evm denom must have
+// at least 8 decimals.
+func SatoshisToCoin(evmParams evmtypes.Params, amount uint64) (sdk.Coin, error) {
+	decimals := evmParams.DenomDecimals()
+	if decimals < BTCDecimals {
+		return sdk.Coin{}, errorsmod.Wrapf(ErrUnsupportedDenom, "%s has %d decimals", evmParams.EvmDenom, decimals)
+	}
+
+	unit := sdkmath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-BTCDecimals)), nil))
+	return sdk.NewCoin(evmParams.EvmDenom, unit.Mul(sdkmath.NewIntFromUint64(amount))), nil
+}
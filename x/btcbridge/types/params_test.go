@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/evmos/ethermint/x/btcbridge/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestParamsValidateNetwork(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address string
+		expPass bool
+	}{
+		{"deposits disabled", "", true},
+		{"regtest segwit", "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", true},
+		{"regtest taproot", "bcrt1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqc8gma6", true},
+		{"mainnet segwit", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh", false},
+		{"mainnet p2pkh", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", false},
+		{"invalid", "bcrt1invalid", false},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		params.DepositAddress = tc.address
+		err := params.ValidateNetwork(&chaincfg.RegressionNetParams)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSatoshisToCoin(t *testing.T) {
+	testCases := []struct {
+		name     string
+		decimals uint32
+		expCoin  sdkmath.Int
+		expPass  bool
+	}{
+		{"18 decimals", 0, sdkmath.NewInt(1_500).Mul(sdkmath.NewInt(10_000_000_000)), true},
+		{"8 decimals", 8, sdkmath.NewInt(1_500), true},
+		{"12 decimals", 12, sdkmath.NewInt(15_000_000), true},
+		{"6 decimals", 6, sdkmath.Int{}, false},
+	}
+
+	for _, tc := range testCases {
+		params := evmtypes.DefaultParams()
+		params.EvmDenomDecimals = tc.decimals
+		coin, err := types.SatoshisToCoin(params, 1_500)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, params.EvmDenom, coin.Denom, tc.name)
+			require.Equal(t, tc.expCoin, coin.Amount, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrUnsupportedDenom, tc.name)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	btclightclienttypes "github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// NewBTCTxProof creates the inclusion proof of a serialized Bitcoin transaction.
+func NewBTCTxProof(rawTx []byte, branch []chainhash.Hash, index uint32, blockHash chainhash.Hash) BTCTxProof {
+	proof := BTCTxProof{
+		RawTx:       rawTx,
+		MerkleProof: make([]string, len(branch)),
+		Index:       index,
+		BlockHash:   blockHash.String(),
+	}
+	for i, hash := range branch {
+		proof.MerkleProof[i] = hash.String()
+	}
+	return proof
+}
+
+// Validate performs a basic validation of the proof fields.
+func (p BTCTxProof) Validate() error {
+	if _, err := btclightclienttypes.ParseTx(p.RawTx); err != nil {
+		return err
+	}
+	if len(p.MerkleProof) > btclightclienttypes.MaxMerkleProofDepth {
+		return fmt.Errorf("merkle proof depth %d exceeds the maximum %d", len(p.MerkleProof), btclightclienttypes.MaxMerkleProofDepth)
+	}
+	_, _, err := p.Hashes()
+	return err
+}
+
+// Hashes decodes the merkle branch and the block hash of the proof.
+func (p BTCTxProof) Hashes() ([]chainhash.Hash, *chainhash.Hash, error) {
+	branch := make([]chainhash.Hash, len(p.MerkleProof))
+	for i, hex := range p.MerkleProof {
+		hash, err := ParseHash(hex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid merkle proof hash %d: %w", i, err)
+		}
+		branch[i] = *hash
+	}
+
+	blockHash, err := ParseHash(p.BlockHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid block hash: %w", err)
+	}
+	return branch, blockHash, nil
+}
+
+// ParseHash decodes a hash hex encoded in the Bitcoin (reversed) byte order. Unlike
+// chainhash.NewHashFromStr, it rejects the short encodings.
+func ParseHash(s string) (*chainhash.Hash, error) {
+	if len(s) != chainhash.MaxHashStringSize {
+		return nil, fmt.Errorf("hash %q must be %d hex characters", s, chainhash.MaxHashStringSize)
+	}
+	return chainhash.NewHashFromStr(s)
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// GetQueryCmd returns the parent command for all x/btclightclient CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the Bitcoin light client module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetTipCmd(),
+		GetBaseHeaderCmd(),
+		GetHeaderCmd(),
+		GetConfirmationsCmd(),
+	)
+	return cmd
+}
+
+// GetParamsCmd queries the Bitcoin light client params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the Bitcoin light client params",
+		Long:  "Get the Bitcoin light client parameter values.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTipCmd queries the tip of the best Bitcoin chain
+func GetTipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tip",
+		Short: "Get the header at the tip of the best Bitcoin chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Tip(cmd.Context(), &types.QueryTipRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBaseHeaderCmd queries the checkpoint header
+func GetBaseHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-header",
+		Short: "Get the checkpoint header the Bitcoin light client starts from",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseHeader(cmd.Context(), &types.QueryBaseHeaderRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetHeaderCmd queries a Bitcoin header by height or hash
+func GetHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header HEIGHT_OR_HASH",
+		Short: "Get a Bitcoin header by best chain height or by hash",
+		Long: `Get a Bitcoin header by best chain height or by hash.
+Hashes are hex encoded in the Bitcoin byte order and may be the hash of a fork header.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if height, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				res, err := queryClient.HeaderByHeight(cmd.Context(), &types.QueryHeaderByHeightRequest{Height: height})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.HeaderByHash(cmd.Context(), &types.QueryHeaderByHashRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConfirmationsCmd queries the confirmations of a Bitcoin block
+func GetConfirmationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirmations HASH",
+		Short: "Get the number of confirmations of a Bitcoin block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Confirmations(cmd.Context(), &types.QueryConfirmationsRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewInsertHeadersCmd())
+	return cmd
+}
+
+// NewInsertHeadersCmd returns a CLI command to relay Bitcoin headers
+func NewInsertHeadersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insert-headers HEADER_HEX [HEADER_HEX...]",
+		Short: "Relay hex encoded Bitcoin block headers to the light client",
+		Long: `Relay hex encoded 80 bytes Bitcoin block headers to the light client, as returned by
+"bitcoin-cli getblockheader <hash> false". Headers must be ordered from parent to child.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgInsertHeaders{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Headers: make([][]byte, len(args)),
+			}
+			for i, arg := range args {
+				msg.Headers[i], err = hex.DecodeString(arg)
+				if err != nil {
+					return fmt.Errorf("invalid header %d: %w", i, err)
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
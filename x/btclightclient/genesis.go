@@ -0,0 +1,89 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package btclightclient
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/keeper"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis. The first header is stored
+// as the trusted checkpoint, the following ones are validated against it.
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	base := data.Headers[0]
+	if base.Work.IsZero() {
+		header, err := base.BlockHeader()
+		if err != nil {
+			panic(err)
+		}
+		base.Work = types.HeaderWork(header)
+	}
+	k.SetBaseHeader(ctx, base)
+
+	for _, info := range data.Headers[1:] {
+		header, err := info.BlockHeader()
+		if err != nil {
+			panic(err)
+		}
+		if err := k.InsertBlockHeaders(ctx, []*wire.BlockHeader{header}); err != nil {
+			panic(errorsmod.Wrapf(err, "could not insert header %s at genesis", info.Hash))
+		}
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the Bitcoin light client module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	base, found := k.GetBaseHeader(ctx)
+	if !found {
+		panic(types.ErrNoBaseHeader)
+	}
+
+	headers := []types.BTCHeaderInfo{}
+	k.IterateHeaders(ctx, func(info types.BTCHeaderInfo) bool {
+		if info.Hash != base.Hash {
+			headers = append(headers, info)
+		}
+		return false
+	})
+
+	// parents must be imported before their children
+	sort.Slice(headers, func(i, j int) bool {
+		if headers[i].Height != headers[j].Height {
+			return headers[i].Height < headers[j].Height
+		}
+		return headers[i].Hash < headers[j].Hash
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), append([]types.BTCHeaderInfo{base}, headers...))
+}
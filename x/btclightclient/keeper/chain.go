@@ -0,0 +1,329 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+const (
+	// medianTimeBlocks is the number of previous blocks which should be used to calculate the
+	// median time used to validate block timestamps.
+	medianTimeBlocks = 11
+
+	// maxTimeOffset is the maximum number of seconds a block time is allowed to be ahead of the
+	// current block time.
+	maxTimeOffset = 2 * time.Hour
+)
+
+// InsertBlockHeaders validates and stores the headers in order. Each header must extend a stored
+// header, including the previous ones of the list. The best chain is updated when a header has
+// more cumulative work than the current tip.
+func (k Keeper) InsertBlockHeaders(ctx sdk.Context, headers []*wire.BlockHeader) error {
+	if _, found := k.GetBaseHeader(ctx); !found {
+		return types.ErrNoBaseHeader
+	}
+
+	chainParams := k.GetParams(ctx).ChainParams()
+	for _, header := range headers {
+		if err := k.insertHeader(ctx, chainParams, header); err != nil {
+			return errorsmod.Wrapf(err, "header %s", header.BlockHash())
+		}
+	}
+	return nil
+}
+
+// insertHeader validates the header against its parent and stores it.
+func (k Keeper) insertHeader(ctx sdk.Context, chainParams *chaincfg.Params, header *wire.BlockHeader) error {
+	hash := header.BlockHash()
+	if _, found := k.GetHeader(ctx, &hash); found {
+		return types.ErrDuplicateHeader
+	}
+
+	parent, found := k.GetHeader(ctx, &header.PrevBlock)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownParent, "parent %s", header.PrevBlock)
+	}
+
+	if err := k.checkHeader(ctx, chainParams, header, parent); err != nil {
+		return err
+	}
+
+	info := types.NewBTCHeaderInfo(header, parent.Height+1, parent.Work.Add(types.HeaderWork(header)))
+	k.setHeader(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInsertHeader,
+			sdk.NewAttribute(types.AttributeKeyHash, info.Hash),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(info.Height, 10)),
+		),
+	)
+
+	tip, found := k.GetTip(ctx)
+	if !found {
+		return types.ErrNoBaseHeader
+	}
+
+	// best chain rule: the chain with the most cumulative work wins, ties keep the first seen tip
+	if info.Work.GT(tip.Work) {
+		k.setTip(ctx, info, tip)
+	}
+	return nil
+}
+
+// checkHeader performs the proof of work, difficulty and timestamp checks of a header given its
+// parent, following the btcd header validation rules.
+func (k Keeper) checkHeader(
+	ctx sdk.Context,
+	chainParams *chaincfg.Params,
+	header *wire.BlockHeader,
+	parent types.BTCHeaderInfo,
+) error {
+	block := btcutil.NewBlock(&wire.MsgBlock{Header: *header})
+	if err := blockchain.CheckProofOfWork(block, chainParams.PowLimit); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPoW, err.Error())
+	}
+
+	expectedBits, err := k.calcNextRequiredDifficulty(ctx, chainParams, parent, header.Timestamp)
+	if err != nil {
+		return err
+	}
+	if header.Bits != expectedBits {
+		return errorsmod.Wrapf(types.ErrUnexpectedDifficulty, "expected bits %08x, got %08x", expectedBits, header.Bits)
+	}
+
+	// the median time is only checked once the previous 11 blocks are stored above the
+	// checkpoint, a median of fewer blocks could reject valid headers.
+	medianTime, found, err := k.calcPastMedianTime(ctx, parent)
+	if err != nil {
+		return err
+	}
+	if found && !header.Timestamp.After(medianTime) {
+		return errorsmod.Wrapf(types.ErrInvalidTimestamp, "timestamp %s is not after the median time %s", header.Timestamp, medianTime)
+	}
+
+	if maxTime := ctx.BlockTime().Add(maxTimeOffset); header.Timestamp.After(maxTime) {
+		return errorsmod.Wrapf(types.ErrInvalidTimestamp, "timestamp %s is too far in the future", header.Timestamp)
+	}
+
+	// reject outdated block versions, as voted on by BIP0034, BIP0065, and BIP0066.
+	height := parent.Height + 1
+	if header.Version < 2 && height >= uint64(chainParams.BIP0034Height) ||
+		header.Version < 3 && height >= uint64(chainParams.BIP0066Height) ||
+		header.Version < 4 && height >= uint64(chainParams.BIP0065Height) {
+		return errorsmod.Wrapf(types.ErrInvalidHeader, "block version %d is no longer valid", header.Version)
+	}
+
+	return nil
+}
+
+// calcNextRequiredDifficulty calculates the required difficulty of the block after the given
+// header based on the difficulty retarget rules. Unlike btcd, the regression test network doesn't
+// retarget, matching the Bitcoin Core regtest rules.
+func (k Keeper) calcNextRequiredDifficulty(
+	ctx sdk.Context,
+	chainParams *chaincfg.Params,
+	last types.BTCHeaderInfo,
+	newBlockTime time.Time,
+) (uint32, error) {
+	lastHeader, err := last.BlockHeader()
+	if err != nil {
+		return 0, err
+	}
+
+	blocksPerRetarget := types.BlocksPerRetarget(chainParams)
+
+	// return the previous block's difficulty requirements if this block is not at a difficulty
+	// retarget interval.
+	if (last.Height+1)%blocksPerRetarget != 0 {
+		if !chainParams.ReduceMinDifficulty {
+			return lastHeader.Bits, nil
+		}
+
+		// allow minimum difficulty blocks when more than the desired amount of time has elapsed
+		// without mining a block.
+		if newBlockTime.After(lastHeader.Timestamp.Add(chainParams.MinDiffReductionTime)) {
+			return chainParams.PowLimitBits, nil
+		}
+
+		// return the difficulty of the last block without the minimum difficulty rule applied.
+		return k.findPrevTestNetDifficulty(ctx, chainParams, last, blocksPerRetarget)
+	}
+
+	if chainParams.Net == chaincfg.RegressionNetParams.Net {
+		return lastHeader.Bits, nil
+	}
+
+	first, found := k.getAncestor(ctx, last, last.Height-(blocksPerRetarget-1))
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrUnexpectedDifficulty, "unable to obtain previous retarget block of height %d", last.Height+1)
+	}
+	firstHeader, err := first.BlockHeader()
+	if err != nil {
+		return 0, err
+	}
+
+	// limit the amount of adjustment that can occur to the previous difficulty.
+	targetTimespan := int64(chainParams.TargetTimespan / time.Second)
+	minRetargetTimespan := targetTimespan / chainParams.RetargetAdjustmentFactor
+	maxRetargetTimespan := targetTimespan * chainParams.RetargetAdjustmentFactor
+
+	actualTimespan := lastHeader.Timestamp.Unix() - firstHeader.Timestamp.Unix()
+	adjustedTimespan := actualTimespan
+	if actualTimespan < minRetargetTimespan {
+		adjustedTimespan = minRetargetTimespan
+	} else if actualTimespan > maxRetargetTimespan {
+		adjustedTimespan = maxRetargetTimespan
+	}
+
+	// new target = current target * (adjusted timespan / target timespan)
+	newTarget := new(big.Int).Mul(blockchain.CompactToBig(lastHeader.Bits), big.NewInt(adjustedTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+
+	// limit new value to the proof of work limit.
+	if newTarget.Cmp(chainParams.PowLimit) > 0 {
+		newTarget.Set(chainParams.PowLimit)
+	}
+
+	return blockchain.BigToCompact(newTarget), nil
+}
+
+// findPrevTestNetDifficulty returns the difficulty of the last block which did not have the
+// special testnet minimum difficulty rule applied.
+func (k Keeper) findPrevTestNetDifficulty(
+	ctx sdk.Context,
+	chainParams *chaincfg.Params,
+	start types.BTCHeaderInfo,
+	blocksPerRetarget uint64,
+) (uint32, error) {
+	info := start
+	for {
+		header, err := info.BlockHeader()
+		if err != nil {
+			return 0, err
+		}
+		if info.Height%blocksPerRetarget == 0 || header.Bits != chainParams.PowLimitBits {
+			return header.Bits, nil
+		}
+
+		parent, found := k.GetHeader(ctx, &header.PrevBlock)
+		if !found {
+			// no appropriate block stored above the checkpoint
+			return chainParams.PowLimitBits, nil
+		}
+		info = parent
+	}
+}
+
+// calcPastMedianTime returns the median time of the previous blocks, the given one included. It
+// returns false if fewer than medianTimeBlocks blocks are stored.
+func (k Keeper) calcPastMedianTime(ctx sdk.Context, last types.BTCHeaderInfo) (time.Time, bool, error) {
+	timestamps := make([]int64, 0, medianTimeBlocks)
+
+	info := last
+	for len(timestamps) < medianTimeBlocks {
+		header, err := info.BlockHeader()
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		timestamps = append(timestamps, header.Timestamp.Unix())
+
+		if len(timestamps) == medianTimeBlocks {
+			break
+		}
+		parent, found := k.GetHeader(ctx, &header.PrevBlock)
+		if !found {
+			return time.Time{}, false, nil
+		}
+		info = parent
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	return time.Unix(timestamps[len(timestamps)/2], 0), true, nil
+}
+
+// getAncestor returns the ancestor of a header at the given height. The best chain index is used
+// as soon as the walk reaches the best chain.
+func (k Keeper) getAncestor(ctx sdk.Context, info types.BTCHeaderInfo, height uint64) (types.BTCHeaderInfo, bool) {
+	for info.Height > height {
+		if k.IsMainChain(ctx, info) {
+			return k.GetMainChainHeader(ctx, height)
+		}
+
+		header, err := info.BlockHeader()
+		if err != nil {
+			return types.BTCHeaderInfo{}, false
+		}
+		parent, found := k.GetHeader(ctx, &header.PrevBlock)
+		if !found {
+			return types.BTCHeaderInfo{}, false
+		}
+		info = parent
+	}
+	return info, info.Height == height
+}
+
+// setTip makes the header the tip of the best chain, re-indexing the best chain heights from the
+// fork point with the previous tip.
+func (k Keeper) setTip(ctx sdk.Context, tip, prevTip types.BTCHeaderInfo) {
+	info := tip
+	for !k.IsMainChain(ctx, info) {
+		k.setMainChainHash(ctx, info.Height, info.BlockHash())
+
+		header, err := info.BlockHeader()
+		if err != nil {
+			panic(err)
+		}
+		parent, found := k.GetHeader(ctx, &header.PrevBlock)
+		if !found {
+			// the base header is always part of the best chain
+			panic(errorsmod.Wrapf(types.ErrUnknownParent, "parent %s", header.PrevBlock))
+		}
+		info = parent
+	}
+	forkHeight := info.Height
+
+	// a chain with more work can be shorter than the previous best chain
+	for height := tip.Height + 1; height <= prevTip.Height; height++ {
+		k.deleteMainChainHash(ctx, height)
+	}
+
+	hash := tip.BlockHash()
+	ctx.KVStore(k.storeKey).Set(types.KeyTip, hash[:])
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTipUpdated,
+			sdk.NewAttribute(types.AttributeKeyHash, tip.Hash),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(tip.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyReorgDepth, strconv.FormatUint(prevTip.Height-forkHeight, 10)),
+		),
+	)
+}
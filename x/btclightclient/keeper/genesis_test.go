@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"github.com/evmos/ethermint/x/btclightclient"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	k := suite.app.BTCLightClientKeeper
+
+	mainChain := suite.mine(suite.genesis, 3)
+	fork := suite.mine(mainChain[0], 1)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, append(mainChain, fork...)))
+
+	genesis := btclightclient.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Headers, 5)
+	suite.Require().Equal(suite.chainParams.GenesisHash.String(), genesis.Headers[0].Hash)
+
+	// import in a fresh chain
+	suite.SetupTest()
+	btclightclient.InitGenesis(suite.ctx, suite.app.BTCLightClientKeeper, *genesis)
+	suite.requireTip(mainChain[2], 3)
+	suite.Require().Equal(genesis, btclightclient.ExportGenesis(suite.ctx, suite.app.BTCLightClientKeeper))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisCheckpoint() {
+	mainChain := suite.mine(suite.genesis, 12)
+
+	// start from a checkpoint at the first retarget height, without the cumulative work
+	checkpoint := types.NewBTCHeaderInfo(mainChain[10], 2016, types.HeaderWork(mainChain[10]))
+	checkpoint.Work = checkpoint.Work.Sub(checkpoint.Work)
+	genesis := types.NewGenesisState(
+		types.NewParams(suite.chainParams.Name),
+		[]types.BTCHeaderInfo{checkpoint, types.NewBTCHeaderInfo(mainChain[11], 2017, checkpoint.Work)},
+	)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	btclightclient.InitGenesis(suite.ctx, suite.app.BTCLightClientKeeper, *genesis)
+	suite.requireTip(mainChain[11], 2017)
+
+	tip, _ := suite.app.BTCLightClientKeeper.GetTip(suite.ctx)
+	suite.Require().Equal(types.HeaderWork(mainChain[10]).MulUint64(2), tip.Work)
+
+	// the headers can't be inserted below the checkpoint
+	suite.Require().Panics(func() {
+		genesis.Headers = append(genesis.Headers, types.NewBTCHeaderInfo(mainChain[5], 6, checkpoint.Work))
+		suite.SetupTest()
+		btclightclient.InitGenesis(suite.ctx, suite.app.BTCLightClientKeeper, *genesis)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Tip implements the Query/Tip gRPC method
+func (k Keeper) Tip(c context.Context, _ *types.QueryTipRequest) (*types.QueryTipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tip, found := k.GetTip(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNoBaseHeader.Error())
+	}
+
+	return &types.QueryTipResponse{
+		Header: tip,
+	}, nil
+}
+
+// BaseHeader implements the Query/BaseHeader gRPC method
+func (k Keeper) BaseHeader(c context.Context, _ *types.QueryBaseHeaderRequest) (*types.QueryBaseHeaderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	base, found := k.GetBaseHeader(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNoBaseHeader.Error())
+	}
+
+	return &types.QueryBaseHeaderResponse{
+		Header: base,
+	}, nil
+}
+
+// HeaderByHeight implements the Query/HeaderByHeight gRPC method
+func (k Keeper) HeaderByHeight(c context.Context, req *types.QueryHeaderByHeightRequest) (*types.QueryHeaderByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	header, found := k.GetMainChainHeader(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no best chain header at height %d", req.Height)
+	}
+
+	return &types.QueryHeaderByHeightResponse{
+		Header: header,
+	}, nil
+}
+
+// HeaderByHash implements the Query/HeaderByHash gRPC method
+func (k Keeper) HeaderByHash(c context.Context, req *types.QueryHeaderByHashRequest) (*types.QueryHeaderByHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := chainhash.NewHashFromStr(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	header, found := k.GetHeader(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "header %s not found", req.Hash)
+	}
+
+	return &types.QueryHeaderByHashResponse{
+		Header:    header,
+		MainChain: k.IsMainChain(ctx, header),
+	}, nil
+}
+
+// Confirmations implements the Query/Confirmations gRPC method
+func (k Keeper) Confirmations(c context.Context, req *types.QueryConfirmationsRequest) (*types.QueryConfirmationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := chainhash.NewHashFromStr(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	confirmations, err := k.GetConfirmations(ctx, hash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryConfirmationsResponse{
+		Confirmations: confirmations,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"github.com/btcsuite/btcd/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryHeaders() {
+	k := suite.app.BTCLightClientKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	mainChain := suite.mine(suite.genesis, 3)
+	fork := suite.mine(mainChain[0], 1)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, append(mainChain, fork...)))
+
+	info := func(header *wire.BlockHeader) types.BTCHeaderInfo {
+		hash := header.BlockHash()
+		info, found := k.GetHeader(suite.ctx, &hash)
+		suite.Require().True(found)
+		return info
+	}
+
+	params, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainParams.Name, params.Params.Network)
+
+	tip, err := suite.queryClient.Tip(ctx, &types.QueryTipRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(info(mainChain[2]), tip.Header)
+
+	base, err := suite.queryClient.BaseHeader(ctx, &types.QueryBaseHeaderRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(info(suite.genesis), base.Header)
+
+	byHeight, err := suite.queryClient.HeaderByHeight(ctx, &types.QueryHeaderByHeightRequest{Height: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(info(mainChain[1]), byHeight.Header)
+
+	_, err = suite.queryClient.HeaderByHeight(ctx, &types.QueryHeaderByHeightRequest{Height: 4})
+	suite.Require().Error(err)
+
+	byHash, err := suite.queryClient.HeaderByHash(ctx, &types.QueryHeaderByHashRequest{Hash: fork[0].BlockHash().String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(info(fork[0]), byHash.Header)
+	suite.Require().False(byHash.MainChain)
+
+	byHash, err = suite.queryClient.HeaderByHash(ctx, &types.QueryHeaderByHashRequest{Hash: mainChain[1].BlockHash().String()})
+	suite.Require().NoError(err)
+	suite.Require().True(byHash.MainChain)
+
+	_, err = suite.queryClient.HeaderByHash(ctx, &types.QueryHeaderByHashRequest{Hash: "invalid"})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.HeaderByHash(ctx, &types.QueryHeaderByHashRequest{Hash: suite.mine(mainChain[2], 1)[0].BlockHash().String()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryConfirmations() {
+	k := suite.app.BTCLightClientKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	mainChain := suite.mine(suite.genesis, 3)
+	fork := suite.mine(mainChain[0], 1)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, append(mainChain, fork...)))
+
+	testCases := []struct {
+		name          string
+		hash          string
+		confirmations uint64
+		expPass       bool
+	}{
+		{"checkpoint", suite.genesis.BlockHash().String(), 4, true},
+		{"best chain tip", mainChain[2].BlockHash().String(), 1, true},
+		{"best chain", mainChain[0].BlockHash().String(), 3, true},
+		{"fork", fork[0].BlockHash().String(), 0, true},
+		{"unknown header", suite.mine(mainChain[2], 1)[0].BlockHash().String(), 0, false},
+		{"invalid hash", "0x00", 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.Confirmations(ctx, &types.QueryConfirmationsRequest{Hash: tc.hash})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.confirmations, res.Confirmations)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
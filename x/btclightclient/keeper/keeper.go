@@ -0,0 +1,190 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// Keeper grants access to the Bitcoin light client module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the Bitcoin header chain Prefix KVStore.
+	storeKey storetypes.StoreKey
+}
+
+// NewKeeper generates new Bitcoin light client module keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetParams returns the total set of Bitcoin light client parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyParams)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the Bitcoin light client parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyParams, bz)
+	return nil
+}
+
+// GetHeader returns a stored header, of the best chain or of a fork, by hash.
+func (k Keeper) GetHeader(ctx sdk.Context, hash *chainhash.Hash) (types.BTCHeaderInfo, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeader)
+	bz := store.Get(hash[:])
+	if len(bz) == 0 {
+		return types.BTCHeaderInfo{}, false
+	}
+
+	var info types.BTCHeaderInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// setHeader stores a header by hash.
+func (k Keeper) setHeader(ctx sdk.Context, info types.BTCHeaderInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeader)
+	hash := info.BlockHash()
+	store.Set(hash[:], k.cdc.MustMarshal(&info))
+}
+
+// IterateHeaders iterates over all the stored headers, ordered by hash, until the callback returns true.
+func (k Keeper) IterateHeaders(ctx sdk.Context, cb func(info types.BTCHeaderInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeader)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.BTCHeaderInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// GetMainChainHeader returns the header of the best chain at the given height.
+func (k Keeper) GetMainChainHeader(ctx sdk.Context, height uint64) (types.BTCHeaderInfo, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMainChainHeight)
+	bz := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(bz) == 0 {
+		return types.BTCHeaderInfo{}, false
+	}
+	return k.GetHeader(ctx, (*chainhash.Hash)(bz))
+}
+
+// IsMainChain returns true if the header is part of the best chain.
+func (k Keeper) IsMainChain(ctx sdk.Context, info types.BTCHeaderInfo) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMainChainHeight)
+	hash := info.BlockHash()
+	return bytes.Equal(hash[:], store.Get(sdk.Uint64ToBigEndian(info.Height)))
+}
+
+// setMainChainHash indexes the best chain header at the given height.
+func (k Keeper) setMainChainHash(ctx sdk.Context, height uint64, hash chainhash.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMainChainHeight)
+	store.Set(sdk.Uint64ToBigEndian(height), hash[:])
+}
+
+// deleteMainChainHash removes the best chain index at the given height.
+func (k Keeper) deleteMainChainHash(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMainChainHeight)
+	store.Delete(sdk.Uint64ToBigEndian(height))
+}
+
+// GetTip returns the header at the tip of the best chain.
+func (k Keeper) GetTip(ctx sdk.Context) (types.BTCHeaderInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyTip)
+	if len(bz) == 0 {
+		return types.BTCHeaderInfo{}, false
+	}
+	return k.GetHeader(ctx, (*chainhash.Hash)(bz))
+}
+
+// GetBaseHeader returns the checkpoint header the light client starts from.
+func (k Keeper) GetBaseHeader(ctx sdk.Context) (types.BTCHeaderInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyBase)
+	if len(bz) == 0 {
+		return types.BTCHeaderInfo{}, false
+	}
+	return k.GetHeader(ctx, (*chainhash.Hash)(bz))
+}
+
+// SetBaseHeader stores the trusted checkpoint header the light client starts from. The header
+// isn't validated and becomes the tip of the best chain.
+// CONTRACT: this should be only called during genesis.
+func (k Keeper) SetBaseHeader(ctx sdk.Context, info types.BTCHeaderInfo) {
+	hash := info.BlockHash()
+	k.setHeader(ctx, info)
+	k.setMainChainHash(ctx, info.Height, hash)
+	ctx.KVStore(k.storeKey).Set(types.KeyBase, hash[:])
+	ctx.KVStore(k.storeKey).Set(types.KeyTip, hash[:])
+}
+
+// GetConfirmations returns the number of confirmations of a block, i.e. the number of best chain
+// blocks from the tip down to the block. It returns zero for blocks out of the best chain.
+func (k Keeper) GetConfirmations(ctx sdk.Context, hash *chainhash.Hash) (uint64, error) {
+	info, found := k.GetHeader(ctx, hash)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrHeaderNotFound, "hash %s", hash)
+	}
+
+	if !k.IsMainChain(ctx, info) {
+		return 0, nil
+	}
+
+	tip, found := k.GetTip(ctx)
+	if !found {
+		return 0, types.ErrNoBaseHeader
+	}
+	return tip.Height - info.Height + 1, nil
+}
@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.EthermintApp
+	queryClient types.QueryClient
+	chainParams *chaincfg.Params
+	genesis     *wire.BlockHeader
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.chainParams = &chaincfg.RegressionNetParams
+	suite.genesis = &suite.chainParams.GenesisBlock.Header
+
+	suite.app = app.Setup(false, func(app *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		gs := types.NewNetworkGenesisState(types.NewParams(suite.chainParams.Name))
+		genesis[types.ModuleName] = app.AppCodec().MustMarshalJSON(gs)
+		return genesis
+	})
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.BTCLightClientKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// mine returns n regtest headers extending prev spaced by 10 minutes.
+func (suite *KeeperTestSuite) mine(prev *wire.BlockHeader, n int) []*wire.BlockHeader {
+	return testutil.MineBTCHeaders(prev, suite.chainParams.PowLimitBits, n, 10*time.Minute)
+}
+
+func (suite *KeeperTestSuite) requireTip(header *wire.BlockHeader, height uint64) {
+	tip, found := suite.app.BTCLightClientKeeper.GetTip(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(header.BlockHash().String(), tip.Hash)
+	suite.Require().Equal(height, tip.Height)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	k := suite.app.BTCLightClientKeeper
+
+	suite.Require().Equal(types.NewParams(suite.chainParams.Name), k.GetParams(suite.ctx))
+
+	base, found := k.GetBaseHeader(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainParams.GenesisHash.String(), base.Hash)
+	suite.Require().Equal(uint64(0), base.Height)
+	suite.Require().Equal(types.HeaderWork(suite.genesis), base.Work)
+
+	suite.requireTip(suite.genesis, 0)
+}
+
+func (suite *KeeperTestSuite) TestInsertBlockHeaders() {
+	k := suite.app.BTCLightClientKeeper
+
+	headers := suite.mine(suite.genesis, 5)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, headers[:3]))
+	suite.requireTip(headers[2], 3)
+
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, headers[3:]))
+	suite.requireTip(headers[4], 5)
+
+	for i, header := range headers {
+		hash := header.BlockHash()
+		info, found := k.GetHeader(suite.ctx, &hash)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(i+1), info.Height)
+		suite.Require().Equal(types.SerializeHeader(header), info.Header)
+
+		mainChain, found := k.GetMainChainHeader(suite.ctx, uint64(i+1))
+		suite.Require().True(found)
+		suite.Require().Equal(info, mainChain)
+
+		confirmations, err := k.GetConfirmations(suite.ctx, &hash)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(len(headers)-i), confirmations)
+	}
+
+	// work is accumulated from the checkpoint
+	tip, _ := k.GetTip(suite.ctx)
+	suite.Require().Equal(types.HeaderWork(suite.genesis).MulUint64(6), tip.Work)
+}
+
+func (suite *KeeperTestSuite) TestInsertBlockHeadersInvalid() {
+	chain := suite.mine(suite.genesis, 11)
+	prev := chain[len(chain)-1]
+
+	testCases := []struct {
+		name   string
+		header func() *wire.BlockHeader
+		err    error
+	}{
+		{
+			"unknown parent",
+			func() *wire.BlockHeader {
+				orphan := suite.mine(suite.genesis, 2)[1]
+				return orphan
+			},
+			types.ErrUnknownParent,
+		},
+		{
+			"duplicate",
+			func() *wire.BlockHeader {
+				return chain[3]
+			},
+			types.ErrDuplicateHeader,
+		},
+		{
+			"hash above target",
+			func() *wire.BlockHeader {
+				header := suite.mine(prev, 1)[0]
+				target := blockchain.CompactToBig(header.Bits)
+				for {
+					header.Nonce++
+					hash := header.BlockHash()
+					if blockchain.HashToBig(&hash).Cmp(target) > 0 {
+						return header
+					}
+				}
+			},
+			types.ErrInvalidPoW,
+		},
+		{
+			"target above the pow limit",
+			func() *wire.BlockHeader {
+				return testutil.MineBTCHeader(prev, 0x2100ffff, prev.Timestamp.Add(time.Minute), chainhash.Hash{})
+			},
+			types.ErrInvalidPoW,
+		},
+		{
+			"unexpected difficulty",
+			func() *wire.BlockHeader {
+				return testutil.MineBTCHeader(prev, 0x2000ffff, prev.Timestamp.Add(time.Minute), chainhash.Hash{})
+			},
+			types.ErrUnexpectedDifficulty,
+		},
+		{
+			"timestamp not after the median time",
+			func() *wire.BlockHeader {
+				// median of the last 11 blocks is the 6th one
+				return testutil.MineBTCHeader(prev, prev.Bits, chain[5].Timestamp, chainhash.Hash{})
+			},
+			types.ErrInvalidTimestamp,
+		},
+		{
+			"timestamp too far in the future",
+			func() *wire.BlockHeader {
+				return testutil.MineBTCHeader(prev, prev.Bits, suite.ctx.BlockTime().Add(3*time.Hour), chainhash.Hash{})
+			},
+			types.ErrInvalidTimestamp,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.BTCLightClientKeeper
+			suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, chain))
+
+			err := k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{tc.header()})
+			suite.Require().ErrorIs(err, tc.err)
+			suite.requireTip(prev, uint64(len(chain)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMedianTimeAboveCheckpoint() {
+	k := suite.app.BTCLightClientKeeper
+	chain := suite.mine(suite.genesis, 9)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, chain))
+
+	// only 10 blocks are stored, the median time is not checked
+	header := testutil.MineBTCHeader(chain[8], chain[8].Bits, chain[0].Timestamp, chainhash.Hash{})
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{header}))
+	suite.requireTip(header, 10)
+
+	header = testutil.MineBTCHeader(header, header.Bits, chain[0].Timestamp, chainhash.Hash{})
+	err := k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{header})
+	suite.Require().ErrorIs(err, types.ErrInvalidTimestamp)
+}
+
+func (suite *KeeperTestSuite) TestReorg() {
+	k := suite.app.BTCLightClientKeeper
+
+	mainChain := suite.mine(suite.genesis, 4)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, mainChain))
+
+	// a fork with the same work doesn't replace the tip
+	fork := suite.mine(mainChain[0], 3)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, fork))
+	suite.requireTip(mainChain[3], 4)
+
+	forkHash := fork[2].BlockHash()
+	info, found := k.GetHeader(suite.ctx, &forkHash)
+	suite.Require().True(found)
+	suite.Require().False(k.IsMainChain(suite.ctx, info))
+	confirmations, err := k.GetConfirmations(suite.ctx, &forkHash)
+	suite.Require().NoError(err)
+	suite.Require().Zero(confirmations)
+
+	// more work switches the best chain
+	fork = append(fork, suite.mine(fork[2], 1)...)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, fork[3:]))
+	suite.requireTip(fork[3], 5)
+
+	for i, header := range append(mainChain[:1], fork...) {
+		info, found := k.GetMainChainHeader(suite.ctx, uint64(i+1))
+		suite.Require().True(found)
+		suite.Require().Equal(header.BlockHash().String(), info.Hash)
+	}
+
+	oldHash := mainChain[3].BlockHash()
+	confirmations, err = k.GetConfirmations(suite.ctx, &oldHash)
+	suite.Require().NoError(err)
+	suite.Require().Zero(confirmations)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeTipUpdated, events[len(events)-1].Type)
+	suite.Require().Equal(types.AttributeKeyReorgDepth, string(events[len(events)-1].Attributes[2].Key))
+	suite.Require().Equal("3", string(events[len(events)-1].Attributes[2].Value))
+}
+
+func (suite *KeeperTestSuite) TestRetarget() {
+	k := suite.app.BTCLightClientKeeper
+	params := &chaincfg.SimNetParams
+
+	// start from the simulation network genesis block
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(params.Name)))
+	genesis := params.GenesisBlock.Header
+	k.SetBaseHeader(suite.ctx, types.NewBTCHeaderInfo(&genesis, 0, types.HeaderWork(&genesis)))
+
+	blocksPerRetarget := int(params.TargetTimespan / params.TargetTimePerBlock)
+	chain := testutil.MineBTCHeaders(&genesis, params.PowLimitBits, blocksPerRetarget-1, time.Second)
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, chain))
+	prev := chain[len(chain)-1]
+
+	// the blocks were mined faster than a quarter of the target timespan, the target is
+	// limited to a quarter of the previous one.
+	target := blockchain.CompactToBig(params.PowLimitBits)
+	target.Div(target, big.NewInt(params.RetargetAdjustmentFactor))
+	expectedBits := blockchain.BigToCompact(target)
+
+	header := testutil.MineBTCHeader(prev, params.PowLimitBits, prev.Timestamp.Add(time.Minute), chainhash.Hash{})
+	err := k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{header})
+	suite.Require().ErrorIs(err, types.ErrUnexpectedDifficulty)
+
+	header = testutil.MineBTCHeader(prev, expectedBits, prev.Timestamp.Add(time.Minute), chainhash.Hash{})
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{header}))
+	suite.requireTip(header, uint64(blocksPerRetarget))
+}
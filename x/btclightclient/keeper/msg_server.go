@@ -0,0 +1,51 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// InsertHeaders implements the gRPC MsgServer interface. It validates the relayed Bitcoin headers
+// and adds them to the header chain.
+func (k Keeper) InsertHeaders(goCtx context.Context, msg *types.MsgInsertHeaders) (*types.MsgInsertHeadersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	headers, err := msg.BlockHeaders()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.InsertBlockHeaders(ctx, headers); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	)
+
+	return &types.MsgInsertHeadersResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func (suite *KeeperTestSuite) TestInsertHeaders() {
+	k := suite.app.BTCLightClientKeeper
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	headers := suite.mine(suite.genesis, 3)
+
+	_, err := k.InsertHeaders(sdk.WrapSDKContext(suite.ctx), types.NewMsgInsertHeaders(signer, headers))
+	suite.Require().NoError(err)
+	suite.requireTip(headers[2], 3)
+
+	// inserting the headers again fails
+	_, err = k.InsertHeaders(sdk.WrapSDKContext(suite.ctx), types.NewMsgInsertHeaders(signer, headers[2:]))
+	suite.Require().ErrorIs(err, types.ErrDuplicateHeader)
+
+	msg := types.NewMsgInsertHeaders(signer, suite.mine(headers[2], 1))
+	msg.Headers[0] = msg.Headers[0][:79]
+	_, err = k.InsertHeaders(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidHeader)
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+// VerifyTxInclusion checks that a serialized Bitcoin transaction is included in a stored block,
+// given its merkle branch and its index in the block. It returns the decoded transaction and the
+// number of confirmations of the block, which is zero if the block is not part of the best chain.
+func (k Keeper) VerifyTxInclusion(
+	ctx sdk.Context,
+	rawTx []byte,
+	branch []chainhash.Hash,
+	index uint32,
+	blockHash *chainhash.Hash,
+) (*wire.MsgTx, uint64, error) {
+	tx, err := types.ParseTx(rawTx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, found := k.GetHeader(ctx, blockHash)
+	if !found {
+		return nil, 0, errorsmod.Wrapf(types.ErrHeaderNotFound, "block %s", blockHash)
+	}
+	header, err := info.BlockHeader()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := types.VerifyMerkleProof(tx.TxHash(), branch, index, header); err != nil {
+		return nil, 0, err
+	}
+
+	confirmations, err := k.GetConfirmations(ctx, blockHash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tx, confirmations, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package precompile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/btclightclient/keeper"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+const (
+	// VerifyTxMethod is the name of the inclusion proof verification method.
+	VerifyTxMethod = "verifyTx"
+
+	// VerifyTxBaseGas is the gas charged for the block header lookup and the confirmations count.
+	VerifyTxBaseGas uint64 = 10_000
+	// VerifyTxProofDepthGas is the gas charged for each level of the merkle branch.
+	VerifyTxProofDepthGas uint64 = 500
+	// VerifyTxWordGas is the gas charged for each 32 bytes word of the raw transaction, which is
+	// decoded and double SHA-256 hashed.
+	VerifyTxWordGas uint64 = 24
+)
+
+// Address is the address of the Bitcoin SPV precompiled contract.
+var Address = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// ABI is the Solidity interface of the Bitcoin SPV precompiled contract:
+//
+//	interface IBitcoinSPV {
+//	    struct Output {
+//	        uint64 value;
+//	        bytes scriptPubKey;
+//	    }
+//
+//	    function verifyTx(bytes calldata rawTx, bytes32[] calldata proof, uint32 index, bytes32 blockHash)
+//	        external view returns (bytes32 txid, Output[] memory outputs, uint64 confirmations);
+//	}
+//
+// The hashes use the Bitcoin internal byte order, which is the reverse of the displayed one.
+var ABI abi.ABI
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader([]byte(abiJSON)))
+	if err != nil {
+		panic(err)
+	}
+}
+
+const abiJSON = `[
+  {
+    "type": "function",
+    "name": "verifyTx",
+    "stateMutability": "view",
+    "inputs": [
+      {"name": "rawTx", "type": "bytes"},
+      {"name": "proof", "type": "bytes32[]"},
+      {"name": "index", "type": "uint32"},
+      {"name": "blockHash", "type": "bytes32"}
+    ],
+    "outputs": [
+      {"name": "txid", "type": "bytes32"},
+      {
+        "name": "outputs",
+        "type": "tuple[]",
+        "components": [
+          {"name": "value", "type": "uint64"},
+          {"name": "scriptPubKey", "type": "bytes"}
+        ]
+      },
+      {"name": "confirmations", "type": "uint64"}
+    ]
+  }
+]`
+
+// Output is a Bitcoin transaction output, as returned by the verifyTx method.
+type Output struct {
+	Value        uint64 `abi:"value"`
+	ScriptPubKey []byte `abi:"scriptPubKey"`
+}
+
+// verifyTxArgs are the arguments of the verifyTx method.
+type verifyTxArgs struct {
+	RawTx     []byte     `abi:"rawTx"`
+	Proof     [][32]byte `abi:"proof"`
+	Index     uint32     `abi:"index"`
+	BlockHash [32]byte   `abi:"blockHash"`
+}
+
+var _ evm.StatefulPrecompiledContract = (*Precompile)(nil)
+
+// Precompile is a stateful precompiled contract verifying Bitcoin transaction inclusion proofs
+// against the headers stored by the Bitcoin light client.
+type Precompile struct {
+	keeper keeper.Keeper
+}
+
+// NewPrecompile creates a new Bitcoin SPV precompiled contract.
+func NewPrecompile(k keeper.Keeper) *Precompile {
+	return &Precompile{
+		keeper: k,
+	}
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract, which grows with
+// the merkle proof depth and the raw transaction size. Invalid inputs are charged the base gas.
+func (p *Precompile) RequiredGas(input []byte) uint64 {
+	args, err := unpackVerifyTx(input)
+	if err != nil {
+		return VerifyTxBaseGas
+	}
+	words := (uint64(len(args.RawTx)) + 31) / 32
+	return VerifyTxBaseGas + uint64(len(args.Proof))*VerifyTxProofDepthGas + words*VerifyTxWordGas
+}
+
+// Address returns the address of the precompiled contract.
+func (p *Precompile) Address() common.Address {
+	return Address
+}
+
+// Run returns an error, the precompiled contract needs the state to be executed.
+func (p *Precompile) Run(_ *vm.EVM, _ *vm.Contract, _ bool) ([]byte, error) {
+	return nil, errors.New("bitcoin SPV precompile must be run with RunStateful")
+}
+
+// RunStateful verifies that a Bitcoin transaction is included in a stored block and returns its
+// id, its outputs and the number of confirmations of the block. The confirmations are zero if the
+// block is not part of the best Bitcoin chain.
+func (p *Precompile) RunStateful(evm evm.EVM, _, _ common.Address, input []byte, value *big.Int) ([]byte, error) {
+	if value != nil && value.Sign() != 0 {
+		return nil, errors.New("bitcoin SPV precompile doesn't accept value")
+	}
+
+	stateDB, ok := evm.StateDB().(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("unsupported state database %T", evm.StateDB())
+	}
+
+	args, err := unpackVerifyTx(input)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := make([]chainhash.Hash, len(args.Proof))
+	for i, hash := range args.Proof {
+		branch[i] = hash
+	}
+	blockHash := chainhash.Hash(args.BlockHash)
+
+	tx, confirmations, err := p.keeper.VerifyTxInclusion(stateDB.Context(), args.RawTx, branch, args.Index, &blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]Output, len(tx.TxOut))
+	for i, out := range tx.TxOut {
+		if out.Value < 0 {
+			return nil, fmt.Errorf("output %d has a negative value", i)
+		}
+		outputs[i] = Output{
+			Value:        uint64(out.Value),
+			ScriptPubKey: out.PkScript,
+		}
+	}
+
+	return ABI.Methods[VerifyTxMethod].Outputs.Pack([32]byte(tx.TxHash()), outputs, confirmations)
+}
+
+// unpackVerifyTx decodes the call data of the verifyTx method.
+func unpackVerifyTx(input []byte) (*verifyTxArgs, error) {
+	method := ABI.Methods[VerifyTxMethod]
+	if len(input) < 4 || !bytes.Equal(input[:4], method.ID) {
+		return nil, errors.New("unknown bitcoin SPV precompile method")
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	var args verifyTxArgs
+	if err := method.Inputs.Copy(&args, values); err != nil {
+		return nil, err
+	}
+	return &args, nil
+}
@@ -0,0 +1,277 @@
+package precompile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btclightclient/precompile"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evmvm "github.com/evmos/ethermint/x/evm/vm"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.EthermintApp
+	proposer sdk.ConsAddress
+
+	txs       []*wire.MsgTx
+	txids     []chainhash.Hash
+	block     *wire.BlockHeader
+	mainChain []*wire.BlockHeader
+	fork      *wire.BlockHeader
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	params := &chaincfg.RegressionNetParams
+	suite.app = app.Setup(false, func(app *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		gs := types.NewNetworkGenesisState(types.NewParams(params.Name))
+		genesis[types.ModuleName] = app.AppCodec().MustMarshalJSON(gs)
+		return genesis
+	})
+	header := tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	}
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	proposer, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.proposer = proposer
+	header.ProposerAddress = proposer
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+
+	// a block with 5 transactions, confirmed by 2 blocks
+	suite.txs = make([]*wire.MsgTx, 5)
+	suite.txids = make([]chainhash.Hash, len(suite.txs))
+	for i := range suite.txs {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{byte(i)}, 0), nil, nil))
+		tx.AddTxOut(wire.NewTxOut(int64(1000*(i+1)), []byte{0x00, 0x14, byte(i)}))
+		tx.AddTxOut(wire.NewTxOut(5, []byte{0x6a}))
+		suite.txs[i] = tx
+		suite.txids[i] = tx.TxHash()
+	}
+	root, err := types.ComputeMerkleRoot(suite.txids[0], testutil.BTCMerkleBranch(suite.txids, 0), 0)
+	suite.Require().NoError(err)
+
+	genesis := &params.GenesisBlock.Header
+	suite.block = testutil.MineBTCHeader(genesis, params.PowLimitBits, genesis.Timestamp.Add(10*time.Minute), root)
+	suite.mainChain = append([]*wire.BlockHeader{suite.block}, testutil.MineBTCHeaders(suite.block, params.PowLimitBits, 2, 10*time.Minute)...)
+	suite.fork = testutil.MineBTCHeader(genesis, params.PowLimitBits, genesis.Timestamp.Add(20*time.Minute), root)
+
+	k := suite.app.BTCLightClientKeeper
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, suite.mainChain))
+	suite.Require().NoError(k.InsertBlockHeaders(suite.ctx, []*wire.BlockHeader{suite.fork}))
+}
+
+func (suite *PrecompileTestSuite) rawTx(i int) []byte {
+	var buf bytes.Buffer
+	suite.Require().NoError(suite.txs[i].Serialize(&buf))
+	return buf.Bytes()
+}
+
+func (suite *PrecompileTestSuite) pack(rawTx []byte, branch []chainhash.Hash, index uint32, blockHash chainhash.Hash) []byte {
+	proof := make([][32]byte, len(branch))
+	for i, hash := range branch {
+		proof[i] = hash
+	}
+	input, err := precompile.ABI.Pack(precompile.VerifyTxMethod, rawTx, proof, index, [32]byte(blockHash))
+	suite.Require().NoError(err)
+	return input
+}
+
+func (suite *PrecompileTestSuite) call(to common.Address, input []byte, value *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+	from := tests.GenerateAddress()
+	if value != nil {
+		suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, from, value))
+	}
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		From:  &from,
+		To:    &to,
+		Data:  (*hexutil.Bytes)(&input),
+		Value: (*hexutil.Big)(value),
+	})
+	suite.Require().NoError(err)
+
+	return suite.app.EvmKeeper.EthCall(sdk.WrapSDKContext(suite.ctx), &evmtypes.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: suite.proposer,
+	})
+}
+
+func (suite *PrecompileTestSuite) TestVerifyTx() {
+	testCases := []struct {
+		name          string
+		index         int
+		blockHash     chainhash.Hash
+		confirmations uint64
+		expPass       bool
+	}{
+		{"first transaction", 0, suite.block.BlockHash(), 3, true},
+		{"last transaction", 4, suite.block.BlockHash(), 3, true},
+		{"fork block", 2, suite.fork.BlockHash(), 0, true},
+		{"other block", 2, suite.mainChain[1].BlockHash(), 0, false},
+		{"unknown block", 2, chainhash.Hash{1}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			branch := testutil.BTCMerkleBranch(suite.txids, tc.index)
+			input := suite.pack(suite.rawTx(tc.index), branch, uint32(tc.index), tc.blockHash)
+
+			res, err := suite.call(precompile.Address, input, nil)
+			suite.Require().NoError(err)
+			if !tc.expPass {
+				suite.Require().True(res.Failed())
+				return
+			}
+			suite.Require().False(res.Failed(), res.VmError)
+
+			values, err := precompile.ABI.Unpack(precompile.VerifyTxMethod, res.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal([32]byte(suite.txids[tc.index]), values[0])
+			suite.Require().Equal(tc.confirmations, values[2])
+
+			var outputs []precompile.Output
+			suite.Require().NoError(precompile.ABI.Methods[precompile.VerifyTxMethod].Outputs[1:2].Copy(&outputs, values[1:2]))
+			suite.Require().Equal([]precompile.Output{
+				{Value: uint64(1000 * (tc.index + 1)), ScriptPubKey: []byte{0x00, 0x14, byte(tc.index)}},
+				{Value: 5, ScriptPubKey: []byte{0x6a}},
+			}, outputs)
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestVerifyTxInvalid() {
+	branch := testutil.BTCMerkleBranch(suite.txids, 1)
+	blockHash := suite.block.BlockHash()
+
+	testCases := []struct {
+		name  string
+		input []byte
+		value *big.Int
+	}{
+		{"wrong index", suite.pack(suite.rawTx(1), branch, 0, blockHash), nil},
+		{"wrong transaction", suite.pack(suite.rawTx(2), branch, 1, blockHash), nil},
+		{"truncated branch", suite.pack(suite.rawTx(1), branch[:2], 1, blockHash), nil},
+		{"invalid transaction", suite.pack(suite.rawTx(1)[1:], branch, 1, blockHash), nil},
+		{"invalid input", []byte{1, 2, 3, 4, 5}, nil},
+		{"value", suite.pack(suite.rawTx(1), branch, 1, blockHash), big.NewInt(1)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.call(precompile.Address, tc.input, tc.value)
+			suite.Require().NoError(err)
+			suite.Require().True(res.Failed())
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestRequiredGas() {
+	p := precompile.NewPrecompile(suite.app.BTCLightClientKeeper)
+
+	rawTx := suite.rawTx(1)
+	words := uint64(len(rawTx)+31) / 32
+
+	shallow := suite.pack(rawTx, make([]chainhash.Hash, 1), 1, chainhash.Hash{})
+	deep := suite.pack(rawTx, make([]chainhash.Hash, 10), 1, chainhash.Hash{})
+
+	suite.Require().Equal(precompile.VerifyTxBaseGas+precompile.VerifyTxProofDepthGas+words*precompile.VerifyTxWordGas, p.RequiredGas(shallow))
+	suite.Require().Equal(precompile.VerifyTxBaseGas+10*precompile.VerifyTxProofDepthGas+words*precompile.VerifyTxWordGas, p.RequiredGas(deep))
+	suite.Require().Equal(precompile.VerifyTxBaseGas, p.RequiredGas(nil))
+
+	// the gas is consumed by the call
+	res, err := suite.call(precompile.Address, deep, nil)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(res.GasUsed, p.RequiredGas(deep))
+
+	_, err = p.Run(nil, nil, false)
+	suite.Require().Error(err)
+}
+
+// forwarderCode is the init code of a contract forwarding its call data to the precompile with
+// a STATICCALL, and returning or reverting with the precompile output:
+//
+//	CALLDATASIZE PUSH1 0 PUSH1 0 CALLDATACOPY
+//	PUSH1 0 PUSH1 0 CALLDATASIZE PUSH1 0 PUSH2 0x0900 GAS STATICCALL
+//	RETURNDATASIZE PUSH1 0 PUSH1 0 RETURNDATACOPY
+//	PUSH1 0x1f JUMPI RETURNDATASIZE PUSH1 0 REVERT
+//	JUMPDEST RETURNDATASIZE PUSH1 0 RETURN
+var forwarderCode = common.FromHex(
+	"6024600c60003960246000f3" +
+		"366000600037600060003660006109005afa3d600060003e601f573d6000fd5b3d6000f3",
+)
+
+func (suite *PrecompileTestSuite) TestVerifyTxFromContract() {
+	deployer := tests.GenerateAddress()
+	msg := ethtypes.NewMessage(deployer, nil, 0, big.NewInt(0), 100_000, big.NewInt(0), nil, nil, forwarderCode, nil, true)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	forwarder := crypto.CreateAddress(deployer, 0)
+
+	branch := testutil.BTCMerkleBranch(suite.txids, 1)
+	input := suite.pack(suite.rawTx(1), branch, 1, suite.block.BlockHash())
+	direct, err := suite.call(precompile.Address, input, nil)
+	suite.Require().NoError(err)
+	suite.Require().False(direct.Failed(), direct.VmError)
+
+	// the precompile is dispatched for the calls from contracts
+	res, err = suite.call(forwarder, input, nil)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(direct.Ret, res.Ret)
+
+	// and reverts the calling contract on invalid proofs
+	res, err = suite.call(forwarder, suite.pack(suite.rawTx(2), branch, 1, suite.block.BlockHash()), nil)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestActivePrecompiles() {
+	p := precompile.NewPrecompile(suite.app.BTCLightClientKeeper)
+	chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(suite.app.EvmKeeper.ChainID())
+	evm := geth.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, nil, chainConfig, vm.Config{}, evmvm.PrecompiledContracts{
+		precompile.Address: p,
+	}, 0)
+
+	found, ok := evm.Precompile(precompile.Address)
+	suite.Require().True(ok)
+	suite.Require().Equal(p, found)
+
+	active := evm.ActivePrecompiles(chainConfig.Rules(big.NewInt(1), false))
+	suite.Require().Equal(precompile.Address, active[len(active)-1])
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidHeader = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrUnknownParent
+	codeErrDuplicateHeader
+	codeErrInvalidPoW
+	codeErrUnexpectedDifficulty
+	codeErrInvalidTimestamp
+	codeErrHeaderNotFound
+	codeErrNoBaseHeader
+	codeErrInvalidNetwork
+	codeErrInvalidTx
+	codeErrInvalidMerkleProof
+)
+
+var (
+	// ErrInvalidHeader returns an error if a header can't be decoded or is malformed.
+	ErrInvalidHeader = errorsmod.Register(ModuleName, codeErrInvalidHeader, "invalid bitcoin header")
+
+	// ErrUnknownParent returns an error if the parent of a header is not stored.
+	ErrUnknownParent = errorsmod.Register(ModuleName, codeErrUnknownParent, "unknown bitcoin header parent")
+
+	// ErrDuplicateHeader returns an error if a header is already stored.
+	ErrDuplicateHeader = errorsmod.Register(ModuleName, codeErrDuplicateHeader, "bitcoin header already stored")
+
+	// ErrInvalidPoW returns an error if the header hash doesn't satisfy its target.
+	ErrInvalidPoW = errorsmod.Register(ModuleName, codeErrInvalidPoW, "invalid bitcoin header proof of work")
+
+	// ErrUnexpectedDifficulty returns an error if the header bits don't match the difficulty retarget rules.
+	ErrUnexpectedDifficulty = errorsmod.Register(ModuleName, codeErrUnexpectedDifficulty, "unexpected bitcoin header difficulty")
+
+	// ErrInvalidTimestamp returns an error if the header timestamp is too old or too far in the future.
+	ErrInvalidTimestamp = errorsmod.Register(ModuleName, codeErrInvalidTimestamp, "invalid bitcoin header timestamp")
+
+	// ErrHeaderNotFound returns an error if a header is not stored.
+	ErrHeaderNotFound = errorsmod.Register(ModuleName, codeErrHeaderNotFound, "bitcoin header not found")
+
+	// ErrNoBaseHeader returns an error if the light client has no checkpoint header.
+	ErrNoBaseHeader = errorsmod.Register(ModuleName, codeErrNoBaseHeader, "bitcoin light client has no base header")
+
+	// ErrInvalidNetwork returns an error if the Bitcoin network name is unknown.
+	ErrInvalidNetwork = errorsmod.Register(ModuleName, codeErrInvalidNetwork, "invalid bitcoin network")
+
+	// ErrInvalidTx returns an error if a Bitcoin transaction can't be decoded.
+	ErrInvalidTx = errorsmod.Register(ModuleName, codeErrInvalidTx, "invalid bitcoin transaction")
+
+	// ErrInvalidMerkleProof returns an error if a transaction merkle proof doesn't match the block merkle root.
+	ErrInvalidMerkleProof = errorsmod.Register(ModuleName, codeErrInvalidMerkleProof, "invalid bitcoin merkle proof")
+)
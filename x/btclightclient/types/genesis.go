@@ -0,0 +1,87 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState sets default Bitcoin light client genesis state, which
+// starts from the genesis block of the default network.
+func DefaultGenesisState() *GenesisState {
+	return NewNetworkGenesisState(DefaultParams())
+}
+
+// NewNetworkGenesisState creates a genesis state which uses the genesis block of the params
+// network as checkpoint.
+func NewNetworkGenesisState(params Params) *GenesisState {
+	genesis := params.ChainParams().GenesisBlock.Header
+	return NewGenesisState(params, []BTCHeaderInfo{
+		NewBTCHeaderInfo(&genesis, 0, HeaderWork(&genesis)),
+	})
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, headers []BTCHeaderInfo) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Headers: headers,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.Headers) == 0 {
+		return errorsmod.Wrap(ErrNoBaseHeader, "genesis must contain a checkpoint header")
+	}
+
+	seen := make(map[string]bool, len(gs.Headers))
+	for i, header := range gs.Headers {
+		if err := header.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "header %d", i)
+		}
+		if seen[header.Hash] {
+			return fmt.Errorf("duplicated header %s", header.Hash)
+		}
+		seen[header.Hash] = true
+
+		if i == 0 {
+			// a retarget needs the first header of the previous adjustment period.
+			if blocksPerRetarget := BlocksPerRetarget(gs.Params.ChainParams()); header.Height%blocksPerRetarget != 0 {
+				return errorsmod.Wrapf(
+					ErrInvalidHeader,
+					"checkpoint height %d is not the first block of an adjustment period of %d blocks", header.Height, blocksPerRetarget,
+				)
+			}
+			continue
+		}
+		if header.Height <= gs.Headers[0].Height {
+			return fmt.Errorf("header %s at height %d is not above the checkpoint height %d", header.Hash, header.Height, gs.Headers[0].Height)
+		}
+		if header.Height < gs.Headers[i-1].Height {
+			return fmt.Errorf("headers are not ordered by height, %d after %d", header.Height, gs.Headers[i-1].Height)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	params := types.NewParams(chaincfg.RegressionNetParams.Name)
+	genesis := &chaincfg.RegressionNetParams.GenesisBlock.Header
+	headers := testutil.MineBTCHeaders(genesis, genesis.Bits, 2, 10*time.Minute)
+	work := types.HeaderWork(genesis)
+
+	base := types.NewBTCHeaderInfo(genesis, 0, work)
+	first := types.NewBTCHeaderInfo(headers[0], 1, work.MulUint64(2))
+	second := types.NewBTCHeaderInfo(headers[1], 2, work.MulUint64(3))
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"regtest", types.NewNetworkGenesisState(params), true},
+		{"headers", types.NewGenesisState(params, []types.BTCHeaderInfo{base, first, second}), true},
+		{"invalid network", types.NewGenesisState(types.NewParams("bitcoin"), []types.BTCHeaderInfo{base}), false},
+		{"unsupported signet", types.NewGenesisState(types.NewParams("signet"), []types.BTCHeaderInfo{base}), false},
+		{"no checkpoint", types.NewGenesisState(params, nil), false},
+		{"duplicate header", types.NewGenesisState(params, []types.BTCHeaderInfo{base, first, first}), false},
+		{"checkpoint not at a retarget height", types.NewGenesisState(params, []types.BTCHeaderInfo{first, second}), false},
+		{"header below checkpoint", types.NewGenesisState(params, []types.BTCHeaderInfo{base, types.NewBTCHeaderInfo(headers[0], 0, work)}), false},
+		{"unordered headers", types.NewGenesisState(params, []types.BTCHeaderInfo{base, second, first}), false},
+		{
+			"hash mismatch",
+			types.NewGenesisState(params, []types.BTCHeaderInfo{base, {Header: first.Header, Hash: second.Hash, Height: 1, Work: first.Work}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
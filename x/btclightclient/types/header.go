@@ -0,0 +1,91 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewBTCHeaderInfo returns a new BTCHeaderInfo for the header at the given height.
+func NewBTCHeaderInfo(header *wire.BlockHeader, height uint64, work sdkmath.Uint) BTCHeaderInfo {
+	return BTCHeaderInfo{
+		Header: SerializeHeader(header),
+		Hash:   header.BlockHash().String(),
+		Height: height,
+		Work:   work,
+	}
+}
+
+// SerializeHeader returns the 80 bytes serialization of a Bitcoin header.
+func SerializeHeader(header *wire.BlockHeader) []byte {
+	var buf bytes.Buffer
+	buf.Grow(wire.MaxBlockHeaderPayload)
+	// writing to a bytes buffer never fails
+	_ = header.Serialize(&buf)
+	return buf.Bytes()
+}
+
+// ParseHeader decodes a 80 bytes serialized Bitcoin header.
+func ParseHeader(bz []byte) (*wire.BlockHeader, error) {
+	if len(bz) != wire.MaxBlockHeaderPayload {
+		return nil, errorsmod.Wrapf(ErrInvalidHeader, "expected %d bytes, got %d", wire.MaxBlockHeaderPayload, len(bz))
+	}
+
+	header := new(wire.BlockHeader)
+	if err := header.Deserialize(bytes.NewReader(bz)); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidHeader, err.Error())
+	}
+	return header, nil
+}
+
+// HeaderWork returns the proof of work of a single header.
+func HeaderWork(header *wire.BlockHeader) sdkmath.Uint {
+	return sdkmath.NewUintFromBigInt(blockchain.CalcWork(header.Bits))
+}
+
+// BlockHeader decodes the stored Bitcoin header.
+func (h BTCHeaderInfo) BlockHeader() (*wire.BlockHeader, error) {
+	return ParseHeader(h.Header)
+}
+
+// BlockHash returns the hash of the stored Bitcoin header.
+func (h BTCHeaderInfo) BlockHash() chainhash.Hash {
+	return chainhash.DoubleHashH(h.Header)
+}
+
+// Validate performs a stateless validation of the header info.
+func (h BTCHeaderInfo) Validate() error {
+	if _, err := h.BlockHeader(); err != nil {
+		return err
+	}
+
+	if hash := h.BlockHash(); hash.String() != h.Hash {
+		return errorsmod.Wrapf(ErrInvalidHeader, "hash mismatch, expected %s, got %s", hash, h.Hash)
+	}
+
+	if h.Work.IsNil() {
+		return errorsmod.Wrap(ErrInvalidHeader, "nil work")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgInsertHeaders{}
+
+const (
+	// TypeMsgInsertHeaders defines the type string of a header relay message
+	TypeMsgInsertHeaders = "insert_headers"
+
+	// MaxHeadersPerMsg is the maximum number of headers relayed by a single message,
+	// i.e. one difficulty retarget period.
+	MaxHeadersPerMsg = 2016
+)
+
+// NewMsgInsertHeaders returns a new MsgInsertHeaders relaying the given headers.
+func NewMsgInsertHeaders(signer sdk.AccAddress, headers []*wire.BlockHeader) *MsgInsertHeaders {
+	msg := &MsgInsertHeaders{
+		Signer:  signer.String(),
+		Headers: make([][]byte, len(headers)),
+	}
+	for i, header := range headers {
+		msg.Headers[i] = SerializeHeader(header)
+	}
+	return msg
+}
+
+// Route returns the route value of a MsgInsertHeaders.
+func (m MsgInsertHeaders) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgInsertHeaders.
+func (m MsgInsertHeaders) Type() string { return TypeMsgInsertHeaders }
+
+// GetSigners returns the expected signers for a MsgInsertHeaders message.
+func (m MsgInsertHeaders) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgInsertHeaders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if len(m.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "no headers")
+	}
+
+	if len(m.Headers) > MaxHeadersPerMsg {
+		return errorsmod.Wrapf(ErrInvalidHeader, "too many headers, got %d, max %d", len(m.Headers), MaxHeadersPerMsg)
+	}
+
+	for i, bz := range m.Headers {
+		if _, err := ParseHeader(bz); err != nil {
+			return errorsmod.Wrapf(err, "header %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgInsertHeaders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// BlockHeaders decodes the relayed headers.
+func (m MsgInsertHeaders) BlockHeaders() ([]*wire.BlockHeader, error) {
+	headers := make([]*wire.BlockHeader, len(m.Headers))
+	for i, bz := range m.Headers {
+		header, err := ParseHeader(bz)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "header %d", i)
+		}
+		headers[i] = header
+	}
+	return headers, nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func TestMsgInsertHeaders_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	genesis := &chaincfg.RegressionNetParams.GenesisBlock.Header
+	headers := testutil.MineBTCHeaders(genesis, genesis.Bits, 2, 10*time.Minute)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgInsertHeaders
+		malleate func(msg *types.MsgInsertHeaders)
+		expPass  bool
+	}{
+		{"valid", types.NewMsgInsertHeaders(signer, headers), func(*types.MsgInsertHeaders) {}, true},
+		{"invalid signer", types.NewMsgInsertHeaders(signer, headers), func(msg *types.MsgInsertHeaders) { msg.Signer = "invalid" }, false},
+		{"no headers", types.NewMsgInsertHeaders(signer, nil), func(*types.MsgInsertHeaders) {}, false},
+		{
+			"too many headers",
+			types.NewMsgInsertHeaders(signer, headers),
+			func(msg *types.MsgInsertHeaders) {
+				msg.Headers = make([][]byte, types.MaxHeadersPerMsg+1)
+				for i := range msg.Headers {
+					msg.Headers[i] = types.SerializeHeader(genesis)
+				}
+			},
+			false,
+		},
+		{"short header", types.NewMsgInsertHeaders(signer, headers), func(msg *types.MsgInsertHeaders) { msg.Headers[1] = msg.Headers[1][1:] }, false},
+		{
+			"long header",
+			types.NewMsgInsertHeaders(signer, headers),
+			func(msg *types.MsgInsertHeaders) { msg.Headers[1] = append(msg.Headers[1], 0) },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.malleate(tc.msg)
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+
+			decoded, err := tc.msg.BlockHeaders()
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []*wire.BlockHeader{headers[0], headers[1]}, decoded, tc.name)
+			require.Equal(t, []sdk.AccAddress{signer}, tc.msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
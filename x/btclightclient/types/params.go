@@ -0,0 +1,74 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/btcsuite/btcd/chaincfg"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultNetwork is the Bitcoin main network
+var DefaultNetwork = chaincfg.MainNetParams.Name
+
+// NewParams creates a new Params instance
+func NewParams(network string) Params {
+	return Params{
+		Network: network,
+	}
+}
+
+// DefaultParams returns the default Bitcoin light client module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultNetwork)
+}
+
+// Validate performs basic validation on the module parameters.
+func (p Params) Validate() error {
+	_, err := NetworkParams(p.Network)
+	return err
+}
+
+// ChainParams returns the btcd chain parameters of the network.
+func (p Params) ChainParams() *chaincfg.Params {
+	params, err := NetworkParams(p.Network)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// BlocksPerRetarget returns the number of blocks between two difficulty retargets of a network.
+func BlocksPerRetarget(chainParams *chaincfg.Params) uint64 {
+	return uint64(chainParams.TargetTimespan / chainParams.TargetTimePerBlock)
+}
+
+// NetworkParams returns the btcd chain parameters of a Bitcoin network by name. The signet isn't
+// supported, its blocks are only valid with a signature of the signet challenge, which the light
+// client doesn't verify.
+func NetworkParams(network string) (*chaincfg.Params, error) {
+	for _, params := range []*chaincfg.Params{
+		&chaincfg.MainNetParams,
+		&chaincfg.TestNet3Params,
+		&chaincfg.RegressionNetParams,
+		&chaincfg.SimNetParams,
+	} {
+		if params.Name == network {
+			return params, nil
+		}
+	}
+	return nil, errorsmod.Wrapf(ErrInvalidNetwork, "%q", network)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxMerkleProofDepth is the maximum depth of a transaction merkle proof. A block can't contain
+// more than 2^32 transactions.
+const MaxMerkleProofDepth = 32
+
+// ParseTx decodes a serialized Bitcoin transaction, with or without witness data.
+func ParseTx(bz []byte) (*wire.MsgTx, error) {
+	// a 64 bytes transaction can be confused with an inner node of the merkle tree
+	if len(bz) == 64 {
+		return nil, errorsmod.Wrap(ErrInvalidTx, "64 bytes transactions are not supported")
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	reader := bytes.NewReader(bz)
+	if err := tx.Deserialize(reader); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidTx, err.Error())
+	}
+	if reader.Len() != 0 {
+		return nil, errorsmod.Wrapf(ErrInvalidTx, "%d trailing bytes", reader.Len())
+	}
+	return tx, nil
+}
+
+// ComputeMerkleRoot returns the merkle root committing to a transaction id given its merkle
+// branch, from the leaf level, and its index in the block.
+//
+// NOTE: the last node of an odd merkle tree level is paired with itself, so the last
+// transaction of a block may also be proven at a following index. The index must not be used
+// to identify a transaction, the transaction id must be used instead.
+func ComputeMerkleRoot(txid chainhash.Hash, branch []chainhash.Hash, index uint32) (chainhash.Hash, error) {
+	if len(branch) > MaxMerkleProofDepth {
+		return chainhash.Hash{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "depth %d exceeds the maximum %d", len(branch), MaxMerkleProofDepth)
+	}
+	if len(branch) < MaxMerkleProofDepth && uint64(index)>>len(branch) != 0 {
+		return chainhash.Hash{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "index %d out of range for depth %d", index, len(branch))
+	}
+
+	root := txid
+	for i, sibling := range branch {
+		if index>>i&1 == 1 {
+			root = *blockchain.HashMerkleBranches(&sibling, &root)
+		} else {
+			root = *blockchain.HashMerkleBranches(&root, &sibling)
+		}
+	}
+	return root, nil
+}
+
+// VerifyMerkleProof checks that a transaction id is committed at the given index by the merkle
+// root of a block header.
+func VerifyMerkleProof(txid chainhash.Hash, branch []chainhash.Hash, index uint32, header *wire.BlockHeader) error {
+	root, err := ComputeMerkleRoot(txid, branch, index)
+	if err != nil {
+		return err
+	}
+	if !root.IsEqual(&header.MerkleRoot) {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "computed root %s, expected %s", root, header.MerkleRoot)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/btclightclient/types"
+)
+
+func newTx(lockTime uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, [][]byte{{1, 2, 3}}))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	tx.LockTime = lockTime
+	return tx
+}
+
+func TestComputeMerkleRoot(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 5, 8, 13} {
+		txs := make([]*btcutil.Tx, count)
+		txids := make([]chainhash.Hash, count)
+		for i := range txs {
+			txs[i] = btcutil.NewTx(newTx(uint32(i)))
+			txids[i] = *txs[i].Hash()
+		}
+		merkles := blockchain.BuildMerkleTreeStore(txs, false)
+		root := *merkles[len(merkles)-1]
+
+		for i, txid := range txids {
+			branch := testutil.BTCMerkleBranch(txids, i)
+			computed, err := types.ComputeMerkleRoot(txid, branch, uint32(i))
+			require.NoError(t, err, "count %d index %d", count, i)
+			require.Equal(t, root, computed, "count %d index %d", count, i)
+
+			header := wire.BlockHeader{MerkleRoot: root}
+			require.NoError(t, types.VerifyMerkleProof(txid, branch, uint32(i), &header))
+
+			// the last transaction of an odd level is paired with itself, so it's also
+			// proven at the next index
+			if len(branch) > 0 && branch[0] != txid {
+				require.Error(t, types.VerifyMerkleProof(txid, branch, uint32(i^1), &header), "count %d index %d", count, i)
+			}
+		}
+	}
+
+	_, err := types.ComputeMerkleRoot(chainhash.Hash{}, make([]chainhash.Hash, 2), 4)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+
+	_, err = types.ComputeMerkleRoot(chainhash.Hash{}, make([]chainhash.Hash, types.MaxMerkleProofDepth+1), 0)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+}
+
+func TestParseTx(t *testing.T) {
+	tx := newTx(0)
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	parsed, err := types.ParseTx(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, tx.TxHash(), parsed.TxHash())
+	require.Equal(t, tx.WitnessHash(), parsed.WitnessHash())
+
+	_, err = types.ParseTx(append(buf.Bytes(), 0))
+	require.ErrorIs(t, err, types.ErrInvalidTx)
+
+	_, err = types.ParseTx(buf.Bytes()[:buf.Len()-1])
+	require.ErrorIs(t, err, types.ErrInvalidTx)
+
+	_, err = types.ParseTx(make([]byte, 64))
+	require.ErrorIs(t, err, types.ErrInvalidTx)
+}
@@ -0,0 +1,2395 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/btclightclient/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest defines the request type for querying x/btclightclient
+// parameters.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/btclightclient
+// parameters.
+type QueryParamsResponse struct {
+	// params define the module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryTipRequest defines the request type for querying the best chain tip.
+type QueryTipRequest struct {
+}
+
+func (m *QueryTipRequest) Reset()         { *m = QueryTipRequest{} }
+func (m *QueryTipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTipRequest) ProtoMessage()    {}
+func (*QueryTipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{2}
+}
+func (m *QueryTipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTipRequest.Merge(m, src)
+}
+func (m *QueryTipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTipRequest proto.InternalMessageInfo
+
+// QueryTipResponse defines the response type for querying the best chain tip.
+type QueryTipResponse struct {
+	// header is the header at the tip of the best chain.
+	Header BTCHeaderInfo `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+}
+
+func (m *QueryTipResponse) Reset()         { *m = QueryTipResponse{} }
+func (m *QueryTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTipResponse) ProtoMessage()    {}
+func (*QueryTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{3}
+}
+func (m *QueryTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTipResponse.Merge(m, src)
+}
+func (m *QueryTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTipResponse proto.InternalMessageInfo
+
+func (m *QueryTipResponse) GetHeader() BTCHeaderInfo {
+	if m != nil {
+		return m.Header
+	}
+	return BTCHeaderInfo{}
+}
+
+// QueryBaseHeaderRequest defines the request type for querying the checkpoint
+// header.
+type QueryBaseHeaderRequest struct {
+}
+
+func (m *QueryBaseHeaderRequest) Reset()         { *m = QueryBaseHeaderRequest{} }
+func (m *QueryBaseHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseHeaderRequest) ProtoMessage()    {}
+func (*QueryBaseHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{4}
+}
+func (m *QueryBaseHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseHeaderRequest.Merge(m, src)
+}
+func (m *QueryBaseHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseHeaderRequest proto.InternalMessageInfo
+
+// QueryBaseHeaderResponse defines the response type for querying the checkpoint
+// header.
+type QueryBaseHeaderResponse struct {
+	// header is the checkpoint header.
+	Header BTCHeaderInfo `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+}
+
+func (m *QueryBaseHeaderResponse) Reset()         { *m = QueryBaseHeaderResponse{} }
+func (m *QueryBaseHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseHeaderResponse) ProtoMessage()    {}
+func (*QueryBaseHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{5}
+}
+func (m *QueryBaseHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseHeaderResponse.Merge(m, src)
+}
+func (m *QueryBaseHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryBaseHeaderResponse) GetHeader() BTCHeaderInfo {
+	if m != nil {
+		return m.Header
+	}
+	return BTCHeaderInfo{}
+}
+
+// QueryHeaderByHeightRequest defines the request type for querying a header of
+// the best chain by height.
+type QueryHeaderByHeightRequest struct {
+	// height is the block height.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHeaderByHeightRequest) Reset()         { *m = QueryHeaderByHeightRequest{} }
+func (m *QueryHeaderByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderByHeightRequest) ProtoMessage()    {}
+func (*QueryHeaderByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{6}
+}
+func (m *QueryHeaderByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderByHeightRequest.Merge(m, src)
+}
+func (m *QueryHeaderByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderByHeightRequest proto.InternalMessageInfo
+
+func (m *QueryHeaderByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryHeaderByHeightResponse defines the response type for querying a header
+// of the best chain by height.
+type QueryHeaderByHeightResponse struct {
+	// header is the best chain header at the given height.
+	Header BTCHeaderInfo `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+}
+
+func (m *QueryHeaderByHeightResponse) Reset()         { *m = QueryHeaderByHeightResponse{} }
+func (m *QueryHeaderByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderByHeightResponse) ProtoMessage()    {}
+func (*QueryHeaderByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{7}
+}
+func (m *QueryHeaderByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderByHeightResponse.Merge(m, src)
+}
+func (m *QueryHeaderByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderByHeightResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderByHeightResponse) GetHeader() BTCHeaderInfo {
+	if m != nil {
+		return m.Header
+	}
+	return BTCHeaderInfo{}
+}
+
+// QueryHeaderByHashRequest defines the request type for querying a header by
+// hash.
+type QueryHeaderByHashRequest struct {
+	// hash is the block hash, hex encoded in the Bitcoin byte order.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryHeaderByHashRequest) Reset()         { *m = QueryHeaderByHashRequest{} }
+func (m *QueryHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderByHashRequest) ProtoMessage()    {}
+func (*QueryHeaderByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{8}
+}
+func (m *QueryHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderByHashRequest.Merge(m, src)
+}
+func (m *QueryHeaderByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderByHashRequest proto.InternalMessageInfo
+
+func (m *QueryHeaderByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryHeaderByHashResponse defines the response type for querying a header by
+// hash.
+type QueryHeaderByHashResponse struct {
+	// header is the header with the given hash.
+	Header BTCHeaderInfo `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	// main_chain is true if the header is part of the best chain.
+	MainChain bool `protobuf:"varint,2,opt,name=main_chain,json=mainChain,proto3" json:"main_chain,omitempty"`
+}
+
+func (m *QueryHeaderByHashResponse) Reset()         { *m = QueryHeaderByHashResponse{} }
+func (m *QueryHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderByHashResponse) ProtoMessage()    {}
+func (*QueryHeaderByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{9}
+}
+func (m *QueryHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderByHashResponse.Merge(m, src)
+}
+func (m *QueryHeaderByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderByHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderByHashResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderByHashResponse) GetHeader() BTCHeaderInfo {
+	if m != nil {
+		return m.Header
+	}
+	return BTCHeaderInfo{}
+}
+
+func (m *QueryHeaderByHashResponse) GetMainChain() bool {
+	if m != nil {
+		return m.MainChain
+	}
+	return false
+}
+
+// QueryConfirmationsRequest defines the request type for querying the
+// confirmations of a block.
+type QueryConfirmationsRequest struct {
+	// hash is the block hash, hex encoded in the Bitcoin byte order.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryConfirmationsRequest) Reset()         { *m = QueryConfirmationsRequest{} }
+func (m *QueryConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConfirmationsRequest) ProtoMessage()    {}
+func (*QueryConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{10}
+}
+func (m *QueryConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfirmationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfirmationsRequest.Merge(m, src)
+}
+func (m *QueryConfirmationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfirmationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfirmationsRequest proto.InternalMessageInfo
+
+func (m *QueryConfirmationsRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryConfirmationsResponse defines the response type for querying the
+// confirmations of a block.
+type QueryConfirmationsResponse struct {
+	// confirmations is the number of best chain blocks from the tip down to the
+	// block, included. It is zero for blocks out of the best chain.
+	Confirmations uint64 `protobuf:"varint,1,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *QueryConfirmationsResponse) Reset()         { *m = QueryConfirmationsResponse{} }
+func (m *QueryConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConfirmationsResponse) ProtoMessage()    {}
+func (*QueryConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab66fc7b7b440a4f, []int{11}
+}
+func (m *QueryConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfirmationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfirmationsResponse.Merge(m, src)
+}
+func (m *QueryConfirmationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfirmationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfirmationsResponse proto.InternalMessageInfo
+
+func (m *QueryConfirmationsResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.btclightclient.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.btclightclient.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTipRequest)(nil), "ethermint.btclightclient.v1.QueryTipRequest")
+	proto.RegisterType((*QueryTipResponse)(nil), "ethermint.btclightclient.v1.QueryTipResponse")
+	proto.RegisterType((*QueryBaseHeaderRequest)(nil), "ethermint.btclightclient.v1.QueryBaseHeaderRequest")
+	proto.RegisterType((*QueryBaseHeaderResponse)(nil), "ethermint.btclightclient.v1.QueryBaseHeaderResponse")
+	proto.RegisterType((*QueryHeaderByHeightRequest)(nil), "ethermint.btclightclient.v1.QueryHeaderByHeightRequest")
+	proto.RegisterType((*QueryHeaderByHeightResponse)(nil), "ethermint.btclightclient.v1.QueryHeaderByHeightResponse")
+	proto.RegisterType((*QueryHeaderByHashRequest)(nil), "ethermint.btclightclient.v1.QueryHeaderByHashRequest")
+	proto.RegisterType((*QueryHeaderByHashResponse)(nil), "ethermint.btclightclient.v1.QueryHeaderByHashResponse")
+	proto.RegisterType((*QueryConfirmationsRequest)(nil), "ethermint.btclightclient.v1.QueryConfirmationsRequest")
+	proto.RegisterType((*QueryConfirmationsResponse)(nil), "ethermint.btclightclient.v1.QueryConfirmationsResponse")
+}
+
+func init() {
+	proto.RegisterFile("ethermint/btclightclient/v1/query.proto", fileDescriptor_ab66fc7b7b440a4f)
+}
+
+var fileDescriptor_ab66fc7b7b440a4f = []byte{
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfc, 0xf8, 0x35, 0xf0, 0x28, 0xfe, 0x19, 0x09, 0xd6, 0x45, 0x57, 0xb2, 0x68,
+	0x6c, 0x50, 0x77, 0x5a, 0x40, 0xc0, 0xc4, 0x8b, 0xcb, 0x05, 0xe3, 0x45, 0x1b, 0x0e, 0xc6, 0x98,
+	0x90, 0x69, 0x1d, 0x76, 0x37, 0x61, 0x77, 0x96, 0x9d, 0x85, 0x48, 0x08, 0x17, 0xe3, 0xd1, 0x83,
+	0x89, 0x17, 0x7d, 0x09, 0xbe, 0x02, 0x0f, 0x9e, 0xbc, 0x71, 0x24, 0xf1, 0xe2, 0xc9, 0x18, 0xea,
+	0x0b, 0x31, 0x3b, 0x33, 0xb5, 0x5d, 0xda, 0x6c, 0xdb, 0xa4, 0x97, 0xed, 0xf4, 0x99, 0xe7, 0xfb,
+	0x7c, 0x3f, 0x9b, 0xce, 0x77, 0x0a, 0x77, 0x58, 0xe2, 0xb1, 0x38, 0xf0, 0xc3, 0x84, 0xd4, 0x93,
+	0xc6, 0x8e, 0xef, 0x7a, 0xe9, 0x93, 0x85, 0x09, 0xd9, 0xaf, 0x92, 0xdd, 0x3d, 0x16, 0x1f, 0xd8,
+	0x51, 0xcc, 0x13, 0x8e, 0x67, 0xff, 0x35, 0xda, 0xd9, 0x46, 0x7b, 0xbf, 0x6a, 0x54, 0xf2, 0xa6,
+	0x9c, 0x69, 0x97, 0xe3, 0x8c, 0x69, 0x97, 0xbb, 0x5c, 0x2e, 0x49, 0xba, 0xd2, 0xd5, 0xeb, 0x2e,
+	0xe7, 0xee, 0x0e, 0x23, 0x34, 0xf2, 0x09, 0x0d, 0x43, 0x9e, 0xd0, 0xc4, 0xe7, 0xa1, 0x50, 0xbb,
+	0xd6, 0x34, 0xe0, 0xe7, 0x29, 0xd1, 0x33, 0x1a, 0xd3, 0x40, 0xd4, 0xd8, 0xee, 0x1e, 0x13, 0x89,
+	0xf5, 0x02, 0xae, 0x64, 0xaa, 0x22, 0xe2, 0xa1, 0x60, 0xf8, 0x31, 0x14, 0x23, 0x59, 0x29, 0xa1,
+	0x39, 0x54, 0x3e, 0xb7, 0x38, 0x6f, 0xe7, 0xbc, 0x80, 0xad, 0xc4, 0xce, 0xf8, 0xf1, 0xaf, 0x9b,
+	0x85, 0x9a, 0x16, 0x5a, 0x97, 0xe1, 0xa2, 0x9c, 0xbc, 0xe9, 0x47, 0x2d, 0xb3, 0x57, 0x70, 0xa9,
+	0x5d, 0xd2, 0x4e, 0x1b, 0x50, 0xf4, 0x18, 0x7d, 0xcd, 0x62, 0xed, 0xb4, 0x90, 0xeb, 0xe4, 0x6c,
+	0xae, 0x6f, 0xc8, 0xee, 0x27, 0xe1, 0x36, 0x6f, 0x19, 0x2a, 0xbd, 0x55, 0x82, 0x19, 0x39, 0xdd,
+	0xa1, 0x82, 0xa9, 0xa6, 0x96, 0x6f, 0x03, 0xae, 0x76, 0xed, 0x8c, 0xdc, 0x7e, 0x19, 0x0c, 0x69,
+	0xa2, 0x1a, 0x9c, 0x83, 0x0d, 0x96, 0x6a, 0x35, 0x02, 0x9e, 0x49, 0x7d, 0xd2, 0x82, 0xf4, 0x19,
+	0xaf, 0xe9, 0x6f, 0x96, 0x0b, 0xb3, 0x3d, 0x55, 0x23, 0xc7, 0xb3, 0xa1, 0x94, 0x35, 0xa2, 0xc2,
+	0x6b, 0xc1, 0x61, 0x18, 0xf7, 0xa8, 0xf0, 0xa4, 0xc7, 0x64, 0x4d, 0xae, 0xad, 0x77, 0x08, 0xae,
+	0xf5, 0x10, 0x8c, 0x9a, 0x0b, 0xdf, 0x00, 0x08, 0xa8, 0x1f, 0x6e, 0x35, 0x3c, 0xea, 0x87, 0xa5,
+	0xb1, 0x39, 0x54, 0x9e, 0xa8, 0x4d, 0xa6, 0x95, 0xf5, 0xb4, 0x60, 0x11, 0x4d, 0xb1, 0xce, 0xc3,
+	0x6d, 0x3f, 0x0e, 0xd4, 0x89, 0xce, 0xe3, 0x76, 0xc0, 0xe8, 0x25, 0xd0, 0xdc, 0xb7, 0x60, 0xaa,
+	0xd1, 0xb9, 0xa1, 0x7f, 0x8d, 0x6c, 0x71, 0xf1, 0xf3, 0x04, 0xfc, 0x2f, 0x87, 0xe0, 0x4f, 0x08,
+	0x8a, 0xea, 0x74, 0x63, 0x92, 0xfb, 0x8a, 0xdd, 0xd1, 0x32, 0x2a, 0x83, 0x0b, 0x14, 0x9d, 0x75,
+	0xf7, 0xed, 0x8f, 0x3f, 0x1f, 0xc7, 0x6e, 0xe3, 0x79, 0x92, 0x77, 0x23, 0xa8, 0x7c, 0xe1, 0xf7,
+	0x08, 0xfe, 0xdb, 0xf4, 0x23, 0x7c, 0xaf, 0xbf, 0x4d, 0x3b, 0x82, 0xc6, 0xfd, 0x01, 0xbb, 0x35,
+	0x51, 0x59, 0x12, 0x59, 0x78, 0x2e, 0x97, 0x28, 0xf1, 0x23, 0xfc, 0x05, 0x01, 0xb4, 0xf3, 0x85,
+	0x97, 0xfa, 0xfb, 0x74, 0xe5, 0xd4, 0x58, 0x1e, 0x4e, 0xa4, 0x19, 0x2b, 0x92, 0x71, 0x01, 0x97,
+	0x73, 0x19, 0xeb, 0x54, 0xb0, 0x2d, 0x7d, 0xe6, 0xbe, 0x23, 0xb8, 0x90, 0x0d, 0x1c, 0x5e, 0xed,
+	0x6f, 0xdd, 0x33, 0xd8, 0xc6, 0xda, 0xf0, 0x42, 0xcd, 0xfd, 0x48, 0x72, 0xaf, 0xe0, 0xe5, 0x5c,
+	0x6e, 0x85, 0x2c, 0x88, 0xba, 0x2f, 0xc8, 0xa1, 0xfa, 0x3c, 0xc2, 0x5f, 0x11, 0x9c, 0xef, 0x8c,
+	0x26, 0x7e, 0x30, 0x04, 0x48, 0x3b, 0xfb, 0xc6, 0xca, 0xb0, 0x32, 0x4d, 0xbf, 0x26, 0xe9, 0x17,
+	0x71, 0x65, 0x30, 0x7a, 0x2a, 0x3c, 0x72, 0x98, 0x3e, 0x8f, 0xf0, 0x37, 0x04, 0x53, 0x99, 0x74,
+	0xe2, 0x01, 0x18, 0x7a, 0xe5, 0xdf, 0x58, 0x1d, 0x5a, 0xa7, 0xe1, 0x1f, 0x4a, 0xf8, 0x25, 0x5c,
+	0xcd, 0x85, 0xcf, 0x5c, 0x0a, 0x9a, 0xde, 0x79, 0x7a, 0x7c, 0x6a, 0xa2, 0x93, 0x53, 0x13, 0xfd,
+	0x3e, 0x35, 0xd1, 0x87, 0xa6, 0x59, 0x38, 0x69, 0x9a, 0x85, 0x9f, 0x4d, 0xb3, 0xf0, 0xb2, 0xea,
+	0xfa, 0x89, 0xb7, 0x57, 0xb7, 0x1b, 0x3c, 0x20, 0x6c, 0x3f, 0xe0, 0xa2, 0x63, 0xf8, 0x9b, 0xb3,
+	0xe3, 0x93, 0x83, 0x88, 0x89, 0x7a, 0x51, 0xfe, 0x35, 0x2f, 0xfd, 0x1d, 0x00, 0x67, 0x81, 0x5d,
+	0x64, 0x48, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/btclightclient module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Tip queries the header at the tip of the best chain.
+	Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error)
+	// BaseHeader queries the checkpoint header the light client starts from.
+	BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error)
+	// HeaderByHeight queries the header of the best chain at a given height.
+	HeaderByHeight(ctx context.Context, in *QueryHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryHeaderByHeightResponse, error)
+	// HeaderByHash queries a header, of the best chain or of a fork, by hash.
+	HeaderByHash(ctx context.Context, in *QueryHeaderByHashRequest, opts ...grpc.CallOption) (*QueryHeaderByHashResponse, error)
+	// Confirmations queries the number of confirmations of a block.
+	Confirmations(ctx context.Context, in *QueryConfirmationsRequest, opts ...grpc.CallOption) (*QueryConfirmationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error) {
+	out := new(QueryTipResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/Tip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error) {
+	out := new(QueryBaseHeaderResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/BaseHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeaderByHeight(ctx context.Context, in *QueryHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryHeaderByHeightResponse, error) {
+	out := new(QueryHeaderByHeightResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/HeaderByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeaderByHash(ctx context.Context, in *QueryHeaderByHashRequest, opts ...grpc.CallOption) (*QueryHeaderByHashResponse, error) {
+	out := new(QueryHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/HeaderByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Confirmations(ctx context.Context, in *QueryConfirmationsRequest, opts ...grpc.CallOption) (*QueryConfirmationsResponse, error) {
+	out := new(QueryConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.btclightclient.v1.Query/Confirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/btclightclient module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Tip queries the header at the tip of the best chain.
+	Tip(context.Context, *QueryTipRequest) (*QueryTipResponse, error)
+	// BaseHeader queries the checkpoint header the light client starts from.
+	BaseHeader(context.Context, *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error)
+	// HeaderByHeight queries the header of the best chain at a given height.
+	HeaderByHeight(context.Context, *QueryHeaderByHeightRequest) (*QueryHeaderByHeightResponse, error)
+	// HeaderByHash queries a header, of the best chain or of a fork, by hash.
+	HeaderByHash(context.Context, *QueryHeaderByHashRequest) (*QueryHeaderByHashResponse, error)
+	// Confirmations queries the number of confirmations of a block.
+	Confirmations(context.Context, *QueryConfirmationsRequest) (*QueryConfirmationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Tip(ctx context.Context, req *QueryTipRequest) (*QueryTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tip not implemented")
+}
+func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseHeader not implemented")
+}
+func (*UnimplementedQueryServer) HeaderByHeight(ctx context.Context, req *QueryHeaderByHeightRequest) (*QueryHeaderByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderByHeight not implemented")
+}
+func (*UnimplementedQueryServer) HeaderByHash(ctx context.Context, req *QueryHeaderByHashRequest) (*QueryHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderByHash not implemented")
+}
+func (*UnimplementedQueryServer) Confirmations(ctx context.Context, req *QueryConfirmationsRequest) (*QueryConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Confirmations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/Tip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tip(ctx, req.(*QueryTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/BaseHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseHeader(ctx, req.(*QueryBaseHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/HeaderByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderByHeight(ctx, req.(*QueryHeaderByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/HeaderByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderByHash(ctx, req.(*QueryHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Confirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Confirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.btclightclient.v1.Query/Confirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Confirmations(ctx, req.(*QueryConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.btclightclient.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Tip",
+			Handler:    _Query_Tip_Handler,
+		},
+		{
+			MethodName: "BaseHeader",
+			Handler:    _Query_BaseHeader_Handler,
+		},
+		{
+			MethodName: "HeaderByHeight",
+			Handler:    _Query_HeaderByHeight_Handler,
+		},
+		{
+			MethodName: "HeaderByHash",
+			Handler:    _Query_HeaderByHash_Handler,
+		},
+		{
+			MethodName: "Confirmations",
+			Handler:    _Query_Confirmations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ethermint/btclightclient/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MainChain {
+		i--
+		if m.MainChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeaderByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHeaderByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MainChain {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		n += 1 + sovQuery(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MainChain = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,295 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// parseMethodSig parses a human readable method signature such as
+// "transfer(address,uint256)" or "balanceOf(address)(uint256)" into an ABI
+// method. The optional second parameter list defines the return types.
+func parseMethodSig(sig string) (abi.Method, error) {
+	sig = strings.TrimSpace(sig)
+
+	open := strings.Index(sig, "(")
+	if open <= 0 {
+		return abi.Method{}, fmt.Errorf("invalid method signature %q, expected name(types...)", sig)
+	}
+	name := sig[:open]
+
+	inputs, rest, err := parseArgList(sig[open:])
+	if err != nil {
+		return abi.Method{}, fmt.Errorf("invalid method signature %q: %w", sig, err)
+	}
+
+	var outputs abi.Arguments
+	if rest != "" {
+		outputs, rest, err = parseArgList(rest)
+		if err != nil {
+			return abi.Method{}, fmt.Errorf("invalid method signature %q: %w", sig, err)
+		}
+		if rest != "" {
+			return abi.Method{}, fmt.Errorf("invalid method signature %q: unexpected %q", sig, rest)
+		}
+	}
+
+	return abi.NewMethod(name, name, abi.Function, "", false, false, inputs, outputs), nil
+}
+
+// encodeCall returns the calldata of a call to the method with the given
+// signature, together with the parsed method.
+func encodeCall(sig string, values []string) ([]byte, abi.Method, error) {
+	method, err := parseMethodSig(sig)
+	if err != nil {
+		return nil, abi.Method{}, err
+	}
+
+	packed, err := packArgs(method.Inputs, values)
+	if err != nil {
+		return nil, abi.Method{}, err
+	}
+
+	data := make([]byte, 0, len(method.ID)+len(packed))
+	data = append(data, method.ID...)
+	data = append(data, packed...)
+	return data, method, nil
+}
+
+// parseArgList parses a parenthesized, comma separated list of ABI types at
+// the start of s and returns the remaining input. Tuple types are not
+// supported.
+func parseArgList(s string) (abi.Arguments, string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, "", fmt.Errorf("expected '(' at %q", s)
+	}
+
+	end := strings.Index(s, ")")
+	if end < 0 {
+		return nil, "", fmt.Errorf("missing ')' in %q", s)
+	}
+	if strings.Contains(s[1:end], "(") {
+		return nil, "", errTupleArg(s)
+	}
+
+	list := strings.TrimSpace(s[1:end])
+	rest := strings.TrimSpace(s[end+1:])
+	if list == "" {
+		return abi.Arguments{}, rest, nil
+	}
+
+	var args abi.Arguments
+	for _, typ := range strings.Split(list, ",") {
+		typ = strings.TrimSpace(typ)
+		if strings.HasPrefix(typ, "tuple") {
+			return nil, "", errTupleArg(s)
+		}
+		// canonicalize the integer aliases
+		switch typ {
+		case "uint":
+			typ = "uint256"
+		case "int":
+			typ = "int256"
+		}
+
+		t, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, abi.Argument{Type: t})
+	}
+
+	return args, rest, nil
+}
+
+// errTupleArg returns the error for a tuple type in the argument list s.
+func errTupleArg(s string) error {
+	return fmt.Errorf("unsupported tuple type in %q, only elementary types are supported", s)
+}
+
+// packArgs converts the command line values to the given ABI types and packs them.
+func packArgs(args abi.Arguments, values []string) ([]byte, error) {
+	if len(args) != len(values) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(args), len(values))
+	}
+
+	converted := make([]interface{}, len(values))
+	for i, value := range values {
+		v, err := convertArg(args[i].Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		converted[i] = v
+	}
+
+	return args.Pack(converted...)
+}
+
+// convertArg converts a command line value into the Go type expected by the
+// ABI encoder for t. Only elementary types are supported.
+func convertArg(t abi.Type, value string) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("%s is not a valid hex address", value)
+		}
+		return common.HexToAddress(value), nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a valid integer", value)
+		}
+
+		goType := t.GetType()
+		if goType == reflect.TypeOf(&big.Int{}) {
+			return n, nil
+		}
+
+		v := reflect.New(goType).Elem()
+		if t.T == abi.UintTy {
+			if !n.IsUint64() || v.OverflowUint(n.Uint64()) {
+				return nil, fmt.Errorf("%s overflows %s", value, t)
+			}
+			v.SetUint(n.Uint64())
+		} else {
+			if !n.IsInt64() || v.OverflowInt(n.Int64()) {
+				return nil, fmt.Errorf("%s overflows %s", value, t)
+			}
+			v.SetInt(n.Int64())
+		}
+		return v.Interface(), nil
+	case abi.BoolTy:
+		return strconv.ParseBool(value)
+	case abi.StringTy:
+		return value, nil
+	case abi.BytesTy:
+		return hexutil.Decode(value)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) > t.Size {
+			return nil, fmt.Errorf("%s overflows %s", value, t)
+		}
+		v := reflect.New(t.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(bz))
+		return v.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", t)
+	}
+}
+
+// formatValue returns the command line representation of a decoded ABI value.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		bz := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(bz), rv)
+		return hexutil.Encode(bz)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// artifact is the subset of the Hardhat and Foundry artifact formats needed
+// to deploy a contract.
+type artifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode json.RawMessage `json:"bytecode"`
+}
+
+// loadContract returns the creation bytecode and, when available, the ABI of a
+// contract. The source is either a hex encoded bytecode or the path of a
+// Hardhat, Foundry or ethermint compiled contract JSON artifact.
+func loadContract(source string) ([]byte, *abi.ABI, error) {
+	if _, err := os.Stat(source); err != nil {
+		bytecode, err := hexutil.Decode(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is neither a contract artifact nor hex encoded bytecode", source)
+		}
+		return bytecode, nil, nil
+	}
+
+	bz, err := os.ReadFile(filepath.Clean(source))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var a artifact
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal contract artifact: %w", err)
+	}
+
+	if len(a.Bytecode) == 0 {
+		// ethermint compiled contract format
+		var contract types.CompiledContract
+		if err := json.Unmarshal(bz, &contract); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal contract artifact: %w", err)
+		}
+		return contract.Bin, &contract.ABI, nil
+	}
+
+	// the bytecode is either a hex string (Hardhat) or an object (Foundry)
+	var bytecodeHex string
+	if err := json.Unmarshal(a.Bytecode, &bytecodeHex); err != nil {
+		var object struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(a.Bytecode, &object); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal contract bytecode: %w", err)
+		}
+		bytecodeHex = object.Object
+	}
+
+	if !strings.HasPrefix(bytecodeHex, "0x") {
+		bytecodeHex = "0x" + bytecodeHex
+	}
+
+	bytecode, err := hexutil.Decode(bytecodeHex)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode contract bytecode: %w", err)
+	}
+
+	var contractABI abi.ABI
+	if err := json.Unmarshal(a.ABI, &contractABI); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal contract ABI: %w", err)
+	}
+
+	return bytecode, &contractABI, nil
+}
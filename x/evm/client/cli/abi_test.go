@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestParseMethodSig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sig        string
+		expSig     string
+		expOutputs int
+		expectErr  bool
+	}{
+		{"no arguments", "totalSupply()", "totalSupply()", 0, false},
+		{"with arguments", "transfer(address,uint256)", "transfer(address,uint256)", 0, false},
+		{"with outputs", "balanceOf(address)(uint256)", "balanceOf(address)", 1, false},
+		{"integer aliases", "foo(uint, int)", "foo(uint256,int256)", 0, false},
+		{"missing name", "(address)", "", 0, true},
+		{"missing parenthesis", "transfer", "", 0, true},
+		{"unknown type", "foo(notatype)", "", 0, true},
+		{"trailing input", "foo()bar", "", 0, true},
+		{"tuple argument", "foo((uint256,address),uint256)", "", 0, true},
+		{"tuple output", "foo()(tuple)", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, err := parseMethodSig(tc.sig)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSig, method.Sig)
+			require.Len(t, method.Outputs, tc.expOutputs)
+		})
+	}
+}
+
+func TestEncodeCall(t *testing.T) {
+	to := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+
+	data, _, err := encodeCall("transfer(address,uint256)", []string{to.Hex(), "1000"})
+	require.NoError(t, err)
+
+	expData, err := types.ERC20Contract.ABI.Pack("transfer", to, big.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, expData, data)
+
+	_, _, err = encodeCall("transfer(address,uint256)", []string{to.Hex()})
+	require.Error(t, err)
+}
+
+func TestConvertArg(t *testing.T) {
+	testCases := []struct {
+		name      string
+		sig       string
+		value     string
+		expValue  interface{}
+		expectErr bool
+	}{
+		{"address", "f(address)", "0x3B98c72760f7BBa69D62ED6f48278451251948e7", common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7"), false},
+		{"invalid address", "f(address)", "0x3B98", nil, true},
+		{"uint8", "f(uint8)", "255", uint8(255), false},
+		{"uint8 overflow", "f(uint8)", "256", nil, true},
+		{"negative uint", "f(uint64)", "-1", nil, true},
+		{"int32 hex", "f(int32)", "0x10", int32(16), false},
+		{"uint256", "f(uint256)", "1000", big.NewInt(1000), false},
+		{"bool", "f(bool)", "true", true, false},
+		{"string", "f(string)", "hello", "hello", false},
+		{"bytes", "f(bytes)", "0x0102", []byte{1, 2}, false},
+		{"bytes2", "f(bytes2)", "0x0102", [2]byte{1, 2}, false},
+		{"bytes2 overflow", "f(bytes2)", "0x010203", nil, true},
+		{"unsupported", "f(uint256[])", "1", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, err := parseMethodSig(tc.sig)
+			require.NoError(t, err)
+
+			value, err := convertArg(method.Inputs[0].Type, tc.value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expValue, value)
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	require.Equal(t, "0x0102", formatValue([]byte{1, 2}))
+	require.Equal(t, "0x0102", formatValue([2]byte{1, 2}))
+	require.Equal(t, "1000", formatValue(big.NewInt(1000)))
+	require.Equal(t, "true", formatValue(true))
+	require.Equal(t, "0x3B98c72760f7BBa69D62ED6f48278451251948e7", formatValue(common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")))
+}
+
+func TestLoadContract(t *testing.T) {
+	dir := t.TempDir()
+
+	abiJSON := []byte(`[{"type":"function","name":"transfer","stateMutability":"nonpayable",` +
+		`"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`)
+
+	hardhat := filepath.Join(dir, "hardhat.json")
+	bz, err := json.Marshal(map[string]interface{}{
+		"abi":      json.RawMessage(abiJSON),
+		"bytecode": "0x6080",
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(hardhat, bz, 0o600))
+
+	foundry := filepath.Join(dir, "foundry.json")
+	bz, err = json.Marshal(map[string]interface{}{
+		"abi":      json.RawMessage(abiJSON),
+		"bytecode": map[string]string{"object": "0x6080"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(foundry, bz, 0o600))
+
+	compiled := filepath.Join(dir, "compiled.json")
+	bz, err = json.Marshal(map[string]interface{}{
+		"abi": string(abiJSON),
+		"bin": "6080",
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(compiled, bz, 0o600))
+
+	for _, path := range []string{hardhat, foundry, compiled} {
+		bytecode, contractABI, err := loadContract(path)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x60, 0x80}, bytecode)
+		require.NotNil(t, contractABI)
+		require.Contains(t, contractABI.Methods, "transfer")
+	}
+
+	bytecode, contractABI, err := loadContract("0x6080")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80}, bytecode)
+	require.Nil(t, contractABI)
+
+	_, _, err = loadContract("not-a-contract")
+	require.Error(t, err)
+}
@@ -16,12 +16,21 @@
 package cli
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -39,6 +48,7 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetCallCmd(),
 	)
 	return cmd
 }
@@ -147,3 +157,112 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCallCmd executes a read-only call of a contract method
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD_SIG [ARGS...]",
+		Short: "Call a contract method without sending a transaction",
+		Long: `Call a contract method without sending a transaction, using eth_call. The method is given
+by its signature followed by its arguments. Return types can be appended to the signature,
+e.g. "balanceOf(address)(uint256)", to decode the output; otherwise the raw output is printed
+in hex. Tuple types are not supported. If the height is not provided, it will use the latest
+height from context.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			to, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			data, method, err := encodeCall(args[1], args[2:])
+			if err != nil {
+				return err
+			}
+
+			value, err := valueFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr := common.HexToAddress(to)
+			input := hexutil.Bytes(data)
+			callArgs := types.TransactionArgs{
+				To:    &toAddr,
+				Value: (*hexutil.Big)(value),
+				Input: &input,
+			}
+
+			fromStr, err := cmd.Flags().GetString(flagFrom)
+			if err != nil {
+				return err
+			}
+			if fromStr != "" {
+				from, err := accountToHex(fromStr)
+				if err != nil {
+					return err
+				}
+				fromAddr := common.HexToAddress(from)
+				callArgs.From = &fromAddr
+			}
+
+			bz, err := json.Marshal(&callArgs)
+			if err != nil {
+				return err
+			}
+
+			req := &types.EthCallRequest{
+				Args:   bz,
+				GasCap: defaultGasCap,
+			}
+			if clientCtx.ChainID != "" {
+				chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+				if err != nil {
+					return err
+				}
+				req.ChainId = chainID.Int64()
+			}
+
+			res, err := queryClient.EthCall(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			if res.Failed() {
+				if res.VmError == vm.ErrExecutionReverted.Error() {
+					return types.NewExecErrorWithReason(res.Ret)
+				}
+				return errors.New(res.VmError)
+			}
+
+			if len(method.Outputs) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", hexutil.Encode(res.Ret)))
+			}
+
+			values, err := method.Outputs.Unpack(res.Ret)
+			if err != nil {
+				return fmt.Errorf("failed to decode output: %w", err)
+			}
+
+			var out strings.Builder
+			for _, v := range values {
+				out.WriteString(formatValue(v))
+				out.WriteString("\n")
+			}
+
+			return clientCtx.PrintString(out.String())
+		},
+	}
+
+	cmd.Flags().String(flagValue, "0", "Amount of the evm denom to transfer with the call")
+	cmd.Flags().String(flagFrom, "", "Address the call is sent from")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
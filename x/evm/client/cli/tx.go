@@ -17,20 +17,36 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
+const (
+	flagValue = "value"
+	flagFrom  = "from"
+
+	// defaultGasCap is the gas cap used for eth_call and gas estimation, it
+	// matches the default json-rpc gas cap.
+	defaultGasCap uint64 = 25000000
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,7 +56,12 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSendTxCmd(),
+		NewDeployTxCmd(),
+		NewCallTxCmd(),
+	)
 	return cmd
 }
 
@@ -75,52 +96,279 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			return broadcastEthTx(clientCtx, msg, rsp.Params.EvmDenom)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSendTxCmd command signs and broadcasts an ethereum transaction transferring
+// the EVM denom to an address
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send TO_ADDRESS AMOUNT",
+		Short: "Sign and broadcast an ethereum transaction sending AMOUNT of the evm denom to TO_ADDRESS",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := accountToHex(args[0])
 			if err != nil {
 				return err
 			}
 
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			amount, ok := new(big.Int).SetString(args[1], 10)
+			if !ok || amount.Sign() < 0 {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			toAddr := common.HexToAddress(to)
+			return sendEthTx(cmd, &toAddr, amount, nil)
+		},
+	}
 
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeployTxCmd command signs and broadcasts an ethereum contract creation transaction
+func NewDeployTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy BYTECODE_OR_ARTIFACT [CONSTRUCTOR_ARGS...]",
+		Short: "Sign and broadcast an ethereum transaction deploying a contract",
+		Long: `Sign and broadcast an ethereum transaction deploying a contract. The contract is either
+hex encoded creation bytecode or the path of a Hardhat, Foundry or ethermint compiled contract
+JSON artifact. Constructor arguments require an artifact, as they are encoded with its ABI.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bytecode, contractABI, err := loadContract(args[0])
+			if err != nil {
+				return err
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			ctorArgs := args[1:]
+			if len(ctorArgs) > 0 && contractABI == nil {
+				return errors.New("constructor arguments require a contract artifact with an ABI")
+			}
+
+			data := bytecode
+			if contractABI != nil {
+				packed, err := packArgs(contractABI.Constructor.Inputs, ctorArgs)
 				if err != nil {
-					return err
+					return errors.Wrap(err, "failed to encode constructor arguments")
 				}
+				data = append(data, packed...)
+			}
 
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+			value, err := valueFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return sendEthTx(cmd, nil, value, data)
+		},
+	}
 
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+	cmd.Flags().String(flagValue, "0", "Amount of the evm denom to transfer with the transaction")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
+// NewCallTxCmd command signs and broadcasts an ethereum transaction calling a contract method
+func NewCallTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD_SIG [ARGS...]",
+		Short: "Sign and broadcast an ethereum transaction calling a contract method",
+		Long: `Sign and broadcast an ethereum transaction calling a contract method. The method is given
+by its signature, e.g. "transfer(address,uint256)", followed by its arguments. Tuple types are
+not supported.`,
+		Example: fmt.Sprintf(
+			"%s tx evm call 0x... \"transfer(address,uint256)\" 0x... 100 --from mykey",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := accountToHex(args[0])
+			if err != nil {
+				return err
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			data, _, err := encodeCall(args[1], args[2:])
 			if err != nil {
 				return err
 			}
 
-			// broadcast to a Tendermint node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			value, err := valueFromFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			toAddr := common.HexToAddress(to)
+			return sendEthTx(cmd, &toAddr, value, data)
 		},
 	}
 
+	cmd.Flags().String(flagValue, "0", "Amount of the evm denom to transfer with the transaction")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// sendEthTx builds an ethereum transaction from the tx flags, signs it with the
+// eth_secp256k1 key of the --from account and broadcasts it. The nonce, gas
+// limit (with --gas=auto) and fees are queried from the node unless set by flags.
+func sendEthTx(cmd *cobra.Command, to *common.Address, value *big.Int, data []byte) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	queryClient := types.NewQueryClient(clientCtx)
+	from := common.BytesToAddress(clientCtx.GetFromAddress())
+
+	paramsRes, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	evmDenom := paramsRes.Params.EvmDenom
+
+	nonce := txf.Sequence()
+	if !cmd.Flags().Changed(flags.FlagSequence) {
+		accRes, err := queryClient.CosmosAccount(ctx, &types.QueryCosmosAccountRequest{Address: from.Hex()})
+		if err != nil {
+			return err
+		}
+		nonce = accRes.Sequence
+	}
+
+	var gasPrice, gasFeeCap, gasTipCap *big.Int
+	if gasPrices := txf.GasPrices(); !gasPrices.IsZero() {
+		gasPrice = gasPrices.AmountOf(evmDenom).TruncateInt().BigInt()
+	} else {
+		baseFeeRes, err := queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+		if err != nil {
+			return err
+		}
+
+		if baseFeeRes.BaseFee != nil {
+			// leave room for the base fee to increase before the tx is included
+			gasFeeCap = new(big.Int).Mul(baseFeeRes.BaseFee.BigInt(), big.NewInt(2))
+			gasTipCap = big.NewInt(0)
+		} else {
+			gasPrice = big.NewInt(0)
+		}
+	}
+
+	gasLimit := txf.Gas()
+	if txf.SimulateAndExecute() {
+		input := hexutil.Bytes(data)
+		args, err := json.Marshal(&types.TransactionArgs{
+			From:  &from,
+			To:    to,
+			Value: (*hexutil.Big)(value),
+			Input: &input,
+		})
+		if err != nil {
+			return err
+		}
+
+		gasRes, err := queryClient.EstimateGas(ctx, &types.EthCallRequest{
+			Args:    args,
+			GasCap:  defaultGasCap,
+			ChainId: chainID.Int64(),
+		})
+		if err != nil {
+			return err
+		}
+
+		gasLimit = uint64(txf.GasAdjustment() * float64(gasRes.Gas))
+	}
+
+	var msg *types.MsgEthereumTx
+	if to == nil {
+		msg = types.NewTxContract(chainID, nonce, value, gasLimit, gasPrice, gasFeeCap, gasTipCap, data, nil)
+	} else {
+		msg = types.NewTx(chainID, nonce, to, value, gasLimit, gasPrice, gasFeeCap, gasTipCap, data, nil)
+	}
+	msg.From = from.Hex()
+
+	if err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), clientCtx.Keyring); err != nil {
+		return errors.Wrap(err, "failed to sign ethereum transaction")
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return broadcastEthTx(clientCtx, msg, evmDenom)
+}
+
+// broadcastEthTx wraps a signed ethereum transaction in a cosmos transaction
+// and broadcasts it, or prints it with --generate-only.
+func broadcastEthTx(clientCtx client.Context, msg *types.MsgEthereumTx, evmDenom string) error {
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm signed transaction before broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// valueFromFlags returns the amount of the evm denom set with --value
+func valueFromFlags(cmd *cobra.Command) (*big.Int, error) {
+	valueStr, err := cmd.Flags().GetString(flagValue)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := new(big.Int).SetString(valueStr, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %s", valueStr)
+	}
+
+	return value, nil
+}
@@ -18,7 +18,9 @@ package evm
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -83,6 +85,11 @@ func InitGenesis(
 		}
 	}
 
+	// the fractional balances reserve is part of the bank genesis state
+	for _, balance := range data.FractionalBalances {
+		k.SetFractionalBalance(ctx, common.HexToAddress(balance.Address), balance.Amount.BigInt())
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,8 +117,18 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var fractionalBalances []types.FractionalBalance
+	k.IterateFractionalBalances(ctx, func(addr common.Address, amount *big.Int) bool {
+		fractionalBalances = append(fractionalBalances, types.FractionalBalance{
+			Address: addr.String(),
+			Amount:  sdkmath.NewIntFromBigInt(amount),
+		})
+		return false
+	})
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:           ethGenAccounts,
+		Params:             k.GetParams(ctx),
+		FractionalBalances: fractionalBalances,
 	}
 }
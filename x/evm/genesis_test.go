@@ -3,6 +3,7 @@ package evm_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -136,6 +137,21 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 			},
 			false,
 		},
+		{
+			"fractional balances",
+			func() {},
+			&types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EvmDenomDecimals = 8
+					return params
+				}(),
+				FractionalBalances: []types.FractionalBalance{
+					{Address: address.String(), Amount: sdkmath.NewInt(5)},
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -158,6 +174,9 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 						_ = evm.InitGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper, *tc.genState)
 					},
 				)
+
+				exported := evm.ExportGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper)
+				suite.Require().Equal(tc.genState.FractionalBalances, exported.FractionalBalances)
 			}
 		})
 	}
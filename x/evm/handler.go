@@ -32,6 +32,9 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgEthereumTx:
 			res, err := server.EthereumTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEthereumCall:
+			res, err := server.EthereumCall(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgUpdateParams:
 			res, err := server.UpdateParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -569,7 +569,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest()
 			k := suite.app.EvmKeeper
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			// add some fund to pay gas fee
 			k.SetBalance(suite.ctx, suite.from, big.NewInt(1000000000000000))
@@ -603,7 +603,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 
 			txData, err := types.UnpackTxData(tx.Data)
 			suite.Require().NoError(err)
-			fees, err := keeper.VerifyFee(txData, "aphoton", baseFee, true, true, suite.ctx.IsCheckTx())
+			fees, err := keeper.VerifyFee(txData, evmParams, baseFee, true, true, suite.ctx.IsCheckTx())
 			suite.Require().NoError(err)
 			err = k.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From))
 			suite.Require().NoError(err)
@@ -634,7 +634,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 }
 
 func (suite *EvmTestSuite) TestContractDeploymentRevert() {
-	intrinsicGas := uint64(134180)
+	intrinsicGas := uint64(134510)
 	testCases := []struct {
 		msg      string
 		gasLimit uint64
@@ -658,7 +658,7 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k := suite.app.EvmKeeper
 
 			// test with different hooks scenarios
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
 			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
@@ -695,13 +695,29 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 // DummyHook implements EvmHooks interface
 type DummyHook struct{}
 
+func (dh *DummyHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
 func (dh *DummyHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return nil
 }
 
+func (dh *DummyHook) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
+
 // FailureHook implements EvmHooks interface
 type FailureHook struct{}
 
+func (dh *FailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
 func (dh *FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return errors.New("mock error")
 }
+
+func (dh *FailureHook) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
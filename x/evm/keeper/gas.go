@@ -16,9 +16,12 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	errorsmod "cosmossdk.io/errors"
@@ -36,7 +39,33 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	return IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul, k.GetParams(ctx))
+}
+
+// IntrinsicGas computes the intrinsic gas for a message with the given data. On
+// contract creation, it adds the EIP-3860 initcode word cost when the max initcode
+// size is set on the EVM params.
+func IntrinsicGas(
+	data []byte,
+	accessList ethtypes.AccessList,
+	isContractCreation, homestead, istanbul bool,
+	evmParams types.Params,
+) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, accessList, isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	if !isContractCreation {
+		return gas, nil
+	}
+
+	initcodeGas := evmParams.InitcodeGas(data)
+	if math.MaxUint64-gas < initcodeGas {
+		return 0, core.ErrGasUintOverflow
+	}
+
+	return gas + initcodeGas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -56,8 +85,13 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		var err error
+		if params := k.GetParams(ctx); params.IsScaledDenom() {
+			feeCollector := common.BytesToAddress(k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+			err = k.transferScaledBalance(ctx, params, feeCollector, msg.From(), remaining)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		}
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -16,6 +16,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -31,6 +32,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -48,6 +51,10 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// maxStorageRangeLimit is the maximum number of storage entries returned by a single StorageRange query
+	maxStorageRangeLimit = 1024
+	// maxAccountRangeLimit is the maximum number of accounts returned by a single AccountRange query
+	maxAccountRangeLimit = 256
 )
 
 // Account implements the Query/Account gRPC method
@@ -186,6 +193,130 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxStorageRangeLimit {
+		limit = maxStorageRangeLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	start := common.HexToHash(req.StartKey)
+
+	res := &types.QueryStorageRangeResponse{
+		Storage: types.Storage{},
+	}
+	k.ForEachStorageFrom(ctx, address, start, func(key, value common.Hash) bool {
+		if uint64(len(res.Storage)) == limit {
+			res.NextKey = key.Hex()
+			return false
+		}
+		res.Storage = append(res.Storage, types.NewState(key, value))
+		return true
+	})
+
+	return res, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var start common.Address
+	if req.Start != "" {
+		if err := ethermint.ValidateAddress(req.Start); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		start = common.HexToAddress(req.Start)
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxAccountRangeLimit {
+		limit = maxAccountRangeLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAccountRangeResponse{}
+	// accounts are stored by address, the auth store is paginated from the start address so
+	// the accounts below it are not iterated again.
+	pageReq := &query.PageRequest{Key: start.Bytes(), Limit: limit + 1}
+	for pageReq != nil {
+		page, err := k.accountKeeper.Accounts(c, &authtypes.QueryAccountsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		pageReq = nil
+		if page.Pagination != nil && len(page.Pagination.NextKey) > 0 {
+			pageReq = &query.PageRequest{Key: page.Pagination.NextKey, Limit: limit + 1}
+		}
+
+		for _, accountAny := range page.Accounts {
+			var account authtypes.AccountI
+			if err := k.cdc.UnpackAny(accountAny, &account); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			ethAccount, ok := account.(ethermint.EthAccountI)
+			if !ok {
+				// ignore non EthAccounts
+				continue
+			}
+
+			address := ethAccount.EthAddress()
+			if uint64(len(res.Accounts)) == limit {
+				res.Next = address.Hex()
+				return res, nil
+			}
+
+			res.Accounts = append(res.Accounts, k.dumpAccount(ctx, ethAccount, req.NoCode, req.NoStorage))
+		}
+	}
+
+	return res, nil
+}
+
+// dumpAccount returns the state of an account for the AccountRange query.
+func (k Keeper) dumpAccount(ctx sdk.Context, ethAccount ethermint.EthAccountI, noCode, noStorage bool) types.AccountDump {
+	address := ethAccount.EthAddress()
+	codeHash := ethAccount.GetCodeHash()
+	dump := types.AccountDump{
+		Address:  address.Hex(),
+		Balance:  k.GetBalance(ctx, address).String(),
+		Nonce:    ethAccount.GetSequence(),
+		CodeHash: codeHash.Hex(),
+	}
+
+	if !noCode && !bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+		dump.Code = k.GetCode(ctx, codeHash)
+	}
+
+	if !noStorage {
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			dump.Storage = append(dump.Storage, types.NewState(key, value))
+			return true
+		})
+	}
+
+	return dump
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
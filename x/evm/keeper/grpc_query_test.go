@@ -289,6 +289,197 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStorageRange() {
+	var (
+		req        *types.QueryStorageRangeRequest
+		expStorage types.Storage
+		expNextKey string
+	)
+
+	setStorage := func(vmdb vm.StateDB) []common.Hash {
+		keys := []common.Hash{
+			common.BigToHash(big.NewInt(1)),
+			common.BigToHash(big.NewInt(2)),
+			common.BigToHash(big.NewInt(3)),
+		}
+		for i, key := range keys {
+			vmdb.SetState(suite.address, key, common.BigToHash(big.NewInt(int64(i+10))))
+		}
+		return keys
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(vm.StateDB)
+		expPass  bool
+	}{
+		{
+			"invalid address",
+			func(vm.StateDB) {
+				req = &types.QueryStorageRangeRequest{
+					Address: invalidAddress,
+				}
+			},
+			false,
+		},
+		{
+			"success - empty storage",
+			func(vm.StateDB) {
+				expStorage = nil
+				expNextKey = ""
+				req = &types.QueryStorageRangeRequest{
+					Address: suite.address.String(),
+				}
+			},
+			true,
+		},
+		{
+			"success - all entries",
+			func(vmdb vm.StateDB) {
+				keys := setStorage(vmdb)
+				expStorage = types.Storage{
+					types.NewState(keys[0], common.BigToHash(big.NewInt(10))),
+					types.NewState(keys[1], common.BigToHash(big.NewInt(11))),
+					types.NewState(keys[2], common.BigToHash(big.NewInt(12))),
+				}
+				expNextKey = ""
+				req = &types.QueryStorageRangeRequest{
+					Address: suite.address.String(),
+				}
+			},
+			true,
+		},
+		{
+			"success - first page",
+			func(vmdb vm.StateDB) {
+				keys := setStorage(vmdb)
+				expStorage = types.Storage{
+					types.NewState(keys[0], common.BigToHash(big.NewInt(10))),
+					types.NewState(keys[1], common.BigToHash(big.NewInt(11))),
+				}
+				expNextKey = keys[2].Hex()
+				req = &types.QueryStorageRangeRequest{
+					Address: suite.address.String(),
+					Limit:   2,
+				}
+			},
+			true,
+		},
+		{
+			"success - from start key",
+			func(vmdb vm.StateDB) {
+				keys := setStorage(vmdb)
+				expStorage = types.Storage{
+					types.NewState(keys[1], common.BigToHash(big.NewInt(11))),
+				}
+				expNextKey = keys[2].Hex()
+				req = &types.QueryStorageRangeRequest{
+					Address:  suite.address.String(),
+					StartKey: keys[1].Hex(),
+					Limit:    1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			vmdb := suite.StateDB()
+			tc.malleate(vmdb)
+			suite.Require().NoError(vmdb.Commit())
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.StorageRange(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(expStorage, res.Storage)
+				suite.Require().Equal(expNextKey, res.NextKey)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAccountRange() {
+	suite.SetupTest()
+
+	contract := tests.GenerateAddress()
+	code := []byte("code")
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, code)
+	vmdb.SetState(contract, key, value)
+	vmdb.SetNonce(contract, 1)
+	suite.Require().NoError(vmdb.Commit())
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// invalid start address
+	_, err := suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: invalidAddress})
+	suite.Require().Error(err)
+
+	// full range
+	res, err := suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Next)
+
+	var found *types.AccountDump
+	for i, account := range res.Accounts {
+		if i > 0 {
+			suite.Require().Less(res.Accounts[i-1].Address, account.Address)
+		}
+		if account.Address == contract.Hex() {
+			found = &res.Accounts[i]
+		}
+	}
+	suite.Require().NotNil(found)
+	suite.Require().Equal(uint64(1), found.Nonce)
+	suite.Require().Equal(code, found.Code)
+	suite.Require().Equal(crypto.Keccak256Hash(code).Hex(), found.CodeHash)
+	suite.Require().Equal(types.Storage{types.NewState(key, value)}, found.Storage)
+
+	// without code and storage
+	res, err = suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Start:     contract.Hex(),
+		Limit:     1,
+		NoCode:    true,
+		NoStorage: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(contract.Hex(), res.Accounts[0].Address)
+	suite.Require().Empty(res.Accounts[0].Code)
+	suite.Require().Empty(res.Accounts[0].Storage)
+
+	// paginate one account at a time
+	var (
+		pages []types.AccountDump
+		start string
+	)
+	for {
+		res, err = suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: start, Limit: 1})
+		suite.Require().NoError(err)
+		pages = append(pages, res.Accounts...)
+		if res.Next == "" {
+			break
+		}
+		start = res.Next
+	}
+
+	full, err := suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(full.Accounts, pages)
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -576,7 +767,7 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				}
 			},
 			true,
-			1186778,
+			1187108,
 			false,
 		},
 		// estimate gas of an erc20 transfer, the exact gas number is checked with geth
@@ -652,7 +843,7 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				}
 			},
 			true,
-			1186778,
+			1187108,
 			true,
 		},
 		{
@@ -33,6 +33,16 @@ func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
 	return hooks
 }
 
+// PreTxProcessing delegate the call to underlying hooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	for i := range mh {
+		if err := mh[i].PreTxProcessing(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
 // PostTxProcessing delegate the call to underlying hooks
 func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	for i := range mh {
@@ -42,3 +52,13 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// PostTxFailed delegate the call to underlying hooks
+func (mh MultiEvmHooks) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	for i := range mh {
+		if err := mh[i].PostTxFailed(ctx, msg, receipt); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -19,18 +20,65 @@ type LogRecordHook struct {
 	Logs []*ethtypes.Log
 }
 
+func (dh *LogRecordHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
 func (dh *LogRecordHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	dh.Logs = receipt.Logs
 	return nil
 }
 
+func (dh *LogRecordHook) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
+
 // FailureHook always fail
 type FailureHook struct{}
 
+func (dh FailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return nil
+}
+
 func (dh FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return errors.New("post tx processing failed")
 }
 
+func (dh FailureHook) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
+
+var (
+	hookMarker = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	preTxKey   = common.BigToHash(big.NewInt(1))
+	failedKey  = common.BigToHash(big.NewInt(2))
+	hookValue  = common.BigToHash(big.NewInt(42))
+)
+
+// StateHook writes to the EVM state from the PreTxProcessing and PostTxFailed
+// hooks and records the failed receipts
+type StateHook struct {
+	k         *keeper.Keeper
+	preErr    error
+	failedErr error
+	receipts  []*ethtypes.Receipt
+}
+
+func (dh *StateHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	dh.k.SetState(ctx, hookMarker, preTxKey, hookValue.Bytes())
+	return dh.preErr
+}
+
+func (dh *StateHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
+
+func (dh *StateHook) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	dh.receipts = append(dh.receipts, receipt)
+	dh.k.SetState(ctx, hookMarker, failedKey, hookValue.Bytes())
+	return dh.failedErr
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -61,7 +109,7 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 	for _, tc := range testCases {
 		suite.SetupTest()
 		hook := tc.setupHook()
-		suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(hook))
+		suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
 
 		k := suite.app.EvmKeeper
 		ctx := suite.ctx
@@ -87,3 +135,95 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmTxHooks() {
+	var (
+		hook  *StateHook
+		value *big.Int
+	)
+
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000001234")
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expVMError   string
+		expBalance   int64
+		expPreState  bool
+		expFailState bool
+	}{
+		{
+			"pre tx hook succeeds, state changes committed with the tx",
+			func() {},
+			"",
+			100,
+			true,
+			false,
+		},
+		{
+			"pre tx hook fails, tx is not executed",
+			func() {
+				hook.preErr = errors.New("sender is paused")
+			},
+			types.ErrPreTxProcessing.Error(),
+			0,
+			false,
+			true,
+		},
+		{
+			"tx execution fails, post tx failed hook committed",
+			func() {
+				value = big.NewInt(1_000_000)
+			},
+			"insufficient balance for transfer",
+			0,
+			false,
+			true,
+		},
+		{
+			"post tx failed hook fails, its state changes are reverted",
+			func() {
+				value = big.NewInt(1_000_000)
+				hook.failedErr = errors.New("post tx failed")
+			},
+			"insufficient balance for transfer",
+			0,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			hook = &StateHook{k: suite.app.EvmKeeper}
+			value = big.NewInt(100)
+			suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(suite.address, big.NewInt(1000))
+			suite.Require().NoError(vmdb.Commit())
+
+			tc.malleate()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgEthereumCall(sender, &recipient, nil, value, params.TxGas)
+			res, err := suite.app.EvmKeeper.EthereumCall(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+
+			k := suite.app.EvmKeeper
+			suite.Require().Equal(big.NewInt(tc.expBalance), k.GetBalance(suite.ctx, recipient))
+			suite.Require().Equal(tc.expPreState, k.GetState(suite.ctx, hookMarker, preTxKey) == hookValue)
+			suite.Require().Equal(tc.expFailState, k.GetState(suite.ctx, hookMarker, failedKey) == hookValue)
+
+			if tc.expVMError == "" {
+				suite.Require().Empty(hook.receipts)
+				return
+			}
+			suite.Require().Equal(params.TxGas, res.GasUsed)
+			suite.Require().Len(hook.receipts, 1)
+			suite.Require().Equal(ethtypes.ReceiptStatusFailed, hook.receipts[0].Status)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "fractional-balances", FractionalBalancesInvariant(k))
+}
+
+// FractionalBalancesInvariant checks that the fractional balances are lower than the evm denom
+// scale, that their stored total is accurate and that the EVM module account reserve backs it,
+// so that the bank supply of the evm denom is conserved by the scaled EVM balances.
+func FractionalBalancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		params := k.GetParams(ctx)
+		scale := params.DenomScale()
+
+		total := new(big.Int)
+		k.IterateFractionalBalances(ctx, func(addr common.Address, amount *big.Int) bool {
+			if amount.Sign() <= 0 || amount.Cmp(scale) >= 0 {
+				broken = true
+				msg += fmt.Sprintf("\tinvalid fractional balance %s for %s, scale %s\n", amount, addr, scale)
+			}
+			total.Add(total, amount)
+			return false
+		})
+
+		if !params.IsScaledDenom() {
+			if total.Sign() != 0 {
+				broken = true
+				msg += fmt.Sprintf("\tfractional balances total %s with an unscaled evm denom\n", total)
+			}
+			return sdk.FormatInvariant(types.ModuleName, "fractional-balances", msg), broken
+		}
+
+		if stored := k.GetFractionalBalanceTotal(ctx); stored.Cmp(total) != 0 {
+			broken = true
+			msg += fmt.Sprintf("\tstored fractional balances total %s, expected %s\n", stored, total)
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		reserve := k.bankKeeper.GetBalance(ctx, moduleAddr, params.EvmDenom).Amount.BigInt()
+		if expected := FractionalReserve(total, scale); reserve.Cmp(expected) != 0 {
+			broken = true
+			msg += fmt.Sprintf("\tfractional balances reserve %s%s, expected %s%s\n", reserve, params.EvmDenom, expected, params.EvmDenom)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "fractional-balances", msg), broken
+	}
+}
@@ -29,6 +29,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 
 	ethermint "github.com/evmos/ethermint/types"
@@ -206,6 +207,33 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// reservedNonceStore returns the transient store of the nonces reserved for the current tx.
+func (k Keeper) reservedNonceStore(ctx sdk.Context) prefix.Store {
+	txPrefix := append(types.KeyPrefixTransientReservedNonce, tmhash.Sum(ctx.TxBytes())...)
+	return prefix.NewStore(ctx.TransientStore(k.transientKey), txPrefix)
+}
+
+// SetReservedNonceTransient reserves the nonce of an address, read by the ante handler before the
+// account sequence is incremented, for the contract creation of a MsgEthereumCall of the current tx.
+func (k Keeper) SetReservedNonceTransient(ctx sdk.Context, addr common.Address, nonce uint64) {
+	k.reservedNonceStore(ctx).Set(addr.Bytes(), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetReservedNonceTransient returns the nonce reserved for an address by the current tx, if any.
+func (k Keeper) GetReservedNonceTransient(ctx sdk.Context, addr common.Address) (uint64, bool) {
+	bz := k.reservedNonceStore(ctx).Get(addr.Bytes())
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteReservedNonceTransient releases the nonce reserved for an address by the current tx.
+func (k Keeper) DeleteReservedNonceTransient(ctx sdk.Context, addr common.Address) {
+	k.reservedNonceStore(ctx).Delete(addr.Bytes())
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -259,6 +287,33 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the hooks for the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// SetPrecompiles sets the custom precompiled contracts, for the precompiles depending on modules
+// which depend on the EVM keeper.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetPrecompiles(precompiles evm.PrecompiledContracts) *Keeper {
+	if k.customPrecompiles != nil {
+		panic("cannot set evm custom precompiles twice")
+	}
+
+	k.customPrecompiles = precompiles
+	return k
+}
+
+// PreTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PreTxProcessing(ctx, msg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -267,6 +322,14 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *et
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
 
+// PostTxFailed delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PostTxFailed(ctx, msg, receipt)
+}
+
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
@@ -318,7 +381,8 @@ func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	return acct.GetSequence()
 }
 
-// GetBalance load account's balance of gas token
+// GetBalance load account's balance of gas token. If the evm denom has less than 18 decimals, the
+// bank balance is scaled to 18 decimals and the account fractional balance is added.
 func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	evmParams := k.GetParams(ctx)
@@ -328,7 +392,12 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 		return big.NewInt(-1)
 	}
 	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, evmDenom)
-	return coin.Amount.BigInt()
+	if !evmParams.IsScaledDenom() {
+		return coin.Amount.BigInt()
+	}
+
+	balance := new(big.Int).Mul(coin.Amount.BigInt(), evmParams.DenomScale())
+	return balance.Add(balance, k.GetFractionalBalance(ctx, addr))
 }
 
 // GetBaseFee returns current base fee, return values:
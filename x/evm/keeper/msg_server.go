@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -30,6 +31,8 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -89,8 +92,54 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		}
 	}()
 
+	if err := emitEthereumTxEvents(ctx, response, txIndex, tx.Value(), tx.To(), sender, tx.Type()); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// EthereumCall implements the gRPC MsgServer interface. It executes an EVM call or contract creation
+// signed by a Cosmos account, from the EVM address derived from the sender, and emits the same events
+// as EthereumTx, along with the nonce of the call. Fees are deducted by the Cosmos ante handler chain.
+func (k *Keeper) EthereumCall(goCtx context.Context, msg *types.MsgEthereumCall) (*types.MsgEthereumTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	txIndex := k.GetTxIndexTransient(ctx)
+	nonce := k.ethereumCallNonce(ctx, msg)
+
+	response, err := k.ApplyEthereumCall(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply ethereum call")
+	}
+
+	telemetry.IncrCounter(1, "tx", "msg", "ethereum_call", "total")
+
+	if err := emitEthereumTxEvents(
+		ctx, response, txIndex, msg.GetValue(), msg.GetTo(), msg.Sender, ethtypes.LegacyTxType,
+		// the nonce of the call is not part of the message, it's needed to derive the created contract address
+		sdk.NewAttribute(types.AttributeKeyTxNonce, strconv.FormatUint(nonce, 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// emitEthereumTxEvents emits the ethereum_tx, tx_log and message events of an executed EVM message,
+// with the extra attributes added to the ethereum_tx event.
+func emitEthereumTxEvents(
+	ctx sdk.Context,
+	response *types.MsgEthereumTxResponse,
+	txIndex uint64,
+	value *big.Int,
+	to *common.Address,
+	sender string,
+	txType uint8,
+	extraAttrs ...sdk.Attribute,
+) error {
 	attrs := []sdk.Attribute{
-		sdk.NewAttribute(sdk.AttributeKeyAmount, tx.Value().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, value.String()),
 		// add event for ethereum transaction hash format
 		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, response.Hash),
 		// add event for index of valid ethereum tx
@@ -105,7 +154,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxHash, hash.String()))
 	}
 
-	if to := tx.To(); to != nil {
+	if to != nil {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
 	}
 
@@ -113,11 +162,13 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
 
+	attrs = append(attrs, extraAttrs...)
+
 	txLogAttrs := make([]sdk.Attribute, len(response.Logs))
 	for i, log := range response.Logs {
 		value, err := json.Marshal(log)
 		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to encode log")
+			return errorsmod.Wrap(err, "failed to encode log")
 		}
 		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
 	}
@@ -136,11 +187,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", txType)),
 		),
 	})
 
-	return response, nil
+	return nil
 }
 
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
@@ -153,6 +204,15 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the fractional balances can't be migrated to another number of decimals
+	if current := k.GetParams(ctx); current.DenomDecimals() != req.Params.DenomDecimals() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidDenomDecimals,
+			"evm denom decimals cannot be updated, expected %d, got %d", current.DenomDecimals(), req.Params.DenomDecimals(),
+		)
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -2,12 +2,19 @@ package keeper_test
 
 import (
 	"math/big"
+	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/tests"
+	utiltx "github.com/evmos/ethermint/testutil/tx"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -82,6 +89,135 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthereumCall() {
+	var (
+		msg    *types.MsgEthereumCall
+		txGas  uint64
+		nonce  uint64
+		sender sdk.AccAddress
+		check  func(ctx sdk.Context, res *types.MsgEthereumTxResponse)
+	)
+
+	recipient := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"gas limit exceeds the remaining tx gas",
+			func() {
+				// the ante handler has already consumed part of the tx gas
+				txGas = 200_000
+				msg = types.NewMsgEthereumCall(sender, &recipient, nil, big.NewInt(100), txGas)
+			},
+			true,
+		},
+		{
+			"transfer funds",
+			func() {
+				msg = types.NewMsgEthereumCall(sender, &recipient, nil, big.NewInt(100), params.TxGas)
+				// the call is executed with the nonce incremented by the ante handler
+				nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address) + 1
+				check = func(ctx sdk.Context, res *types.MsgEthereumTxResponse) {
+					suite.Require().Equal(params.TxGas, res.GasUsed)
+					suite.Require().Equal(big.NewInt(100), suite.app.EvmKeeper.GetBalance(ctx, recipient))
+				}
+			},
+			false,
+		},
+		{
+			"deploy contract",
+			func() {
+				ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+				suite.Require().NoError(err)
+				data := append(types.ERC20Contract.Bin, ctorArgs...)
+				// the nonce the tx is signed with, before the ante handler increments it
+				nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+				msg = types.NewMsgEthereumCall(sender, nil, data, nil, 2_000_000)
+				check = func(ctx sdk.Context, res *types.MsgEthereumTxResponse) {
+					contractAddr := crypto.CreateAddress(suite.address, nonce)
+					suite.Require().NotEmpty(suite.app.EvmKeeper.GetCode(ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccount(ctx, contractAddr).CodeHash)))
+					suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(ctx, suite.address))
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender = sdk.AccAddress(suite.address.Bytes())
+			txGas = 3_000_000
+			check = nil
+
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(suite.address, new(big.Int).Add(utiltx.DefaultFee.Amount.BigInt(), big.NewInt(1000)))
+			suite.Require().NoError(vmdb.Commit())
+
+			tc.malleate()
+			suite.Require().NoError(msg.ValidateBasic())
+
+			// run the ante handler, which deducts the fees and increments the sender sequence
+			tx, err := utiltx.PrepareCosmosTx(suite.ctx, suite.app, utiltx.CosmosTxArgs{
+				TxCfg:   suite.clientCtx.TxConfig,
+				Priv:    suite.priv,
+				ChainID: suite.ctx.ChainID(),
+				Gas:     txGas,
+				Msgs:    []sdk.Msg{msg},
+			})
+			suite.Require().NoError(err)
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+				AccountKeeper:   suite.app.AccountKeeper,
+				BankKeeper:      suite.app.BankKeeper,
+				EvmKeeper:       suite.app.EvmKeeper,
+				FeegrantKeeper:  suite.app.FeeGrantKeeper,
+				IBCKeeper:       suite.app.IBCKeeper,
+				FeeMarketKeeper: suite.app.FeeMarketKeeper,
+				SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			})
+			suite.Require().NoError(err)
+			ctx, err := anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+			suite.Require().NoError(err)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			res, err := suite.app.EvmKeeper.EthereumCall(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidGasLimit)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, res.GasUsed)
+
+			// the call takes the ethereum tx index and emits the ethereum tx events
+			suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetTxIndexTransient(ctx))
+
+			attrs := make(map[string]string)
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEthereumTx {
+					for _, attr := range event.Attributes {
+						attrs[string(attr.Key)] = string(attr.Value)
+					}
+				}
+			}
+			suite.Require().Equal(res.Hash, attrs[types.AttributeKeyEthereumTxHash])
+			suite.Require().Equal("0", attrs[types.AttributeKeyTxIndex])
+			suite.Require().Equal(strconv.FormatUint(nonce, 10), attrs[types.AttributeKeyTxNonce])
+
+			if check != nil {
+				check(ctx, res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
 		name      string
@@ -101,6 +237,18 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - evm denom decimals update",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EvmDenomDecimals = 8
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -70,7 +70,7 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return k.evmConstructor(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig, k.customPrecompiles)
+	return k.evmConstructor(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig, k.customPrecompiles, cfg.Params.MaxCodeSize)
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -147,11 +147,6 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
-	var (
-		bloom        *big.Int
-		bloomReceipt ethtypes.Bloom
-	)
-
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
@@ -166,6 +161,120 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	res, err := k.applyMessageWithReceipt(ctx, msg, ethTx.Type(), cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
+	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	}
+
+	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
+	}
+
+	// reset the gas meter for current cosmos transaction
+	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
+	return res, nil
+}
+
+// ApplyEthereumCall executes a MsgEthereumCall, i.e. an EVM call or contract creation signed by a
+// Cosmos account, against the current state. It shares the receipt, hooks and transient block
+// bookkeeping of ApplyTransaction, but fees are paid through the Cosmos ante handler chain, so the
+// message carries no gas price and there is nothing to refund. The EVM gas used is charged to the
+// Cosmos gas meter instead of the KVStore gas consumed during execution.
+func (k *Keeper) ApplyEthereumCall(ctx sdk.Context, msgCall *types.MsgEthereumCall) (*types.MsgEthereumTxResponse, error) {
+	if limit := ctx.GasMeter().Limit(); limit > 0 && msgCall.GasLimit > ctx.GasMeter().GasRemaining() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidGasLimit,
+			"gas limit %d exceeds remaining tx gas %d", msgCall.GasLimit, ctx.GasMeter().GasRemaining(),
+		)
+	}
+
+	// ignore the store gas consumption during execution, only the EVM gas used is charged.
+	gasMeter := ctx.GasMeter()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	// there is no ethereum transaction hash, derive a unique one from the cosmos tx and the
+	// position of the message in the block.
+	txHash := k.ethereumCallHash(ctx)
+	txConfig := k.TxConfig(ctx, txHash)
+
+	from := msgCall.GetFrom()
+	nonce := k.ethereumCallNonce(ctx, msgCall)
+	if nonce != k.GetNonce(ctx, from) {
+		// the reserved nonce is only used once
+		k.DeleteReservedNonceTransient(ctx, from)
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		msgCall.GetTo(),
+		nonce,
+		msgCall.GetValue(),
+		msgCall.GasLimit,
+		new(big.Int),
+		new(big.Int),
+		new(big.Int),
+		msgCall.Data,
+		nil,
+		false,
+	)
+
+	res, err := k.applyMessageWithReceipt(ctx, msg, ethtypes.LegacyTxType, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gasMeter.ConsumeGas(res.GasUsed, "evm call")
+	return res, nil
+}
+
+// ethereumCallHash returns the hash of the MsgEthereumCall processed at the current transient tx
+// index, derived from the cosmos tx bytes and the index.
+func (k *Keeper) ethereumCallHash(ctx sdk.Context) common.Hash {
+	return crypto.Keccak256Hash(ctx.TxBytes(), sdk.Uint64ToBigEndian(k.GetTxIndexTransient(ctx)))
+}
+
+// ethereumCallNonce returns the nonce a MsgEthereumCall is executed with. The ante handler has
+// already incremented the sequence of the signer, so a contract creation uses the nonce reserved
+// before the increment, like an ethereum transaction.
+func (k *Keeper) ethereumCallNonce(ctx sdk.Context, msgCall *types.MsgEthereumCall) uint64 {
+	from := msgCall.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	if msgCall.GetTo() != nil {
+		return nonce
+	}
+	if reserved, found := k.GetReservedNonceTransient(ctx, from); found && reserved+1 == nonce {
+		return reserved
+	}
+	return nonce
+}
+
+// applyMessageWithReceipt applies the core message, builds its receipt and runs the PostTxProcessing
+// hooks against it. When hooks are registered the execution happens in a cache context that is only
+// committed if both the message and the hooks succeed. Finally, the transient block bloom, log size
+// and tx index are updated.
+func (k *Keeper) applyMessageWithReceipt(
+	ctx sdk.Context,
+	msg core.Message,
+	txType uint8,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	var (
+		bloom        *big.Int
+		bloomReceipt ethtypes.Bloom
+	)
+
 	// snapshot to contain the tx processing and post processing in same scope
 	var commit func()
 	tmpCtx := ctx
@@ -177,10 +286,23 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	var res *types.MsgEthereumTxResponse
+	if err := k.PreTxProcessing(tmpCtx, msg); err != nil {
+		// If hooks return error, the tx is not executed and consumes its whole gas limit.
+		k.Logger(ctx).Error("tx pre processing failed", "error", err)
+		res = &types.MsgEthereumTxResponse{
+			GasUsed: msg.Gas(),
+			VmError: types.ErrPreTxProcessing.Error(),
+			Hash:    txConfig.TxHash.Hex(),
+		}
+		// the pre processing state changes are discarded with the cache context
+		commit = nil
+	} else {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		}
 	}
 
 	logs := types.LogsToEthereum(res.Logs)
@@ -207,7 +329,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:              ethTx.Type(),
+		Type:              txType,
 		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
@@ -223,13 +345,15 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 		// Only call hooks if tx executed successfully.
-		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+		if err := k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
 			// If hooks return error, revert the whole tx.
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
 
 			// If the tx failed in post processing hooks, we should clear the logs
 			res.Logs = nil
+			receipt.Status = ethtypes.ReceiptStatusFailed
+			receipt.Logs = nil
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
@@ -239,9 +363,15 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
-	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	if res.Failed() && k.hooks != nil {
+		// The failed tx state changes have been discarded, the hooks run in their own cache context
+		// which is only committed if they succeed.
+		failedCtx, commitFailed := ctx.CacheContext()
+		if err := k.PostTxFailed(failedCtx, msg, receipt); err != nil {
+			k.Logger(ctx).Error("tx post failure processing failed", "error", err)
+		} else {
+			commitFailed()
+		}
 	}
 
 	if len(receipt.Logs) > 0 {
@@ -252,13 +382,6 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
 
-	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
-	}
-
-	// reset the gas meter for current cosmos transaction
-	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
 }
 
@@ -299,6 +422,7 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 // 4. the purchased gas is enough to cover intrinsic usage
 // 5. there is no overflow when calculating intrinsic gas
 // 6. caller has enough balance to cover asset transfer for **topmost** call
+// 7. the contract creation initcode doesn't exceed the max initcode size
 //
 // The preprocessing steps performed by the AnteHandler are:
 //
@@ -348,6 +472,13 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context().BlockNumber)
 
+	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
+	if contractCreation {
+		if err := cfg.Params.ValidateInitcode(msg.Data()); err != nil {
+			return nil, errorsmod.Wrap(err, "apply message")
+		}
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
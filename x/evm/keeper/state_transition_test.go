@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -206,7 +208,7 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			1,
 			true,
 			true,
-			params.TxGas + params.TxDataNonZeroGasFrontier*1,
+			params.TxGas + params.TxDataNonZeroGasFrontier*1 + types.InitcodeWordGas*1,
 		},
 		{
 			"no data, one accesslist, not contract creation, not homestead, not istanbul",
@@ -630,6 +632,15 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			},
 			true,
 		},
+		{
+			"create contract tx with initcode larger than config param MaxInitcodeSize",
+			func() {
+				msg, err = suite.createContractGethMsg(vmdb.GetNonce(suite.address), signer, chainCfg, big.NewInt(1))
+				suite.Require().NoError(err)
+				config.Params.MaxInitcodeSize = uint64(len(msg.Data()) - 1)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -662,6 +673,146 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigMaxCodeSize() {
+	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	gasLimit := uint64(5_000_000)
+	msg := ethtypes.NewMessage(suite.address, nil, nonce, big.NewInt(0), gasLimit, big.NewInt(0), nil, nil, data, nil, true)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+
+	// the runtime code of the contract is larger than a single byte
+	config.Params.MaxCodeSize = 1
+	res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(vm.ErrMaxCodeSizeExceeded.Error(), res.VmError)
+	suite.Require().Equal(gasLimit, res.GasUsed)
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(types.ERC20Contract.Bin)))
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr))
+	suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+	config.Params.MaxCodeSize = types.DefaultMaxCodeSize
+	msg = ethtypes.NewMessage(suite.address, nil, nonce+1, big.NewInt(0), gasLimit, big.NewInt(0), nil, nil, data, nil, true)
+	res, err = suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+}
+
+// childInitcode returns the initcode of a contract with a runtime code of the given size.
+func childInitcode(size uint16) []byte {
+	// PUSH2 size PUSH1 0 RETURN
+	return []byte{0x61, byte(size >> 8), byte(size), 0x60, 0x00, 0xf3}
+}
+
+// factoryInitcode returns the initcode of a contract that creates a child contract with a
+// runtime code of the given size, and stores the child address in its storage slot 0.
+func factoryInitcode(size uint16) []byte {
+	// PUSH6 child PUSH1 0 MSTORE
+	code := append([]byte{0x65}, childInitcode(size)...)
+	code = append(code, 0x60, 0x00, 0x52)
+	// PUSH1 6 PUSH1 26 PUSH1 0 CREATE PUSH1 0 SSTORE STOP
+	return append(code, 0x60, 0x06, 0x60, 0x1a, 0x60, 0x00, 0xf0, 0x60, 0x00, 0x55, 0x00)
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigMaxCodeSizeCreate() {
+	largeSize := uint16(types.DefaultMaxCodeSize + 1000)
+
+	testCases := []struct {
+		name        string
+		maxCodeSize uint64
+		data        []byte
+		codeSize    int
+		expVMError  string
+	}{
+		{
+			"creation from a contract above the lowered limit",
+			10,
+			factoryInitcode(11),
+			0,
+			"",
+		},
+		{
+			"creation from a contract within the lowered limit",
+			10,
+			factoryInitcode(10),
+			10,
+			"",
+		},
+		{
+			"creation from a contract above the EIP-170 limit",
+			types.DefaultMaxCodeSize,
+			factoryInitcode(largeSize),
+			0,
+			"",
+		},
+		{
+			"creation from a contract within the raised limit",
+			2 * types.DefaultMaxCodeSize,
+			factoryInitcode(largeSize),
+			int(largeSize),
+			"",
+		},
+		{
+			"creation transaction above the EIP-170 limit",
+			types.DefaultMaxCodeSize,
+			childInitcode(largeSize),
+			0,
+			vm.ErrMaxCodeSizeExceeded.Error(),
+		},
+		{
+			"creation transaction within the raised limit",
+			2 * types.DefaultMaxCodeSize,
+			childInitcode(largeSize),
+			int(largeSize),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			config.Params.MaxCodeSize = tc.maxCodeSize
+			config.Params.MaxInitcodeSize = 0
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, nil, nonce, big.NewInt(0), 10_000_000, big.NewInt(0), nil, nil, tc.data, nil, true)
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+
+			codeAddr := crypto.CreateAddress(suite.address, nonce)
+			if tc.data[0] == 0x65 {
+				// the child address is stored by the factory, or zero if the creation failed
+				slot := suite.app.EvmKeeper.GetState(suite.ctx, codeAddr, common.Hash{})
+				codeAddr = common.BytesToAddress(slot.Bytes())
+				suite.Require().Equal(tc.codeSize == 0, codeAddr == common.Address{})
+			} else {
+				suite.Require().Len(res.Ret, tc.codeSize)
+			}
+
+			acc := suite.app.EvmKeeper.GetAccount(suite.ctx, codeAddr)
+			if tc.codeSize == 0 {
+				suite.Require().True(acc == nil || !acc.IsContract())
+				return
+			}
+			suite.Require().NotNil(acc)
+			suite.Require().Len(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acc.CodeHash)), tc.codeSize)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, cfg, gasPrice)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -67,10 +68,15 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := types.AddressStoragePrefix(addr)
+	k.ForEachStorageFrom(ctx, addr, common.Hash{}, cb)
+}
+
+// ForEachStorageFrom iterate contract storage in key order, starting from the given key (inclusive),
+// callback return false to break early
+func (k *Keeper) ForEachStorageFrom(ctx sdk.Context, addr common.Address, start common.Hash, cb func(key, value common.Hash) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 
-	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	iterator := store.Iterator(start.Bytes(), nil)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -85,17 +91,37 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 }
 
 // SetBalance update account's balance, compare with current balance first, then decide to mint or burn.
+// If the evm denom has less than 18 decimals, the integer part of the balance is set in the bank
+// module and the remainder is stored as the account fractional balance.
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
 	params := k.GetParams(ctx)
-	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, params.EvmDenom)
+	if !params.IsScaledDenom() {
+		return k.setBankBalance(ctx, cosmosAddr, params.EvmDenom, amount)
+	}
+
+	if amount.Sign() < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "negative balance %s for %s", amount, addr)
+	}
+
+	integer, fractional := new(big.Int).QuoRem(amount, params.DenomScale(), new(big.Int))
+	if err := k.setBankBalance(ctx, cosmosAddr, params.EvmDenom, integer); err != nil {
+		return err
+	}
+	k.SetFractionalBalance(ctx, addr, fractional)
+	return k.settleFractionalReserve(ctx, params)
+}
+
+// setBankBalance mints or burns the evm denom bank tokens of an account to match the given amount.
+func (k *Keeper) setBankBalance(ctx sdk.Context, cosmosAddr sdk.AccAddress, denom string, amount *big.Int) error {
+	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, denom)
 	balance := coin.Amount.BigInt()
 	delta := new(big.Int).Sub(amount, balance)
 	switch delta.Sign() {
 	case 1:
 		// mint
-		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(delta)))
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(delta)))
 		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 			return err
 		}
@@ -104,7 +130,7 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 		}
 	case -1:
 		// burn
-		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosAddr, types.ModuleName, coins); err != nil {
 			return err
 		}
@@ -229,3 +255,132 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Fractional balances
+// ----------------------------------------------------------------------------
+//
+// When the evm denom bank token has less than 18 decimals (e.g BTC in satoshis), an EVM balance
+// is split between the bank balance of the account, scaled by 10^(18 - decimals), and a
+// fractional balance lower than one bank token unit, stored by the EVM module. The EVM module
+// account holds a reserve of bank tokens equal to the rounded up sum of the fractional balances,
+// so that the bank supply always covers the EVM balances.
+
+// GetFractionalBalance returns the part of an account EVM balance that is lower than one unit of
+// the evm denom bank token.
+func (k *Keeper) GetFractionalBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	return new(big.Int).SetBytes(store.Get(addr.Bytes()))
+}
+
+// GetFractionalBalanceTotal returns the sum of all the fractional balances.
+func (k *Keeper) GetFractionalBalanceTotal(ctx sdk.Context) *big.Int {
+	return new(big.Int).SetBytes(ctx.KVStore(k.storeKey).Get(types.KeyFractionalBalanceTotal))
+}
+
+// IterateFractionalBalances iterates over all the non-zero fractional balances, ordered by
+// address, until the callback returns true.
+func (k *Keeper) IterateFractionalBalances(ctx sdk.Context, cb func(addr common.Address, amount *big.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(common.BytesToAddress(iterator.Key()), new(big.Int).SetBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// SetFractionalBalance stores the fractional balance of an account and updates the total. The
+// caller is responsible for the EVM module account reserve.
+func (k *Keeper) SetFractionalBalance(ctx sdk.Context, addr common.Address, amount *big.Int) {
+	total := k.GetFractionalBalanceTotal(ctx)
+	total.Sub(total, k.GetFractionalBalance(ctx, addr))
+	total.Add(total, amount)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	if amount.Sign() == 0 {
+		store.Delete(addr.Bytes())
+	} else {
+		store.Set(addr.Bytes(), amount.Bytes())
+	}
+
+	if total.Sign() == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.KeyFractionalBalanceTotal)
+	} else {
+		ctx.KVStore(k.storeKey).Set(types.KeyFractionalBalanceTotal, total.Bytes())
+	}
+}
+
+// FractionalReserve returns the amount of evm denom bank tokens backing the given total of
+// fractional balances, i.e the total divided by the denom scale and rounded up.
+func FractionalReserve(total, scale *big.Int) *big.Int {
+	reserve, rem := new(big.Int).QuoRem(total, scale, new(big.Int))
+	if rem.Sign() > 0 {
+		reserve.Add(reserve, big.NewInt(1))
+	}
+	return reserve
+}
+
+// settleFractionalReserve mints or burns the evm denom bank tokens of the EVM module account so
+// that it matches the reserve backing the fractional balances.
+func (k *Keeper) settleFractionalReserve(ctx sdk.Context, params types.Params) error {
+	reserve := FractionalReserve(k.GetFractionalBalanceTotal(ctx), params.DenomScale())
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddr, params.EvmDenom).Amount.BigInt()
+
+	delta := new(big.Int).Sub(reserve, balance)
+	switch delta.Sign() {
+	case 1:
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(delta)))
+		return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	case -1:
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	default:
+		return nil
+	}
+}
+
+// transferScaledBalance moves an amount of EVM balance units (i.e wei) between two accounts, when
+// the evm denom is scaled. Unlike SetBalance, the bank tokens are transferred through the EVM
+// module account instead of being minted and burned, so the recipient can be a module account.
+func (k *Keeper) transferScaledBalance(ctx sdk.Context, params types.Params, from, to common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	fromBalance := k.GetBalance(ctx, from)
+	if fromBalance.Cmp(amount) < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "%s is smaller than %s", fromBalance, amount)
+	}
+
+	scale := params.DenomScale()
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	// debit the sender, moving the bank tokens to the EVM module account
+	fromInteger, fromFractional := new(big.Int).QuoRem(new(big.Int).Sub(fromBalance, amount), scale, new(big.Int))
+	debit := new(big.Int).Sub(k.bankKeeper.GetBalance(ctx, from.Bytes(), params.EvmDenom).Amount.BigInt(), fromInteger)
+	if debit.Sign() > 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(debit)))
+		if err := k.bankKeeper.SendCoins(ctx, from.Bytes(), moduleAddr, coins); err != nil {
+			return err
+		}
+	}
+	k.SetFractionalBalance(ctx, from, fromFractional)
+
+	// credit the recipient from the EVM module account
+	toBalance := k.GetBalance(ctx, to)
+	toInteger, toFractional := new(big.Int).QuoRem(toBalance.Add(toBalance, amount), scale, new(big.Int))
+	credit := new(big.Int).Sub(toInteger, k.bankKeeper.GetBalance(ctx, to.Bytes(), params.EvmDenom).Amount.BigInt())
+	if credit.Sign() > 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(credit)))
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, to.Bytes(), coins); err != nil {
+			return err
+		}
+	}
+	k.SetFractionalBalance(ctx, to, toFractional)
+
+	return k.settleFractionalReserve(ctx, params)
+}
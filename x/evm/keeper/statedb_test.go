@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -901,6 +902,79 @@ func (suite *KeeperTestSuite) TestSetBalance() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestScaledDenomBalances() {
+	suite.SetupTest()
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.EvmDenomDecimals = 8
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	var (
+		k            = suite.app.EvmKeeper
+		addr         = tests.GenerateAddress()
+		other        = tests.GenerateAddress()
+		feeCollector = common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+		evmModule    = suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+		supply       = suite.app.BankKeeper.GetSupply(suite.ctx, params.EvmDenom).Amount
+	)
+
+	checkBalance := func(addr common.Address, expBank, expFractional int64) {
+		bankBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), params.EvmDenom)
+		suite.Require().Equal(expBank, bankBalance.Amount.Int64())
+		suite.Require().Equal(big.NewInt(expFractional), k.GetFractionalBalance(suite.ctx, addr))
+	}
+	checkReserve := func(expReserve, expSupplyDelta int64) {
+		reserve := suite.app.BankKeeper.GetBalance(suite.ctx, evmModule, params.EvmDenom)
+		suite.Require().Equal(expReserve, reserve.Amount.Int64())
+		suite.Require().Equal(supply.AddRaw(expSupplyDelta), suite.app.BankKeeper.GetSupply(suite.ctx, params.EvmDenom).Amount)
+		msg, broken := keeper.FractionalBalancesInvariant(k)(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+
+	// set balances with sub-unit remainders
+	suite.Require().NoError(k.SetBalance(suite.ctx, addr, big.NewInt(15_000_000_003)))
+	suite.Require().Equal(big.NewInt(15_000_000_003), k.GetBalance(suite.ctx, addr))
+	checkBalance(addr, 1, 5_000_000_003)
+	checkReserve(1, 2)
+
+	suite.Require().NoError(k.SetBalance(suite.ctx, other, big.NewInt(7_000_000_000)))
+	suite.Require().Equal(big.NewInt(7_000_000_000), k.GetBalance(suite.ctx, other))
+	checkBalance(other, 0, 7_000_000_000)
+	checkReserve(2, 3)
+
+	// fees are deducted exactly and collected as a fractional balance
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector.Bytes(), params.EvmDenom).Amount.Int64()
+	fees := sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 6_000_000_000))
+	suite.Require().NoError(k.DeductTxCostsFromUserBalance(suite.ctx, fees, addr))
+	suite.Require().Equal(big.NewInt(9_000_000_003), k.GetBalance(suite.ctx, addr))
+	checkBalance(addr, 0, 9_000_000_003)
+	checkBalance(feeCollector, feeCollectorBalance, 6_000_000_000)
+	checkReserve(3, 3)
+
+	fees = sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 10_000_000_000))
+	suite.Require().Error(k.DeductTxCostsFromUserBalance(suite.ctx, fees, addr))
+
+	// refunds are returned exactly from the fee collector fractional balance
+	gasPrice := big.NewInt(4_000_000)
+	msg := ethtypes.NewMessage(addr, nil, 0, big.NewInt(0), 21000, gasPrice, gasPrice, gasPrice, nil, nil, true)
+	suite.Require().NoError(k.RefundGas(suite.ctx, msg, 1000, params.EvmDenom))
+	suite.Require().Equal(big.NewInt(13_000_000_003), k.GetBalance(suite.ctx, addr))
+	checkBalance(addr, 1, 3_000_000_003)
+	checkBalance(feeCollector, feeCollectorBalance, 2_000_000_000)
+	checkReserve(2, 3)
+
+	suite.Require().NoError(k.SetBalance(suite.ctx, other, big.NewInt(0)))
+	checkBalance(other, 0, 0)
+	checkReserve(1, 2)
+
+	suite.Require().Error(k.SetBalance(suite.ctx, other, big.NewInt(-1)))
+
+	// the invariant breaks if the reserve doesn't back the fractional balances
+	k.SetFractionalBalance(suite.ctx, other, big.NewInt(9_000_000_000))
+	_, broken := keeper.FractionalBalancesInvariant(k)(suite.ctx)
+	suite.Require().True(broken)
+}
+
 func (suite *KeeperTestSuite) TestDeleteAccount() {
 	supply := big.NewInt(100)
 	contractAddr := suite.DeployTestContract(suite.T(), suite.address, supply)
@@ -19,7 +19,6 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/ethermint/x/evm/types"
@@ -57,6 +57,7 @@ func GetProposerAddress(ctx sdk.Context, proposerAddress sdk.ConsAddress) sdk.Co
 
 // DeductTxCostsFromUserBalance deducts the fees from the user balance. Returns an
 // error if the specified sender address does not exist or the account balance is not sufficient.
+// If the evm denom has less than 18 decimals, the fees are deducted from the fractional balances.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
 	fees sdk.Coins,
@@ -68,6 +69,19 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 		return errorsmod.Wrapf(err, "account not found for sender %s", from)
 	}
 
+	// deduct the exact gas cost from the EVM balance when the evm denom is scaled
+	if params := k.GetParams(ctx); params.IsScaledDenom() {
+		if !fees.IsValid() {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFee, "invalid fee amount: %s", fees)
+		}
+
+		feeCollector := common.BytesToAddress(k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+		if err := k.transferScaledBalance(ctx, params, from, feeCollector, fees.AmountOf(params.EvmDenom).BigInt()); err != nil {
+			return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
+		}
+		return nil
+	}
+
 	// deduct the full gas cost from the user balance
 	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, fees); err != nil {
 		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
@@ -81,10 +95,11 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 // base fee is higher than the gas fee cap.
 func VerifyFee(
 	txData types.TxData,
-	denom string,
+	evmParams types.Params,
 	baseFee *big.Int,
 	homestead, istanbul, isCheckTx bool,
 ) (sdk.Coins, error) {
+	denom := evmParams.EvmDenom
 	isContractCreation := txData.GetTo() == nil
 
 	gasLimit := txData.GetGas()
@@ -94,7 +109,7 @@ func VerifyFee(
 		accessList = txData.GetAccessList()
 	}
 
-	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul)
+	intrinsicGas, err := IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul, evmParams)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
 			err,
@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it sets the max code size and max initcode size
+// parameters to their default values.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+	params.MaxInitcodeSize = types.DefaultMaxInitcodeSize
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
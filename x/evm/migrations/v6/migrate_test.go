@@ -0,0 +1,44 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	v6 "github.com/evmos/ethermint/x/evm/migrations/v6"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// Set the v5 params, without code size limits, in the store
+	oldParams := types.DefaultParams()
+	oldParams.MaxCodeSize = 0
+	oldParams.MaxInitcodeSize = 0
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&oldParams))
+
+	err := v6.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	paramsBz := kvStore.Get(types.KeyPrefixParams)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	// test that the code size limits have been set and the other params are kept
+	require.Equal(t, types.DefaultMaxCodeSize, params.MaxCodeSize)
+	require.Equal(t, types.DefaultMaxInitcodeSize, params.MaxInitcodeSize)
+	require.Equal(t, oldParams.EvmDenom, params.EvmDenom)
+	require.Equal(t, oldParams.ChainConfig, params.ChainConfig)
+	require.Equal(t, oldParams.ExtraEIPs, params.ExtraEIPs)
+}
@@ -85,6 +85,13 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// Context returns the context the state is read from. Stateful precompiled contracts use it to
+// access the state of other modules, state changes made to it are not reverted with the
+// `StateDB` snapshots.
+func (s *StateDB) Context() sdk.Context {
+	return s.ctx
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
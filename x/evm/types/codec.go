@@ -39,6 +39,7 @@ var (
 const (
 	// Amino names
 	updateParamsName = "ethermint/MsgUpdateParams"
+	ethereumCallName = "ethermint/MsgEthereumCall"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -57,6 +58,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgEthereumCall{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -104,4 +106,5 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgEthereumCall{}, ethereumCallName, nil)
 }
@@ -49,9 +49,14 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrMaxInitcodeSizeExceeded
+	codeErrInvalidDenomDecimals
 )
 
-var ErrPostTxProcessing = errors.New("failed to execute post processing")
+var (
+	ErrPreTxProcessing  = errors.New("failed to execute pre processing")
+	ErrPostTxProcessing = errors.New("failed to execute post processing")
+)
 
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
@@ -116,6 +121,12 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrMaxInitcodeSizeExceeded returns an error if the initcode of a contract creation exceeds the max initcode size
+	ErrMaxInitcodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxInitcodeSizeExceeded, "max initcode size exceeded")
+
+	// ErrInvalidDenomDecimals returns an error if the number of decimals of the evm denom is invalid
+	ErrInvalidDenomDecimals = errorsmod.Register(ModuleName, codeErrInvalidDenomDecimals, "invalid evm denom decimals")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -40,6 +40,20 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// max_code_size defines the maximum size in bytes of the runtime code
+	// returned by a contract creation, including the CREATE and CREATE2 from
+	// contracts. It replaces the 24576 bytes limit of EIP-170 and can be lower
+	// or greater. A value of 0 leaves the EIP-170 limit.
+	MaxCodeSize uint64 `protobuf:"varint,7,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_initcode_size defines the maximum size in bytes of the initcode of a
+	// contract creation transaction (EIP-3860). A non-zero value also enables
+	// the initcode word gas cost in the intrinsic gas.
+	MaxInitcodeSize uint64 `protobuf:"varint,8,opt,name=max_initcode_size,json=maxInitcodeSize,proto3" json:"max_initcode_size,omitempty"`
+	// evm_denom_decimals defines the number of decimals of the evm_denom bank
+	// token. Balances of tokens with less than 18 decimals are scaled to 18
+	// decimals in the EVM, the sub-unit remainders being tracked by the EVM
+	// module. A value of 0 defaults to 18 decimals.
+	EvmDenomDecimals uint32 `protobuf:"varint,9,opt,name=evm_denom_decimals,json=evmDenomDecimals,proto3" json:"evm_denom_decimals,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +131,27 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInitcodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitcodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetEvmDenomDecimals() uint32 {
+	if m != nil {
+		return m.EvmDenomDecimals
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -689,108 +724,112 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 1673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x4f, 0x24, 0xc7,
+	0x15, 0xdf, 0x81, 0x06, 0x7a, 0x6a, 0xfe, 0x35, 0xc5, 0x2c, 0x1e, 0xef, 0x2a, 0x34, 0xe9, 0x43,
+	0x44, 0xa2, 0x35, 0x18, 0x2c, 0x94, 0x95, 0xad, 0x44, 0x61, 0x00, 0xdb, 0x90, 0x8d, 0x83, 0x0a,
+	0xac, 0x48, 0x91, 0xa2, 0x56, 0x4d, 0x77, 0xb9, 0xa7, 0x4d, 0x77, 0xd7, 0xa8, 0xaa, 0x7a, 0x76,
+	0x66, 0x93, 0x7b, 0x22, 0xe5, 0x92, 0x4f, 0x10, 0xf9, 0xe3, 0x58, 0x39, 0xf9, 0x18, 0xe5, 0xd0,
+	0x8a, 0xd8, 0x1b, 0x47, 0x3e, 0x41, 0x54, 0x7f, 0xa6, 0xe7, 0x0f, 0x28, 0x32, 0x9c, 0xa6, 0xde,
+	0xef, 0xbd, 0xfa, 0xfd, 0xea, 0xbd, 0x7a, 0x45, 0x55, 0x03, 0x5e, 0x10, 0xd1, 0x27, 0x2c, 0x8d,
+	0x33, 0xb1, 0x47, 0x86, 0xe9, 0xde, 0x70, 0x5f, 0xfe, 0xec, 0x0e, 0x18, 0x15, 0x14, 0x3a, 0xa5,
+	0x6f, 0x57, 0x82, 0xc3, 0xfd, 0x17, 0xed, 0x88, 0x46, 0x54, 0x39, 0xf7, 0xe4, 0x48, 0xc7, 0x79,
+	0x7f, 0xb5, 0xc0, 0xea, 0x05, 0x66, 0x38, 0xe5, 0x70, 0x1f, 0x54, 0xc9, 0x30, 0xf5, 0x43, 0x92,
+	0xd1, 0xb4, 0x53, 0xd9, 0xae, 0xec, 0x54, 0xbb, 0xed, 0xbb, 0xc2, 0x75, 0xc6, 0x38, 0x4d, 0x3e,
+	0xf5, 0x4a, 0x97, 0x87, 0x6c, 0x32, 0x4c, 0x4f, 0xe4, 0x10, 0xfe, 0x0a, 0x34, 0x48, 0x86, 0x7b,
+	0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0x9d, 0xa5, 0xed, 0xca, 0x8e, 0xdd, 0xed, 0xdc, 0x15, 0x6e,
+	0xdb, 0x4c, 0x9b, 0x75, 0x7b, 0xa8, 0xae, 0xed, 0x63, 0x65, 0xc2, 0x5f, 0x82, 0xda, 0xc4, 0x8f,
+	0x93, 0xa4, 0xb3, 0xac, 0x26, 0x6f, 0xde, 0x15, 0x2e, 0x9c, 0x9f, 0x8c, 0x93, 0xc4, 0x43, 0xc0,
+	0x4c, 0xc5, 0x49, 0x02, 0x8f, 0x00, 0x20, 0x23, 0xc1, 0xb0, 0x4f, 0xe2, 0x01, 0xef, 0x58, 0xdb,
+	0xcb, 0x3b, 0xcb, 0x5d, 0xef, 0xa6, 0x70, 0xab, 0xa7, 0x12, 0x3d, 0x3d, 0xbb, 0xe0, 0x77, 0x85,
+	0xbb, 0x6e, 0x48, 0xca, 0x40, 0x0f, 0x55, 0x95, 0x71, 0x1a, 0x0f, 0x38, 0xfc, 0x13, 0xa8, 0x07,
+	0x7d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe2, 0xa8, 0xb3, 0xb2, 0x5d, 0xd9, 0xa9, 0x1d, 0xfc,
+	0x64, 0x77, 0xb1, 0x6e, 0xbb, 0xc7, 0x32, 0xea, 0x58, 0x05, 0x75, 0x5f, 0x7e, 0x5f, 0xb8, 0xcf,
+	0xee, 0x0a, 0x77, 0x43, 0x53, 0xcf, 0x12, 0x78, 0xa8, 0x16, 0x4c, 0x23, 0xe1, 0x01, 0x78, 0x8e,
+	0x93, 0x84, 0xbe, 0xf5, 0xf3, 0x4c, 0x16, 0x9a, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf1, 0xce, 0xaa,
+	0x4c, 0x12, 0x6d, 0x28, 0xe7, 0xd7, 0x53, 0xdf, 0xd5, 0x88, 0x43, 0x0f, 0x34, 0x52, 0x3c, 0xf2,
+	0x03, 0x1a, 0x12, 0x9f, 0xc7, 0xef, 0x48, 0x67, 0x6d, 0xbb, 0xb2, 0x63, 0xa1, 0x5a, 0x8a, 0x47,
+	0xc7, 0x34, 0x24, 0x97, 0xf1, 0x3b, 0x02, 0x7f, 0x01, 0xd6, 0x65, 0x4c, 0x9c, 0xc5, 0x62, 0x1a,
+	0x67, 0xab, 0xb8, 0x56, 0x8a, 0x47, 0x67, 0x06, 0x57, 0xb1, 0xaf, 0x00, 0x2c, 0x77, 0xcd, 0x0f,
+	0x49, 0x10, 0xa7, 0x38, 0xe1, 0x9d, 0xea, 0x76, 0x65, 0xa7, 0x81, 0x9c, 0xc9, 0x1e, 0x9e, 0x18,
+	0xdc, 0xfb, 0xe7, 0x3a, 0xa8, 0xcd, 0xe4, 0x0a, 0x53, 0xd0, 0xea, 0xd3, 0x94, 0x70, 0x41, 0x70,
+	0xe8, 0xf7, 0x12, 0x1a, 0x5c, 0x9b, 0xa6, 0x38, 0xf9, 0x4f, 0xe1, 0xfe, 0x2c, 0x8a, 0x45, 0x3f,
+	0xef, 0xed, 0x06, 0x34, 0xdd, 0x0b, 0x28, 0x4f, 0x29, 0x37, 0x3f, 0x1f, 0xf1, 0xf0, 0x7a, 0x4f,
+	0x8c, 0x07, 0x84, 0xef, 0x9e, 0x65, 0xe2, 0xae, 0x70, 0x37, 0x75, 0xa9, 0x16, 0xa8, 0x3c, 0xd4,
+	0x2c, 0x91, 0xae, 0x04, 0xe0, 0x18, 0x34, 0x43, 0x4c, 0xfd, 0x6f, 0x28, 0xbb, 0x36, 0x6a, 0x4b,
+	0x4a, 0xed, 0xf2, 0xc7, 0xab, 0xdd, 0x14, 0x6e, 0xfd, 0xe4, 0xe8, 0xf7, 0x9f, 0x53, 0x76, 0xad,
+	0x38, 0xef, 0x0a, 0xf7, 0xb9, 0x56, 0x9f, 0x67, 0xf6, 0x50, 0x3d, 0xc4, 0xb4, 0x0c, 0x83, 0x7f,
+	0x00, 0x4e, 0x19, 0xc0, 0xf3, 0xc1, 0x80, 0x32, 0x61, 0x7a, 0xf1, 0xa3, 0x9b, 0xc2, 0x6d, 0x1a,
+	0xca, 0x4b, 0xed, 0xb9, 0x2b, 0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0d, 0xad, 0x09,
+	0x85, 0x1c, 0xd4, 0x49, 0x3c, 0xd8, 0x3f, 0xfc, 0xd8, 0x64, 0x64, 0xa9, 0x8c, 0x2e, 0x1e, 0x95,
+	0x51, 0xed, 0xf4, 0xec, 0x62, 0xff, 0xf0, 0xe3, 0x49, 0x42, 0xa6, 0xf3, 0x66, 0x69, 0x3d, 0x54,
+	0xd3, 0xa6, 0xce, 0xe6, 0x0c, 0x18, 0xd3, 0xef, 0x63, 0xde, 0x57, 0x7d, 0x5d, 0xed, 0xee, 0xdc,
+	0x14, 0x2e, 0xd0, 0x4c, 0x5f, 0x62, 0xde, 0x9f, 0xee, 0x4b, 0x6f, 0xfc, 0x0e, 0x67, 0x22, 0xce,
+	0xd3, 0x09, 0x17, 0xd0, 0x93, 0x65, 0x54, 0xb9, 0xfe, 0x43, 0xb3, 0xfe, 0xd5, 0x27, 0xaf, 0xff,
+	0xf0, 0xa1, 0xf5, 0x1f, 0xce, 0xaf, 0x5f, 0xc7, 0x94, 0xa2, 0xaf, 0x8d, 0xe8, 0xda, 0x93, 0x45,
+	0x5f, 0x3f, 0x24, 0xfa, 0x7a, 0x5e, 0x54, 0xc7, 0xc8, 0x66, 0x5f, 0xa8, 0x44, 0xc7, 0x7e, 0x7a,
+	0xb3, 0xdf, 0x2b, 0x6a, 0xb3, 0x44, 0xb4, 0xdc, 0x5f, 0x40, 0x3b, 0xa0, 0x19, 0x17, 0x12, 0xcb,
+	0xe8, 0x20, 0x21, 0x46, 0xb3, 0xaa, 0x34, 0xcf, 0x1e, 0xa5, 0xf9, 0xd2, 0xfc, 0x2d, 0x7a, 0x80,
+	0xcf, 0x43, 0x1b, 0xf3, 0xb0, 0x56, 0x1f, 0x00, 0x67, 0x40, 0x04, 0x61, 0xbc, 0x97, 0xb3, 0xc8,
+	0x28, 0x03, 0xa5, 0x7c, 0xfa, 0x28, 0x65, 0x73, 0x0e, 0x16, 0xb9, 0x3c, 0xd4, 0x9a, 0x42, 0x5a,
+	0xf1, 0x5b, 0xd0, 0x8c, 0xe5, 0x32, 0x7a, 0x79, 0x62, 0xf4, 0x6a, 0x4a, 0xef, 0xf8, 0x51, 0x7a,
+	0xe6, 0x30, 0xcf, 0x33, 0x79, 0xa8, 0x31, 0x01, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f,
+	0x12, 0x1c, 0xc4, 0x84, 0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xe2, 0x51, 0x7a, 0x1f, 0x6a, 0xbd, 0xfb,
+	0x6c, 0x1e, 0x72, 0x24, 0xf8, 0x85, 0xc6, 0xb4, 0x6c, 0x08, 0xea, 0x3d, 0xc2, 0x92, 0x38, 0x33,
+	0x82, 0x0d, 0x25, 0x78, 0xf4, 0x28, 0x41, 0xd3, 0xa7, 0xb3, 0x3c, 0x1e, 0xaa, 0x69, 0xb3, 0x54,
+	0x49, 0x68, 0x16, 0xd2, 0x89, 0xca, 0xfa, 0xd3, 0x55, 0x66, 0x79, 0x3c, 0x54, 0xd3, 0xa6, 0x56,
+	0x19, 0x81, 0x0d, 0xcc, 0x18, 0x7d, 0xbb, 0x50, 0x43, 0xa8, 0xc4, 0xbe, 0x7c, 0x94, 0xd8, 0x0b,
+	0x2d, 0xf6, 0x00, 0x9d, 0x87, 0xd6, 0x15, 0x3a, 0x57, 0xc5, 0x1c, 0xc0, 0x88, 0xe1, 0xf1, 0x82,
+	0x70, 0xfb, 0xe9, 0x9b, 0x77, 0x9f, 0xcd, 0x43, 0x8e, 0x04, 0xe7, 0x64, 0xff, 0x0c, 0xda, 0x29,
+	0x61, 0x11, 0xf1, 0x33, 0x22, 0xf8, 0x20, 0x89, 0x85, 0x11, 0x7e, 0xfe, 0xf4, 0xf3, 0xf8, 0x10,
+	0x9f, 0x87, 0xa0, 0x82, 0xbf, 0x32, 0x68, 0x79, 0x38, 0x78, 0x1f, 0x67, 0x51, 0x1f, 0xc7, 0x46,
+	0x76, 0xf3, 0xe9, 0x87, 0x63, 0x9e, 0xc9, 0x43, 0x8d, 0x09, 0x50, 0xf6, 0x4f, 0x80, 0xb3, 0x20,
+	0x9f, 0xf4, 0xcf, 0x07, 0x4f, 0xef, 0x9f, 0x59, 0x1e, 0xf9, 0xf8, 0x51, 0xa6, 0x52, 0x39, 0xb7,
+	0xec, 0xa6, 0xd3, 0x3a, 0xb7, 0xec, 0x96, 0xe3, 0x9c, 0x5b, 0xb6, 0xe3, 0xac, 0x9f, 0x5b, 0xf6,
+	0x86, 0xd3, 0x46, 0x8d, 0x31, 0x4d, 0xa8, 0x3f, 0xfc, 0x44, 0x4f, 0x42, 0x35, 0xf2, 0x16, 0x73,
+	0xf3, 0x37, 0x12, 0x35, 0x03, 0x2c, 0x70, 0x32, 0xe6, 0xa6, 0x54, 0xc8, 0xd1, 0x05, 0x9c, 0xb9,
+	0xb5, 0xf7, 0xc0, 0xca, 0xa5, 0x90, 0xcf, 0x46, 0x07, 0x2c, 0x5f, 0x93, 0xb1, 0x7e, 0x8d, 0x20,
+	0x39, 0x84, 0x6d, 0xb0, 0x32, 0xc4, 0x49, 0xae, 0xdf, 0x9f, 0x55, 0xa4, 0x0d, 0xef, 0x02, 0xb4,
+	0xae, 0x18, 0xce, 0x38, 0x0e, 0x44, 0x4c, 0xb3, 0x37, 0x34, 0xe2, 0x10, 0x02, 0x4b, 0xdd, 0x8a,
+	0x7a, 0xae, 0x1a, 0xc3, 0x9f, 0x03, 0x2b, 0xa1, 0x11, 0xef, 0x2c, 0x6d, 0x2f, 0xef, 0xd4, 0x0e,
+	0x9e, 0xdf, 0x7f, 0x01, 0xbe, 0xa1, 0x11, 0x52, 0x21, 0xde, 0xbf, 0x96, 0xc0, 0xf2, 0x1b, 0x1a,
+	0xc1, 0x0e, 0x58, 0xc3, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x69, 0x62, 0xc2, 0x4d, 0xb0, 0x2a, 0xe8,
+	0x20, 0x0e, 0x34, 0x5d, 0x15, 0x19, 0x4b, 0x0a, 0x87, 0x58, 0x60, 0xf5, 0xae, 0xa8, 0x23, 0x35,
+	0x86, 0x07, 0xa0, 0xae, 0x32, 0xf3, 0xb3, 0x3c, 0xed, 0x11, 0xa6, 0x9e, 0x07, 0x56, 0xb7, 0x75,
+	0x5b, 0xb8, 0x35, 0x85, 0x7f, 0xa5, 0x60, 0x34, 0x6b, 0xc0, 0x57, 0x60, 0x4d, 0x8c, 0x66, 0x6f,
+	0xf6, 0x8d, 0xdb, 0xc2, 0x6d, 0x89, 0x69, 0x9a, 0xf2, 0xe2, 0x46, 0xab, 0x62, 0x24, 0x7f, 0xe1,
+	0x1e, 0xb0, 0x85, 0x7c, 0x2c, 0x86, 0x64, 0xa4, 0x2e, 0x6f, 0xab, 0xdb, 0xbe, 0x2d, 0x5c, 0x67,
+	0x26, 0xfc, 0x4c, 0xfa, 0xd0, 0x9a, 0x18, 0xa9, 0x01, 0x7c, 0x05, 0x80, 0x5e, 0x92, 0x52, 0xd0,
+	0x57, 0x6f, 0xe3, 0xb6, 0x70, 0xab, 0x0a, 0x55, 0xdc, 0xd3, 0x21, 0xf4, 0xc0, 0x8a, 0xe6, 0x56,
+	0x0f, 0xd0, 0x6e, 0xfd, 0xb6, 0x70, 0xed, 0x84, 0x46, 0x9a, 0x53, 0xbb, 0x64, 0xa9, 0x18, 0x49,
+	0xe9, 0x90, 0x84, 0xea, 0x76, 0xb3, 0xd1, 0xc4, 0xf4, 0xfe, 0xbe, 0x04, 0xec, 0xab, 0x11, 0x22,
+	0x3c, 0x4f, 0x04, 0xfc, 0x1c, 0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x4a, 0xdb, 0x7d,
+	0x39, 0xbd, 0x69, 0x16, 0x23, 0x3c, 0xd4, 0x9a, 0x40, 0x47, 0xa6, 0xfe, 0x6d, 0xb0, 0xd2, 0x4b,
+	0x28, 0x4d, 0x55, 0x27, 0xd4, 0x91, 0x36, 0x20, 0x52, 0x55, 0x53, 0xbb, 0xbc, 0xac, 0xde, 0xf9,
+	0x3f, 0xbd, 0xbf, 0xcb, 0x0b, 0xad, 0xd2, 0xdd, 0x34, 0x6f, 0xfd, 0xa6, 0xd6, 0x36, 0xf3, 0x3d,
+	0x59, 0x5b, 0xd5, 0x4a, 0x0e, 0x58, 0x66, 0x44, 0xa8, 0x4d, 0xab, 0x23, 0x39, 0x84, 0x2f, 0x80,
+	0xcd, 0xc8, 0x90, 0x30, 0x41, 0x42, 0xb5, 0x39, 0x36, 0x2a, 0x6d, 0xf8, 0x21, 0xb0, 0x23, 0xcc,
+	0xfd, 0x9c, 0x93, 0x50, 0xef, 0x04, 0x5a, 0x8b, 0x30, 0xff, 0x9a, 0x93, 0xf0, 0x53, 0xeb, 0x6f,
+	0xdf, 0xb9, 0xcf, 0x3c, 0x0c, 0x6a, 0x47, 0x41, 0x40, 0x38, 0xbf, 0xca, 0x07, 0x09, 0xf9, 0x3f,
+	0x1d, 0x76, 0x00, 0xea, 0x5c, 0x50, 0x86, 0x23, 0xe2, 0x5f, 0x93, 0xb1, 0xe9, 0x33, 0xdd, 0x35,
+	0x06, 0xff, 0x2d, 0x19, 0x73, 0x34, 0x6b, 0x18, 0x89, 0xef, 0x2c, 0x50, 0xbb, 0x62, 0x38, 0x20,
+	0xe6, 0x85, 0x2f, 0x7b, 0x55, 0x9a, 0xcc, 0x48, 0x18, 0x4b, 0x6a, 0x8b, 0x38, 0x25, 0x34, 0x17,
+	0xe6, 0x3c, 0x4d, 0x4c, 0x39, 0x83, 0x11, 0x32, 0x22, 0x81, 0x2a, 0xa3, 0x85, 0x8c, 0x05, 0x0f,
+	0x41, 0x23, 0x8c, 0xb9, 0xfa, 0x58, 0xe3, 0x02, 0x07, 0xd7, 0x3a, 0xfd, 0xae, 0x73, 0x5b, 0xb8,
+	0x75, 0xe3, 0xb8, 0x94, 0x38, 0x9a, 0xb3, 0xe0, 0x67, 0xa0, 0x35, 0x9d, 0xa6, 0x56, 0xab, 0x3f,
+	0x8f, 0xba, 0xf0, 0xb6, 0x70, 0x9b, 0x65, 0xa8, 0xf2, 0xa0, 0x05, 0x5b, 0xee, 0x74, 0x48, 0x7a,
+	0x79, 0xa4, 0x9a, 0xcf, 0x46, 0xda, 0x90, 0x68, 0x12, 0xa7, 0xb1, 0x50, 0xcd, 0xb6, 0x82, 0xb4,
+	0x01, 0x3f, 0x03, 0x55, 0x3a, 0x24, 0x8c, 0xc5, 0x21, 0xe1, 0x1d, 0xf0, 0x23, 0xbe, 0xf4, 0xd0,
+	0x34, 0x5e, 0x26, 0x67, 0x3e, 0x44, 0x53, 0x92, 0x52, 0x36, 0xee, 0xd4, 0xa6, 0xc9, 0x69, 0xc7,
+	0xef, 0x14, 0x8e, 0xe6, 0x2c, 0xd8, 0x05, 0xd0, 0x4c, 0x63, 0x44, 0xe4, 0x2c, 0xf3, 0xd5, 0xf9,
+	0xaf, 0xab, 0xb9, 0xea, 0x14, 0x6a, 0x2f, 0x52, 0xce, 0x13, 0x2c, 0x30, 0xba, 0x87, 0xc0, 0x5f,
+	0x03, 0xa8, 0xf7, 0xc4, 0xff, 0x96, 0xd3, 0xf2, 0x53, 0x55, 0x3f, 0x2d, 0x94, 0xbe, 0xf6, 0x9a,
+	0x35, 0x3b, 0xda, 0x3a, 0xe7, 0xd4, 0x64, 0x71, 0x6e, 0xd9, 0x96, 0xb3, 0x72, 0x6e, 0xd9, 0x6b,
+	0x8e, 0x5d, 0xd6, 0xcf, 0x64, 0x81, 0x36, 0x26, 0xf6, 0xcc, 0xf2, 0xba, 0xbf, 0xf9, 0xfe, 0x66,
+	0xab, 0xf2, 0xc3, 0xcd, 0x56, 0xe5, 0xbf, 0x37, 0x5b, 0x95, 0x7f, 0xbc, 0xdf, 0x7a, 0xf6, 0xc3,
+	0xfb, 0xad, 0x67, 0xff, 0x7e, 0xbf, 0xf5, 0xec, 0x8f, 0xb3, 0xf7, 0x03, 0x19, 0xca, 0xeb, 0x61,
+	0xfa, 0xdf, 0x87, 0x91, 0x44, 0xf4, 0x1d, 0xd1, 0x5b, 0x55, 0xff, 0x57, 0xf8, 0xe4, 0x7f, 0x03,
+	0x00, 0xef, 0x51, 0x1f, 0x7f, 0x9d, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +852,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvmDenomDecimals != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EvmDenomDecimals))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxInitcodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitcodeSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1567,6 +1621,15 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitcodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitcodeSize))
+	}
+	if m.EvmDenomDecimals != 0 {
+		n += 1 + sovEvm(uint64(m.EvmDenomDecimals))
+	}
 	return n
 }
 
@@ -2063,6 +2126,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitcodeSize", wireType)
+			}
+			m.MaxInitcodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitcodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenomDecimals", wireType)
+			}
+			m.EvmDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmDenomDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
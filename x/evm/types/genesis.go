@@ -17,6 +17,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -29,6 +30,21 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of a FractionalBalance fields. The amount
+// must be lower than the given evm denom scale.
+func (fb FractionalBalance) Validate(scale *big.Int) error {
+	if err := ethermint.ValidateAddress(fb.Address); err != nil {
+		return err
+	}
+	if fb.Amount.IsNil() || !fb.Amount.IsPositive() {
+		return fmt.Errorf("fractional balance must be positive: %s", fb.Amount)
+	}
+	if fb.Amount.BigInt().Cmp(scale) >= 0 {
+		return fmt.Errorf("fractional balance %s must be lower than the evm denom scale %s", fb.Amount, scale)
+	}
+	return nil
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -60,5 +76,25 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.FractionalBalances) > 0 && !gs.Params.IsScaledDenom() {
+		return fmt.Errorf("fractional balances require an evm denom with less than %d decimals", EVMDecimals)
+	}
+
+	scale := gs.Params.DenomScale()
+	seenBalances := make(map[string]bool)
+	for _, fb := range gs.FractionalBalances {
+		if seenBalances[fb.Address] {
+			return fmt.Errorf("duplicated fractional balance %s", fb.Address)
+		}
+		if err := fb.Validate(scale); err != nil {
+			return fmt.Errorf("invalid fractional balance %s: %w", fb.Address, err)
+		}
+		seenBalances[fb.Address] = true
+	}
+
+	return nil
 }
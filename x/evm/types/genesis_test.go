@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -83,6 +84,10 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	satParams := DefaultParams()
+	satParams.EvmDenom = "sat"
+	satParams.EvmDenomDecimals = 8
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -211,6 +216,57 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid fractional balance",
+			genState: &GenesisState{
+				Params: satParams,
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(9_999_999_999)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "fractional balance without scaled denom",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(1)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "fractional balance greater than the denom scale",
+			genState: &GenesisState{
+				Params: satParams,
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(10_000_000_000)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "zero fractional balance",
+			genState: &GenesisState{
+				Params: satParams,
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.ZeroInt()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated fractional balance",
+			genState: &GenesisState{
+				Params: satParams,
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(1)},
+					{Address: suite.address, Amount: sdkmath.NewInt(2)},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -16,6 +16,7 @@
 package types
 
 import (
+	"context"
 	"math/big"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -40,6 +41,7 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, account authtypes.AccountI)
 	RemoveAccount(ctx sdk.Context, account authtypes.AccountI)
 	GetParams(ctx sdk.Context) (params authtypes.Params)
+	Accounts(c context.Context, req *authtypes.QueryAccountsRequest) (*authtypes.QueryAccountsResponse, error)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -69,8 +71,13 @@ type FeeMarketKeeper interface {
 
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
+	// Must be called before the tx is executed, if return an error, the tx is not executed and fails consuming
+	// all of its gas limit.
+	PreTxProcessing(ctx sdk.Context, msg core.Message) error
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
+	// Must be called after tx failed, the error returned only reverts the state changes made by the hook.
+	PostTxFailed(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
 type (
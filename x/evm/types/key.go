@@ -41,6 +41,8 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixFractionalBalance
+	prefixFractionalBalanceTotal
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +51,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientReservedNonce
 )
 
 // KVStore key prefixes
@@ -56,6 +59,9 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixFractionalBalance = []byte{prefixFractionalBalance}
+	KeyFractionalBalanceTotal  = []byte{prefixFractionalBalanceTotal}
 )
 
 // Transient Store key prefixes
@@ -64,6 +70,8 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientReservedNonce = []byte{prefixTransientReservedNonce}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
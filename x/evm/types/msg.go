@@ -38,6 +38,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -45,6 +46,7 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgEthereumCall{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -53,6 +55,8 @@ var (
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
 	TypeMsgEthereumTx = "ethereum_tx"
+	// TypeMsgEthereumCall defines the type string of a Cosmos signed EVM call
+	TypeMsgEthereumCall = "ethereum_call"
 )
 
 // NewTx returns a reference to a new Ethereum transaction message.
@@ -447,3 +451,115 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgEthereumCall returns a reference to a new MsgEthereumCall. A nil
+// recipient creates a new contract with the given data as init code.
+func NewMsgEthereumCall(
+	sender sdk.AccAddress, to *common.Address, data []byte, value *big.Int, gasLimit uint64,
+) *MsgEthereumCall {
+	msg := &MsgEthereumCall{
+		Sender:   sender.String(),
+		Data:     common.CopyBytes(data),
+		Value:    sdkmath.ZeroInt(),
+		GasLimit: gasLimit,
+	}
+	if to != nil {
+		msg.To = to.Hex()
+	}
+	if value != nil {
+		msg.Value = sdkmath.NewIntFromBigInt(value)
+	}
+	return msg
+}
+
+// Route returns the route value of a MsgEthereumCall.
+func (m MsgEthereumCall) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgEthereumCall.
+func (m MsgEthereumCall) Type() string { return TypeMsgEthereumCall }
+
+// GetSigners returns the expected signers for a MsgEthereumCall message.
+func (m MsgEthereumCall) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgEthereumCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.To != "" {
+		if err := types.ValidateAddress(m.To); err != nil {
+			return errorsmod.Wrap(err, "invalid recipient address")
+		}
+	}
+
+	if !m.Value.IsNil() && m.Value.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", m.Value)
+	}
+
+	if m.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must not be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgEthereumCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetFrom returns the EVM address the call is executed from. Accounts with a
+// 20 byte address map to the same hex address, while longer addresses (e.g.
+// interchain or module accounts) are mapped to the last 20 bytes of the
+// keccak256 hash of the address.
+func (m MsgEthereumCall) GetFrom() common.Address {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return common.Address{}
+	}
+	return SenderToEVMAddress(addr)
+}
+
+// GetTo returns the recipient of the call or nil for contract creations.
+func (m MsgEthereumCall) GetTo() *common.Address {
+	if m.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(m.To)
+	return &to
+}
+
+// GetValue returns the amount transferred with the call, in the EVM denom.
+func (m MsgEthereumCall) GetValue() *big.Int {
+	if m.Value.IsNil() {
+		return new(big.Int)
+	}
+	return m.Value.BigInt()
+}
+
+// AsTransaction returns the call as an unsigned legacy transaction with a zero
+// gas price, executed with the given nonce.
+func (m MsgEthereumCall) AsTransaction(nonce uint64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: new(big.Int),
+		Gas:      m.GasLimit,
+		To:       m.GetTo(),
+		Value:    m.GetValue(),
+		Data:     common.CopyBytes(m.Data),
+	})
+}
+
+// SenderToEVMAddress returns the EVM address a Cosmos account executes
+// MsgEthereumCall messages from.
+func SenderToEVMAddress(addr sdk.AccAddress) common.Address {
+	if len(addr) == common.AddressLength {
+		return common.BytesToAddress(addr)
+	}
+	return common.BytesToAddress(crypto.Keccak256(addr)[12:])
+}
@@ -586,6 +586,59 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumCall_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg      string
+		malleate func(msg *types.MsgEthereumCall)
+		expPass  bool
+	}{
+		{"pass - call", func(msg *types.MsgEthereumCall) {}, true},
+		{"pass - contract creation", func(msg *types.MsgEthereumCall) { msg.To = "" }, true},
+		{"pass - nil value", func(msg *types.MsgEthereumCall) { msg.Value = sdkmath.Int{} }, true},
+		{"fail - invalid sender", func(msg *types.MsgEthereumCall) { msg.Sender = "foobar" }, false},
+		{"fail - invalid recipient", func(msg *types.MsgEthereumCall) { msg.To = invalidFromAddress }, false},
+		{"fail - negative value", func(msg *types.MsgEthereumCall) { msg.Value = sdkmath.NewInt(-1) }, false},
+		{"fail - zero gas limit", func(msg *types.MsgEthereumCall) { msg.GasLimit = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			msg := types.NewMsgEthereumCall(sender, &suite.to, []byte("data"), suite.hundredBigInt, 21000)
+			tc.malleate(msg)
+
+			err := msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumCall_Getters() {
+	sender := sdk.AccAddress(suite.from.Bytes())
+	msg := types.NewMsgEthereumCall(sender, &suite.to, nil, suite.hundredBigInt, 21000)
+
+	suite.Require().Equal(suite.from, msg.GetFrom())
+	suite.Require().Equal(&suite.to, msg.GetTo())
+	suite.Require().Equal(suite.hundredBigInt, msg.GetValue())
+	suite.Require().Equal([]sdk.AccAddress{sender}, msg.GetSigners())
+
+	msg = types.NewMsgEthereumCall(sender, nil, nil, nil, 21000)
+	suite.Require().Nil(msg.GetTo())
+	suite.Require().Equal(0, msg.GetValue().Sign())
+
+	// addresses longer than 20 bytes are hashed
+	longAddr := sdk.AccAddress(crypto.Keccak256([]byte("ica")))
+	suite.Require().Equal(
+		common.BytesToAddress(crypto.Keccak256(longAddr)[12:]),
+		types.SenderToEVMAddress(longAddr),
+	)
+}
+
 func (suite *MsgsTestSuite) TestFromEthereumTx() {
 	privkey, _ := ethsecp256k1.GenerateKey()
 	ethPriv, err := privkey.ToECDSA()
@@ -21,6 +21,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/types"
@@ -35,8 +36,20 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultMaxCodeSize is the EIP-170 contract code size limit (i.e 24KB)
+	DefaultMaxCodeSize = uint64(params.MaxCodeSize)
+	// DefaultMaxInitcodeSize is the EIP-3860 initcode size limit (i.e 2 * DefaultMaxCodeSize)
+	DefaultMaxInitcodeSize = 2 * DefaultMaxCodeSize
 )
 
+// EVMDecimals is the number of decimals of the EVM native token balances
+// (i.e wei).
+const EVMDecimals = uint32(18)
+
+// InitcodeWordGas is the gas charged per 32-byte word of initcode on contract
+// creation, as defined by EIP-3860.
+const InitcodeWordGas = uint64(2)
+
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
 // EVM interpreter. These EIPs are applied in order and can override the
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
@@ -45,7 +58,13 @@ var (
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529, 3855}
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
+func NewParams(
+	evmDenom string,
+	allowUnprotectedTxs, enableCreate, enableCall bool,
+	config ChainConfig,
+	extraEIPs []int64,
+	maxCodeSize, maxInitcodeSize uint64,
+) Params {
 	return Params{
 		EvmDenom:            evmDenom,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
@@ -53,6 +72,8 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		MaxCodeSize:         maxCodeSize,
+		MaxInitcodeSize:     maxInitcodeSize,
 	}
 }
 
@@ -66,6 +87,8 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           AvailableExtraEIPs,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		MaxCodeSize:         DefaultMaxCodeSize,
+		MaxInitcodeSize:     DefaultMaxInitcodeSize,
 	}
 }
 
@@ -91,9 +114,57 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCodeSizes(p.MaxCodeSize, p.MaxInitcodeSize); err != nil {
+		return err
+	}
+
+	if err := validateDenomDecimals(p.EvmDenomDecimals); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
+// ValidateInitcode returns an error if the initcode of a contract creation
+// exceeds the max initcode size. No limit is enforced if the size is 0.
+func (p Params) ValidateInitcode(initcode []byte) error {
+	if p.MaxInitcodeSize == 0 || uint64(len(initcode)) <= p.MaxInitcodeSize {
+		return nil
+	}
+	return errorsmod.Wrapf(ErrMaxInitcodeSizeExceeded, "size %d, limit %d", len(initcode), p.MaxInitcodeSize)
+}
+
+// InitcodeGas returns the EIP-3860 gas cost charged for the initcode words of a
+// contract creation. It returns 0 if the max initcode size is not set.
+func (p Params) InitcodeGas(initcode []byte) uint64 {
+	if p.MaxInitcodeSize == 0 {
+		return 0
+	}
+	words := (uint64(len(initcode)) + 31) / 32
+	return words * InitcodeWordGas
+}
+
+// DenomDecimals returns the number of decimals of the evm denom bank token,
+// defaulting to 18 decimals if not set.
+func (p Params) DenomDecimals() uint32 {
+	if p.EvmDenomDecimals == 0 {
+		return EVMDecimals
+	}
+	return p.EvmDenomDecimals
+}
+
+// DenomScale returns the amount of EVM balance units (i.e wei) per unit of the
+// evm denom bank token, that is 10^(18 - decimals).
+func (p Params) DenomScale() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(EVMDecimals-p.DenomDecimals())), nil)
+}
+
+// IsScaledDenom returns true if the evm denom bank token has less than 18
+// decimals, in which case the EVM balances are tracked with fractional balances.
+func (p Params) IsScaledDenom() bool {
+	return p.DenomDecimals() < EVMDecimals
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -135,6 +206,23 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateCodeSizes(maxCodeSize, maxInitcodeSize uint64) error {
+	if maxCodeSize != 0 && maxInitcodeSize != 0 && maxInitcodeSize < maxCodeSize {
+		return fmt.Errorf(
+			"max initcode size cannot be lower than the max code size: %d < %d",
+			maxInitcodeSize, maxCodeSize,
+		)
+	}
+	return nil
+}
+
+func validateDenomDecimals(decimals uint32) error {
+	if decimals > EVMDecimals {
+		return fmt.Errorf("evm denom decimals cannot be greater than %d: %d", EVMDecimals, decimals)
+	}
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/params"
@@ -18,9 +19,42 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips),
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, DefaultMaxCodeSize, DefaultMaxInitcodeSize),
 			false,
 		},
+		{
+			"valid without code size limits",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, 0, 0),
+			false,
+		},
+		{
+			"valid max code size greater than the EIP-170 limit",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, 2*DefaultMaxCodeSize, 0),
+			false,
+		},
+		{
+			"invalid max initcode size lower than max code size",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, DefaultMaxCodeSize, DefaultMaxCodeSize-1),
+			true,
+		},
+		{
+			"valid 8 decimals evm denom",
+			Params{
+				EvmDenom:         "sat",
+				EvmDenomDecimals: 8,
+				ChainConfig:      DefaultChainConfig(),
+			},
+			false,
+		},
+		{
+			"invalid evm denom decimals",
+			Params{
+				EvmDenom:         "stake",
+				EvmDenomDecimals: 19,
+				ChainConfig:      DefaultChainConfig(),
+			},
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -56,12 +90,41 @@ func TestParamsValidate(t *testing.T) {
 
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
-	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
+	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, DefaultMaxCodeSize, DefaultMaxInitcodeSize)
 	actual := params.EIPs()
 
 	require.Equal(t, []int([]int{2929, 1884, 1344}), actual)
 }
 
+func TestParamsInitcode(t *testing.T) {
+	params := DefaultParams()
+
+	require.NoError(t, params.ValidateInitcode(make([]byte, DefaultMaxInitcodeSize)))
+	require.ErrorIs(t, params.ValidateInitcode(make([]byte, DefaultMaxInitcodeSize+1)), ErrMaxInitcodeSizeExceeded)
+	require.Equal(t, uint64(0), params.InitcodeGas(nil))
+	require.Equal(t, InitcodeWordGas, params.InitcodeGas(make([]byte, 1)))
+	require.Equal(t, 2*InitcodeWordGas, params.InitcodeGas(make([]byte, 33)))
+
+	params.MaxInitcodeSize = 0
+	require.NoError(t, params.ValidateInitcode(make([]byte, DefaultMaxInitcodeSize+1)))
+	require.Equal(t, uint64(0), params.InitcodeGas(make([]byte, 33)))
+}
+
+func TestParamsDenomScale(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.IsScaledDenom())
+	require.Equal(t, EVMDecimals, params.DenomDecimals())
+	require.Equal(t, big.NewInt(1), params.DenomScale())
+
+	params.EvmDenomDecimals = 18
+	require.False(t, params.IsScaledDenom())
+	require.Equal(t, big.NewInt(1), params.DenomScale())
+
+	params.EvmDenomDecimals = 8
+	require.True(t, params.IsScaledDenom())
+	require.Equal(t, big.NewInt(10_000_000_000), params.DenomScale())
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
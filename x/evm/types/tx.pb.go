@@ -346,6 +346,56 @@ func (m *MsgEthereumTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEthereumTxResponse proto.InternalMessageInfo
 
+// MsgEthereumCall executes an EVM call or contract creation signed with a
+// regular Cosmos transaction. The EVM sender is derived from the sender
+// address, and the EVM gas is charged to the Cosmos transaction gas meter.
+type MsgEthereumCall struct {
+	// sender is the bech32 address of the Cosmos signer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is the hex address of the called account. It is empty for contract
+	// creation.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// data is the call input or the contract creation code.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// value is the amount of the EVM denom transferred with the call.
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+	// gas_limit is the EVM gas limit of the call.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgEthereumCall) Reset()         { *m = MsgEthereumCall{} }
+func (m *MsgEthereumCall) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumCall) ProtoMessage()    {}
+func (*MsgEthereumCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
+}
+func (m *MsgEthereumCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumCall.Merge(m, src)
+}
+func (m *MsgEthereumCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumCall proto.InternalMessageInfo
+
 // MsgUpdateParams defines a Msg for updating the x/evm module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -359,7 +409,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +461,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,6 +497,7 @@ func init() {
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
+	proto.RegisterType((*MsgEthereumCall)(nil), "ethermint.evm.v1.MsgEthereumCall")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
 }
@@ -454,68 +505,73 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0x13, 0xe7, 0xd7, 0x24, 0xdf, 0xfd, 0x56, 0xa3, 0xad, 0xea, 0x44, 0x34, 0x0e, 0x96,
-	0x80, 0xb4, 0x52, 0x6c, 0x75, 0x41, 0x3d, 0xec, 0xa9, 0x9b, 0xee, 0xb6, 0x6a, 0xb5, 0x2b, 0x2a,
-	0x93, 0x5e, 0x28, 0x52, 0x34, 0x6b, 0xcf, 0x4e, 0x2c, 0x62, 0x8f, 0xe5, 0x99, 0x58, 0x09, 0x12,
-	0x97, 0x9e, 0xb8, 0x01, 0xe2, 0x1f, 0xe0, 0xc0, 0x89, 0x13, 0x12, 0xfd, 0x03, 0x38, 0x56, 0x9c,
-	0x2a, 0xb8, 0x20, 0x0e, 0x01, 0x65, 0x91, 0x90, 0xf6, 0x06, 0x7f, 0x01, 0x9a, 0x19, 0x67, 0xb3,
-	0x69, 0xd8, 0x16, 0x4a, 0x11, 0x27, 0xcf, 0x9b, 0xf7, 0xe6, 0xbd, 0x37, 0x9f, 0xcf, 0x67, 0x66,
-	0x0c, 0x1a, 0x98, 0x0f, 0x71, 0x12, 0x06, 0x11, 0x77, 0x70, 0x1a, 0x3a, 0xe9, 0x35, 0x87, 0x4f,
-	0xec, 0x38, 0xa1, 0x9c, 0xc2, 0x0b, 0xa7, 0x2e, 0x1b, 0xa7, 0xa1, 0x9d, 0x5e, 0x6b, 0x5e, 0xf2,
-	0x28, 0x0b, 0x29, 0x73, 0x42, 0x46, 0x44, 0x64, 0xc8, 0x88, 0x0a, 0x6d, 0x36, 0x94, 0x63, 0x20,
-	0x2d, 0x47, 0x19, 0x99, 0xab, 0xb9, 0x56, 0x40, 0x24, 0x53, 0xbe, 0x4d, 0x42, 0x09, 0x55, 0x6b,
-	0xc4, 0x28, 0x9b, 0x7d, 0x85, 0x50, 0x4a, 0x46, 0xd8, 0x41, 0x71, 0xe0, 0xa0, 0x28, 0xa2, 0x1c,
-	0xf1, 0x80, 0x46, 0x8b, 0x7c, 0x8d, 0xcc, 0x2b, 0xad, 0xc3, 0xf1, 0x91, 0x83, 0xa2, 0xa9, 0x72,
-	0x59, 0x1f, 0x6b, 0xe0, 0x7f, 0x07, 0x8c, 0xec, 0x89, 0x82, 0x78, 0x1c, 0xf6, 0x27, 0xb0, 0x03,
-	0x74, 0x1f, 0x71, 0x64, 0x68, 0x6d, 0xad, 0x53, 0xdb, 0xda, 0xb4, 0xd5, 0x5a, 0x7b, 0xb1, 0xd6,
-	0xde, 0x89, 0xa6, 0xae, 0x8c, 0x80, 0x0d, 0xa0, 0xb3, 0xe0, 0x03, 0x6c, 0xe4, 0xdb, 0x5a, 0x47,
-	0xeb, 0x15, 0x4f, 0x66, 0xa6, 0xd6, 0x75, 0xe5, 0x14, 0x34, 0x81, 0x3e, 0x44, 0x6c, 0x68, 0x14,
-	0xda, 0x5a, 0xa7, 0xda, 0xab, 0xfd, 0x3e, 0x33, 0xcb, 0xc9, 0x28, 0xde, 0xb6, 0xba, 0x96, 0x2b,
-	0x1d, 0x10, 0x02, 0xfd, 0x28, 0xa1, 0xa1, 0xa1, 0x8b, 0x00, 0x57, 0x8e, 0xb7, 0xf5, 0x8f, 0x3e,
-	0x37, 0x73, 0xd6, 0xd7, 0x79, 0x50, 0xd9, 0xc7, 0x04, 0x79, 0xd3, 0xfe, 0x04, 0x6e, 0x82, 0x62,
-	0x44, 0x23, 0x0f, 0xcb, 0x6e, 0x74, 0x57, 0x19, 0xf0, 0x36, 0xa8, 0x12, 0x24, 0x90, 0x0b, 0x3c,
-	0x55, 0xbd, 0xda, 0xbb, 0xfa, 0xe3, 0xcc, 0x7c, 0x9d, 0x04, 0x7c, 0x38, 0x3e, 0xb4, 0x3d, 0x1a,
-	0x66, 0x78, 0x66, 0x9f, 0x2e, 0xf3, 0xdf, 0x77, 0xf8, 0x34, 0xc6, 0xcc, 0xbe, 0x13, 0x71, 0xb7,
-	0x42, 0x10, 0xbb, 0x27, 0xd6, 0xc2, 0x16, 0x28, 0x10, 0xc4, 0x64, 0x97, 0x7a, 0xaf, 0x3e, 0x9f,
-	0x99, 0x95, 0xdb, 0x88, 0xed, 0x07, 0x61, 0xc0, 0x5d, 0xe1, 0x80, 0x1b, 0x20, 0xcf, 0x69, 0xd6,
-	0x63, 0x9e, 0x53, 0x78, 0x17, 0x14, 0x53, 0x34, 0x1a, 0x63, 0xa3, 0x28, 0x8b, 0xbe, 0xf5, 0xd7,
-	0x8b, 0xce, 0x67, 0x66, 0x69, 0x27, 0xa4, 0xe3, 0x88, 0xbb, 0x2a, 0x85, 0x40, 0x40, 0xe2, 0x5c,
-	0x6a, 0x6b, 0x9d, 0x7a, 0x86, 0x68, 0x1d, 0x68, 0xa9, 0x51, 0x96, 0x13, 0x5a, 0x2a, 0xac, 0xc4,
-	0xa8, 0x28, 0x2b, 0x11, 0x16, 0x33, 0xaa, 0xca, 0x62, 0xdb, 0x1b, 0x02, 0xab, 0x6f, 0x1f, 0x75,
-	0x4b, 0xfd, 0xc9, 0x2e, 0xe2, 0xc8, 0xfa, 0xad, 0x00, 0xea, 0x3b, 0x9e, 0x87, 0x19, 0xdb, 0x0f,
-	0x18, 0xef, 0x4f, 0xe0, 0x03, 0x50, 0xf1, 0x86, 0x28, 0x88, 0x06, 0x81, 0x2f, 0xc1, 0xab, 0xf6,
-	0x6e, 0xfc, 0xad, 0x6e, 0xcb, 0x37, 0xc5, 0xea, 0x3b, 0xbb, 0x27, 0x33, 0xb3, 0xec, 0xa9, 0xa1,
-	0x9b, 0x0d, 0xfc, 0x25, 0x2d, 0xf9, 0x73, 0x69, 0x29, 0xfc, 0x73, 0x5a, 0xf4, 0x67, 0xd3, 0x52,
-	0x5c, 0xa7, 0xa5, 0xf4, 0xf2, 0x68, 0x29, 0x9f, 0xa1, 0xe5, 0x01, 0xa8, 0x20, 0x89, 0x2d, 0x66,
-	0x46, 0xa5, 0x5d, 0xe8, 0xd4, 0xb6, 0x2e, 0xdb, 0x4f, 0x1f, 0x74, 0x5b, 0xa1, 0xdf, 0x1f, 0xc7,
-	0x23, 0xdc, 0x6b, 0x3f, 0x9e, 0x99, 0xb9, 0x93, 0x99, 0x09, 0xd0, 0x29, 0x25, 0x5f, 0xfe, 0x64,
-	0x82, 0x25, 0x41, 0xee, 0x69, 0x42, 0xc5, 0x79, 0x75, 0x85, 0x73, 0xb0, 0xc2, 0x79, 0xed, 0x3c,
-	0xce, 0xbf, 0xd1, 0x41, 0x7d, 0x77, 0x1a, 0xa1, 0x30, 0xf0, 0x6e, 0x61, 0xfc, 0xdf, 0x70, 0x7e,
-	0x17, 0xd4, 0x04, 0xe7, 0x3c, 0x88, 0x07, 0x1e, 0x8a, 0x5f, 0x80, 0x75, 0x21, 0x99, 0x7e, 0x10,
-	0xdf, 0x44, 0xf1, 0x22, 0xd7, 0x11, 0xc6, 0x32, 0x97, 0xfe, 0x42, 0xb9, 0x6e, 0x61, 0x2c, 0x72,
-	0x65, 0x12, 0x2a, 0x3e, 0x5b, 0x42, 0xa5, 0x75, 0x09, 0x95, 0x5f, 0x9e, 0x84, 0x2a, 0xe7, 0x48,
-	0xa8, 0xfa, 0xaf, 0x48, 0x08, 0xac, 0x48, 0xa8, 0xb6, 0x22, 0xa1, 0xfa, 0x79, 0x12, 0xb2, 0x40,
-	0x73, 0x6f, 0xc2, 0x71, 0xc4, 0x02, 0x1a, 0xbd, 0x1d, 0xcb, 0x37, 0x63, 0xf9, 0x14, 0x64, 0x17,
-	0xf2, 0x17, 0x1a, 0xb8, 0xb8, 0xf2, 0x44, 0xb8, 0x98, 0xc5, 0x34, 0x62, 0x72, 0xa3, 0xf2, 0x96,
-	0xd7, 0xd4, 0x25, 0x2e, 0x2f, 0xf6, 0x2b, 0x40, 0x1f, 0x51, 0xc2, 0x8c, 0xbc, 0xdc, 0xe4, 0xc5,
-	0xf5, 0x4d, 0xee, 0x53, 0xe2, 0xca, 0x10, 0x78, 0x01, 0x14, 0x12, 0xcc, 0xa5, 0x66, 0xea, 0xae,
-	0x18, 0xc2, 0x06, 0xa8, 0xa4, 0xe1, 0x00, 0x27, 0x09, 0x4d, 0xb2, 0x5b, 0xb7, 0x9c, 0x86, 0x7b,
-	0xc2, 0x14, 0x2e, 0x21, 0x8e, 0x31, 0xc3, 0xbe, 0x62, 0xd5, 0x2d, 0x13, 0xc4, 0xee, 0x33, 0xec,
-	0x67, 0x6d, 0x7e, 0xaa, 0x81, 0xff, 0x1f, 0x30, 0x72, 0x3f, 0xf6, 0x11, 0xc7, 0xf7, 0x50, 0x82,
-	0x42, 0x06, 0xaf, 0x83, 0x2a, 0x1a, 0xf3, 0x21, 0x4d, 0x02, 0x3e, 0xcd, 0x4e, 0x84, 0xf1, 0xdd,
-	0xa3, 0xee, 0x66, 0xf6, 0xda, 0xee, 0xf8, 0x7e, 0x82, 0x19, 0x7b, 0x87, 0x27, 0x41, 0x44, 0xdc,
-	0x65, 0x28, 0xbc, 0x0e, 0x4a, 0xb1, 0xcc, 0x20, 0xc5, 0x5e, 0xdb, 0x32, 0xd6, 0xb7, 0xa1, 0x2a,
-	0xf4, 0x74, 0x41, 0x93, 0x9b, 0x45, 0x6f, 0x6f, 0x3c, 0xfc, 0xf5, 0xab, 0xab, 0xcb, 0x3c, 0x56,
-	0x03, 0x5c, 0x7a, 0xaa, 0xa5, 0x05, 0x76, 0x5b, 0x73, 0x0d, 0x14, 0x0e, 0x18, 0x81, 0x1f, 0x02,
-	0x70, 0xe6, 0xf1, 0x35, 0xd7, 0x0b, 0xad, 0x40, 0xdf, 0x7c, 0xe3, 0x39, 0x01, 0x8b, 0xfc, 0xd6,
-	0x6b, 0x0f, 0xbf, 0xff, 0xe5, 0xb3, 0xbc, 0x69, 0x5d, 0x76, 0xd6, 0x7f, 0x26, 0xb2, 0xe8, 0x01,
-	0x9f, 0xc0, 0xf7, 0x40, 0x7d, 0x05, 0xb1, 0x57, 0xff, 0x34, 0xff, 0xd9, 0x90, 0xe6, 0x95, 0xe7,
-	0x86, 0x2c, 0x9a, 0xe8, 0xdd, 0x78, 0x3c, 0x6f, 0x69, 0x4f, 0xe6, 0x2d, 0xed, 0xe7, 0x79, 0x4b,
-	0xfb, 0xe4, 0xb8, 0x95, 0x7b, 0x72, 0xdc, 0xca, 0xfd, 0x70, 0xdc, 0xca, 0xbd, 0x7b, 0xf6, 0x70,
-	0xe1, 0x54, 0x9c, 0xad, 0x65, 0x9b, 0x13, 0xd9, 0xa8, 0x3c, 0x60, 0x87, 0x25, 0xf9, 0xdf, 0xf1,
-	0xe6, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xf3, 0xe0, 0xc6, 0x74, 0x09, 0x00, 0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xda, 0xeb, 0xaf, 0xb1, 0x7f, 0x69, 0x35, 0x4a, 0xd5, 0xb5, 0x7f, 0xd4, 0x6b, 0x56,
+	0x02, 0xdc, 0x4a, 0xd9, 0xa5, 0x01, 0xf5, 0x90, 0x53, 0xe3, 0x24, 0xad, 0x5a, 0xa5, 0xa2, 0x5a,
+	0xdc, 0x4b, 0x8b, 0x14, 0x4d, 0x76, 0x27, 0xeb, 0x15, 0xde, 0x9d, 0xd5, 0xce, 0x78, 0x65, 0x23,
+	0x71, 0xe9, 0x89, 0x1b, 0x20, 0xfe, 0x01, 0x0e, 0x9c, 0x38, 0x21, 0xd1, 0x33, 0xe2, 0x58, 0x71,
+	0xaa, 0xe0, 0x82, 0x40, 0x32, 0x28, 0x41, 0x42, 0xca, 0x0d, 0xfe, 0x02, 0x34, 0x1f, 0x8e, 0xed,
+	0x9a, 0x24, 0x6d, 0x29, 0xe2, 0xe4, 0x79, 0xf7, 0x7d, 0xe7, 0x79, 0x3f, 0x9e, 0xc7, 0x33, 0x03,
+	0xea, 0x98, 0xf5, 0x70, 0x1a, 0x85, 0x31, 0x73, 0x70, 0x16, 0x39, 0xd9, 0x55, 0x87, 0x0d, 0xed,
+	0x24, 0x25, 0x8c, 0xc0, 0xf3, 0xc7, 0x2e, 0x1b, 0x67, 0x91, 0x9d, 0x5d, 0x6d, 0x5c, 0xf4, 0x08,
+	0x8d, 0x08, 0x75, 0x22, 0x1a, 0xf0, 0xc8, 0x88, 0x06, 0x32, 0xb4, 0x51, 0x97, 0x8e, 0x5d, 0x61,
+	0x39, 0xd2, 0x50, 0xae, 0xc6, 0x42, 0x02, 0x0e, 0x26, 0x7d, 0x2b, 0x01, 0x09, 0x88, 0xdc, 0xc3,
+	0x57, 0xea, 0xeb, 0x2b, 0x01, 0x21, 0x41, 0x1f, 0x3b, 0x28, 0x09, 0x1d, 0x14, 0xc7, 0x84, 0x21,
+	0x16, 0x92, 0x78, 0x82, 0x57, 0x57, 0x5e, 0x61, 0xed, 0x0d, 0xf6, 0x1d, 0x14, 0x8f, 0xa4, 0xcb,
+	0xfa, 0x58, 0x03, 0xff, 0xbb, 0x43, 0x83, 0x6d, 0x9e, 0x10, 0x0f, 0xa2, 0xee, 0x10, 0xb6, 0x81,
+	0xee, 0x23, 0x86, 0x0c, 0xad, 0xa5, 0xb5, 0xab, 0x6b, 0x2b, 0xb6, 0xdc, 0x6b, 0x4f, 0xf6, 0xda,
+	0x1b, 0xf1, 0xc8, 0x15, 0x11, 0xb0, 0x0e, 0x74, 0x1a, 0x7e, 0x80, 0x8d, 0x5c, 0x4b, 0x6b, 0x6b,
+	0x9d, 0xc2, 0xd1, 0xd8, 0xd4, 0x56, 0x5d, 0xf1, 0x09, 0x9a, 0x40, 0xef, 0x21, 0xda, 0x33, 0xf2,
+	0x2d, 0xad, 0x5d, 0xe9, 0x54, 0xff, 0x1c, 0x9b, 0xa5, 0xb4, 0x9f, 0xac, 0x5b, 0xab, 0x96, 0x2b,
+	0x1c, 0x10, 0x02, 0x7d, 0x3f, 0x25, 0x91, 0xa1, 0xf3, 0x00, 0x57, 0xac, 0xd7, 0xf5, 0x8f, 0x3e,
+	0x37, 0x97, 0xac, 0xaf, 0x73, 0xa0, 0xbc, 0x83, 0x03, 0xe4, 0x8d, 0xba, 0x43, 0xb8, 0x02, 0x0a,
+	0x31, 0x89, 0x3d, 0x2c, 0xaa, 0xd1, 0x5d, 0x69, 0xc0, 0x9b, 0xa0, 0x12, 0x20, 0x3e, 0xb9, 0xd0,
+	0x93, 0xd9, 0x2b, 0x9d, 0x2b, 0x3f, 0x8d, 0xcd, 0xd7, 0x83, 0x90, 0xf5, 0x06, 0x7b, 0xb6, 0x47,
+	0x22, 0x35, 0x4f, 0xf5, 0xb3, 0x4a, 0xfd, 0xf7, 0x1d, 0x36, 0x4a, 0x30, 0xb5, 0x6f, 0xc5, 0xcc,
+	0x2d, 0x07, 0x88, 0xde, 0xe5, 0x7b, 0x61, 0x13, 0xe4, 0x03, 0x44, 0x45, 0x95, 0x7a, 0xa7, 0x76,
+	0x30, 0x36, 0xcb, 0x37, 0x11, 0xdd, 0x09, 0xa3, 0x90, 0xb9, 0xdc, 0x01, 0x97, 0x41, 0x8e, 0x11,
+	0x55, 0x63, 0x8e, 0x11, 0x78, 0x1b, 0x14, 0x32, 0xd4, 0x1f, 0x60, 0xa3, 0x20, 0x92, 0xbe, 0xfd,
+	0xec, 0x49, 0x0f, 0xc6, 0x66, 0x71, 0x23, 0x22, 0x83, 0x98, 0xb9, 0x12, 0x82, 0x4f, 0x40, 0xcc,
+	0xb9, 0xd8, 0xd2, 0xda, 0x35, 0x35, 0xd1, 0x1a, 0xd0, 0x32, 0xa3, 0x24, 0x3e, 0x68, 0x19, 0xb7,
+	0x52, 0xa3, 0x2c, 0xad, 0x94, 0x5b, 0xd4, 0xa8, 0x48, 0x8b, 0xae, 0x2f, 0xf3, 0x59, 0x7d, 0xf7,
+	0x68, 0xb5, 0xd8, 0x1d, 0x6e, 0x21, 0x86, 0xac, 0x3f, 0xf2, 0xa0, 0xb6, 0xe1, 0x79, 0x98, 0xd2,
+	0x9d, 0x90, 0xb2, 0xee, 0x10, 0x3e, 0x00, 0x65, 0xaf, 0x87, 0xc2, 0x78, 0x37, 0xf4, 0xc5, 0xf0,
+	0x2a, 0x9d, 0xeb, 0xcf, 0x55, 0x6d, 0x69, 0x93, 0xef, 0xbe, 0xb5, 0x75, 0x34, 0x36, 0x4b, 0x9e,
+	0x5c, 0xba, 0x6a, 0xe1, 0x4f, 0x69, 0xc9, 0x9d, 0x48, 0x4b, 0xfe, 0x9f, 0xd3, 0xa2, 0x9f, 0x4e,
+	0x4b, 0x61, 0x91, 0x96, 0xe2, 0xcb, 0xa3, 0xa5, 0x34, 0x43, 0xcb, 0x03, 0x50, 0x46, 0x62, 0xb6,
+	0x98, 0x1a, 0xe5, 0x56, 0xbe, 0x5d, 0x5d, 0xbb, 0x64, 0x3f, 0xfd, 0x47, 0xb7, 0xe5, 0xf4, 0xbb,
+	0x83, 0xa4, 0x8f, 0x3b, 0xad, 0xc7, 0x63, 0x73, 0xe9, 0x68, 0x6c, 0x02, 0x74, 0x4c, 0xc9, 0x97,
+	0xbf, 0x98, 0x60, 0x4a, 0x90, 0x7b, 0x0c, 0x28, 0x39, 0xaf, 0xcc, 0x71, 0x0e, 0xe6, 0x38, 0xaf,
+	0x9e, 0xc4, 0xf9, 0xb7, 0x3a, 0xa8, 0x6d, 0x8d, 0x62, 0x14, 0x85, 0xde, 0x0d, 0x8c, 0xff, 0x1b,
+	0xce, 0x6f, 0x83, 0x2a, 0xe7, 0x9c, 0x85, 0xc9, 0xae, 0x87, 0x92, 0x17, 0x60, 0x9d, 0x4b, 0xa6,
+	0x1b, 0x26, 0x9b, 0x28, 0x99, 0x60, 0xed, 0x63, 0x2c, 0xb0, 0xf4, 0x17, 0xc2, 0xba, 0x81, 0x31,
+	0xc7, 0x52, 0x12, 0x2a, 0x9c, 0x2e, 0xa1, 0xe2, 0xa2, 0x84, 0x4a, 0x2f, 0x4f, 0x42, 0xe5, 0x13,
+	0x24, 0x54, 0xf9, 0x57, 0x24, 0x04, 0xe6, 0x24, 0x54, 0x9d, 0x93, 0x50, 0xed, 0x24, 0x09, 0x59,
+	0xa0, 0xb1, 0x3d, 0x64, 0x38, 0xa6, 0x21, 0x89, 0xdf, 0x49, 0xc4, 0x9d, 0x31, 0xbd, 0x0a, 0xd4,
+	0x81, 0xfc, 0x85, 0x06, 0x2e, 0xcc, 0x5d, 0x11, 0x2e, 0xa6, 0x09, 0x89, 0xa9, 0x68, 0x54, 0x9c,
+	0xf2, 0x9a, 0x3c, 0xc4, 0xf9, 0x1a, 0x5e, 0x06, 0x7a, 0x9f, 0x04, 0xd4, 0xc8, 0x89, 0x26, 0x2f,
+	0x2c, 0x36, 0xb9, 0x43, 0x02, 0x57, 0x84, 0xc0, 0xf3, 0x20, 0x9f, 0x62, 0x26, 0x34, 0x53, 0x73,
+	0xf9, 0x12, 0xd6, 0x41, 0x39, 0x8b, 0x76, 0x71, 0x9a, 0x92, 0x54, 0x9d, 0xba, 0xa5, 0x2c, 0xda,
+	0xe6, 0x26, 0x77, 0x71, 0x71, 0x0c, 0x28, 0xf6, 0x25, 0xab, 0x6e, 0x29, 0x40, 0xf4, 0x1e, 0xc5,
+	0xbe, 0x2a, 0xf3, 0x67, 0x0d, 0x9c, 0x9b, 0x29, 0x73, 0x13, 0xf5, 0xfb, 0xf0, 0x4d, 0x50, 0xa4,
+	0x38, 0xf6, 0x71, 0xaa, 0xfe, 0x0e, 0xc6, 0xf7, 0x8f, 0x56, 0x57, 0xd4, 0x55, 0xbb, 0xe1, 0xfb,
+	0x29, 0xa6, 0xf4, 0x5d, 0x96, 0x86, 0x71, 0xe0, 0xaa, 0x38, 0xa5, 0x8b, 0xdc, 0xb1, 0x2e, 0x26,
+	0x5c, 0xe6, 0x67, 0xb8, 0xdc, 0x9a, 0x68, 0x45, 0x2a, 0xd4, 0xe6, 0x4c, 0x3d, 0x87, 0x4a, 0x95,
+	0x4a, 0xfe, 0x2f, 0x4f, 0xcb, 0x3e, 0xd7, 0xa4, 0xea, 0xa8, 0x1c, 0x28, 0x8d, 0xae, 0x9f, 0xe3,
+	0x2d, 0x3d, 0xfc, 0xfd, 0xab, 0x2b, 0xaa, 0x2e, 0xeb, 0x53, 0xd9, 0xdd, 0xbd, 0xc4, 0x47, 0x0c,
+	0xdf, 0x45, 0x29, 0x8a, 0x28, 0xbc, 0x06, 0x2a, 0x68, 0xc0, 0x7a, 0x24, 0x0d, 0xd9, 0xe8, 0xcc,
+	0x06, 0xa7, 0xa1, 0xf0, 0x1a, 0x28, 0x26, 0x02, 0x41, 0xf4, 0x59, 0x5d, 0x33, 0x16, 0x49, 0x92,
+	0x19, 0x3a, 0x3a, 0x6f, 0xcd, 0x55, 0xd1, 0xeb, 0xcb, 0xbc, 0xa0, 0x29, 0x8e, 0x55, 0x07, 0x17,
+	0x9f, 0x2a, 0x69, 0xa2, 0x8c, 0xb5, 0x6f, 0x72, 0x20, 0x7f, 0x87, 0x06, 0xf0, 0x43, 0x00, 0x66,
+	0x9e, 0x16, 0xe6, 0x62, 0xa2, 0x39, 0x61, 0x35, 0xde, 0x38, 0x23, 0x60, 0x82, 0x6f, 0xbd, 0xf6,
+	0xf0, 0x87, 0xdf, 0x3e, 0xcb, 0x99, 0xd6, 0x25, 0x67, 0xf1, 0xa9, 0xa4, 0xa2, 0x77, 0xd9, 0x10,
+	0xde, 0x07, 0xb5, 0x39, 0x3d, 0xbc, 0x7a, 0x2a, 0x3e, 0x0f, 0x79, 0xe6, 0x12, 0xe0, 0x7b, 0xa0,
+	0x36, 0xc7, 0xc6, 0xdf, 0x63, 0xcf, 0x86, 0x34, 0x2e, 0x9f, 0x19, 0x32, 0x41, 0xef, 0x5c, 0x7f,
+	0x7c, 0xd0, 0xd4, 0x9e, 0x1c, 0x34, 0xb5, 0x5f, 0x0f, 0x9a, 0xda, 0x27, 0x87, 0xcd, 0xa5, 0x27,
+	0x87, 0xcd, 0xa5, 0x1f, 0x0f, 0x9b, 0x4b, 0xf7, 0x67, 0x65, 0x86, 0x33, 0xae, 0xb2, 0xe9, 0x08,
+	0x86, 0x62, 0x08, 0x42, 0x6a, 0x7b, 0x45, 0xf1, 0x62, 0x7b, 0xeb, 0xaf, 0x01, 0x00, 0xb2, 0x8f,
+	0x6e, 0x18, 0xae, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -532,6 +588,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
 	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EthereumCall defines a method executing an EVM call or contract creation
+	// on behalf of a Cosmos signed account.
+	EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -554,6 +613,15 @@ func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
+	out := new(MsgEthereumTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/EthereumCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateParams", in, out, opts...)
@@ -567,6 +635,9 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
 	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
+	// EthereumCall defines a method executing an EVM call or contract creation
+	// on behalf of a Cosmos signed account.
+	EthereumCall(context.Context, *MsgEthereumCall) (*MsgEthereumTxResponse, error)
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -579,6 +650,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
 }
+func (*UnimplementedMsgServer) EthereumCall(ctx context.Context, req *MsgEthereumCall) (*MsgEthereumTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumCall not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -605,6 +679,24 @@ func _Msg_EthereumTx_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EthereumCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EthereumCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/EthereumCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EthereumCall(ctx, req.(*MsgEthereumCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -631,6 +723,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthereumTx",
 			Handler:    _Msg_EthereumTx_Handler,
 		},
+		{
+			MethodName: "EthereumCall",
+			Handler:    _Msg_EthereumCall_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1121,6 +1217,65 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEthereumCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1411,6 +1566,32 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEthereumCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3013,6 +3194,207 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEthereumCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,11 +16,14 @@
 package geth
 
 import (
+	"bytes"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 
 	evm "github.com/evmos/ethermint/x/evm/vm"
 )
@@ -38,17 +41,50 @@ type EVM struct {
 // NewEVM defines the constructor function for the go-ethereum (geth) EVM. It uses
 // the default precompiled contracts and the EVM concrete implementation from
 // geth.
+//
+// The custom precompiled contracts are registered on the EVM along with the default ones, so
+// that they are dispatched by the interpreter for the top level calls as well as the calls from
+// contracts. The stateful precompiled contracts are run with the EVM wrapper.
+//
+// A non-zero max code size replaces the EIP-170 limit on the runtime code of the contract
+// creations, see codeSizeInterpreter.
 func NewEVM(
 	blockCtx vm.BlockContext,
 	txCtx vm.TxContext,
 	stateDB vm.StateDB,
 	chainConfig *params.ChainConfig,
 	config vm.Config,
-	_ evm.PrecompiledContracts, // unused
+	customPrecompiles evm.PrecompiledContracts,
+	maxCodeSize uint64,
 ) evm.EVM {
-	return &EVM{
+	var codeDB *codeStateDB
+	if maxCodeSize > params.MaxCodeSize {
+		codeDB = &codeStateDB{StateDB: stateDB}
+		stateDB = codeDB
+	}
+
+	e := &EVM{
 		EVM: vm.NewEVM(blockCtx, txCtx, stateDB, chainConfig, config),
 	}
+	rules := chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil)
+	if maxCodeSize != 0 {
+		e.WithInterpreter(&codeSizeInterpreter{
+			Interpreter: e.EVM.Interpreter(),
+			evm:         e.EVM,
+			stateDB:     codeDB,
+			maxCodeSize: maxCodeSize,
+			isLondon:    rules.IsLondon,
+		})
+	}
+	if len(customPrecompiles) > 0 {
+		precompiles, active := e.mergePrecompiles(
+			vm.DefaultPrecompiles(rules),
+			vm.DefaultActivePrecompiles(rules),
+			customPrecompiles,
+		)
+		e.WithPrecompiles(precompiles, active)
+	}
+	return e
 }
 
 // Context returns the EVM's Block Context
@@ -66,29 +102,129 @@ func (e EVM) Config() vm.Config {
 	return e.EVM.Config
 }
 
-// Precompile returns the precompiled contract associated with the given address
-// and the current chain configuration. If the contract cannot be found it returns
-// nil.
-func (e EVM) Precompile(addr common.Address) (p vm.PrecompiledContract, found bool) {
-	precompiles := GetPrecompiles(e.ChainConfig(), e.EVM.Context.BlockNumber)
-	p, found = precompiles[addr]
-	return p, found
+// StateDB returns the state database of the EVM.
+func (e EVM) StateDB() vm.StateDB {
+	if db, ok := e.EVM.StateDB.(*codeStateDB); ok {
+		return db.StateDB
+	}
+	return e.EVM.StateDB
 }
 
-// ActivePrecompiles returns a list of all the active precompiled contract addresses
-// for the current chain configuration.
-func (EVM) ActivePrecompiles(rules params.Rules) []common.Address {
-	return vm.ActivePrecompiles(rules)
+// Reset resets the EVM with a new transaction context and state database.
+func (e *EVM) Reset(txCtx vm.TxContext, stateDB vm.StateDB) {
+	if db, ok := e.EVM.StateDB.(*codeStateDB); ok {
+		db.StateDB = stateDB
+		stateDB = db
+	}
+	e.EVM.Reset(txCtx, stateDB)
 }
 
-// RunPrecompiledContract runs a stateless precompiled contract and ignores the address and
-// value arguments. It uses the RunPrecompiledContract function from the geth vm package
-func (EVM) RunPrecompiledContract(
+// Create creates a new contract using code as deployment code. The runtime code stored by the
+// codeStateDB is returned empty by the geth EVM, so it's read back from the state.
+func (e *EVM) Create(
+	caller vm.ContractRef,
+	code []byte,
+	gas uint64,
+	value *big.Int,
+) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	ret, contractAddr, leftOverGas, err = e.EVM.Create(caller, code, gas, value)
+	return e.createdCode(ret, contractAddr, err), contractAddr, leftOverGas, err
+}
+
+// Create2 creates a new contract using code as deployment code, at an address derived from the
+// salt. See Create.
+func (e *EVM) Create2(
+	caller vm.ContractRef,
+	code []byte,
+	gas uint64,
+	endowment *big.Int,
+	salt *uint256.Int,
+) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	ret, contractAddr, leftOverGas, err = e.EVM.Create2(caller, code, gas, endowment, salt)
+	return e.createdCode(ret, contractAddr, err), contractAddr, leftOverGas, err
+}
+
+// createdCode returns the runtime code of a successful contract creation.
+func (e *EVM) createdCode(ret []byte, contractAddr common.Address, err error) []byte {
+	if _, ok := e.EVM.StateDB.(*codeStateDB); !ok || err != nil || len(ret) != 0 {
+		return ret
+	}
+	return e.EVM.StateDB.GetCode(contractAddr)
+}
+
+// Precompile returns the precompiled contract associated with the given address. The stateful
+// precompiled contracts are returned as registered, rather than wrapped for the interpreter.
+func (e EVM) Precompile(addr common.Address) (vm.PrecompiledContract, bool) {
+	p, found := e.EVM.Precompile(addr)
+	if stateful, ok := p.(*statefulPrecompile); ok {
+		return stateful.StatefulPrecompiledContract, true
+	}
+	return p, found
+}
+
+// RunPrecompiledContract runs a stateful precompiled contract. The required gas is
+// consumed before the execution, as for the geth precompiled contracts.
+func (e *EVM) RunPrecompiledContract(
 	p evm.StatefulPrecompiledContract,
-	_ common.Address, // address arg is unused
+	caller common.Address,
+	addr common.Address,
 	input []byte,
 	suppliedGas uint64,
-	_ *big.Int, // 	value arg is unused
+	value *big.Int,
 ) (ret []byte, remainingGas uint64, err error) {
-	return vm.RunPrecompiledContract(p, input, suppliedGas)
+	gasCost := p.RequiredGas(input)
+	if suppliedGas < gasCost {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	suppliedGas -= gasCost
+	output, err := p.RunStateful(e, caller, addr, input, value)
+	return output, suppliedGas, err
+}
+
+// mergePrecompiles returns the default precompiled contracts along with the custom ones, and the
+// active precompile addresses, i.e. the default ones followed by the sorted custom addresses.
+// The default precompiles map is shared by all the EVMs, so it's copied.
+func (e *EVM) mergePrecompiles(
+	defaults map[common.Address]vm.PrecompiledContract,
+	defaultActive []common.Address,
+	custom evm.PrecompiledContracts,
+) (map[common.Address]vm.PrecompiledContract, []common.Address) {
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(defaults)+len(custom))
+	for addr, p := range defaults {
+		precompiles[addr] = p
+	}
+
+	customActive := make([]common.Address, 0, len(custom))
+	for addr, p := range custom {
+		if stateful, ok := p.(evm.StatefulPrecompiledContract); ok {
+			p = &statefulPrecompile{StatefulPrecompiledContract: stateful, evm: e, addr: addr}
+		}
+		precompiles[addr] = p
+		customActive = append(customActive, addr)
+	}
+	sort.Slice(customActive, func(i, j int) bool {
+		return bytes.Compare(customActive[i].Bytes(), customActive[j].Bytes()) < 0
+	})
+
+	active := make([]common.Address, 0, len(defaultActive)+len(customActive))
+	active = append(active, defaultActive...)
+	return precompiles, append(active, customActive...)
+}
+
+// statefulPrecompile registers a stateful precompiled contract on the geth interpreter. The
+// interpreter consumes the required gas and transfers the value of the call before running it.
+type statefulPrecompile struct {
+	evm.StatefulPrecompiledContract
+	evm  *EVM
+	addr common.Address
+}
+
+// Address returns the address the precompiled contract is registered at.
+func (p *statefulPrecompile) Address() common.Address {
+	return p.addr
+}
+
+// Run runs the stateful precompiled contract with the EVM wrapper.
+func (p *statefulPrecompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	return p.RunStateful(p.evm, contract.Caller(), p.addr, contract.Input, contract.Value())
 }
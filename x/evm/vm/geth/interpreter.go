@@ -0,0 +1,97 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package geth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// codeSizeInterpreter wraps the geth interpreter to enforce the max code size parameter on the
+// runtime code returned by every contract creation, i.e. the creation transactions as well as
+// the CREATE and CREATE2 from contracts.
+//
+// The geth EVM rejects the runtime code above the EIP-170 limit after the interpreter returns, so
+// the larger code allowed by the parameter is charged and validated here, as the EVM would do it,
+// and returned empty to the EVM. The code is then stored by the codeStateDB when the EVM sets the
+// empty code of the created contract.
+type codeSizeInterpreter struct {
+	vm.Interpreter
+	evm         *vm.EVM
+	stateDB     *codeStateDB
+	maxCodeSize uint64
+	isLondon    bool
+}
+
+// Run runs the contract code and validates the size of the runtime code returned by the contract
+// creations.
+func (in *codeSizeInterpreter) Run(contract *vm.Contract, input []byte, readOnly bool) ([]byte, error) {
+	ret, err := in.Interpreter.Run(contract, input, readOnly)
+	if err != nil || !in.isCreation(contract) {
+		return ret, err
+	}
+
+	size := uint64(len(ret))
+	if size > in.maxCodeSize {
+		return nil, vm.ErrMaxCodeSizeExceeded
+	}
+	if size <= params.MaxCodeSize {
+		return ret, nil
+	}
+
+	// EIP-3541
+	if in.isLondon && ret[0] == 0xEF {
+		return nil, vm.ErrInvalidCode
+	}
+	if !contract.UseGas(size * params.CreateDataGas) {
+		return nil, vm.ErrCodeStoreOutOfGas
+	}
+	in.stateDB.pending = &pendingCode{addr: contract.Address(), code: ret}
+	return nil, nil
+}
+
+// isCreation returns true if the contract runs the initcode of a contract creation: the code runs
+// at its own address, which has no code yet. The calls to a contract run its stored code, and
+// the calls to an account without code are not run by the interpreter.
+func (in *codeSizeInterpreter) isCreation(contract *vm.Contract) bool {
+	return contract.CodeAddr != nil &&
+		*contract.CodeAddr == contract.Address() &&
+		in.evm.StateDB.GetCodeSize(contract.Address()) == 0
+}
+
+// pendingCode is the runtime code of a contract creation, validated by the codeSizeInterpreter.
+type pendingCode struct {
+	addr common.Address
+	code []byte
+}
+
+// codeStateDB wraps the state database of the EVM to store the runtime code validated by the
+// codeSizeInterpreter, in place of the empty code set by the EVM right after the interpreter
+// returns.
+type codeStateDB struct {
+	vm.StateDB
+	pending *pendingCode
+}
+
+// SetCode sets the code of the account, or the pending runtime code of the created contract.
+func (db *codeStateDB) SetCode(addr common.Address, code []byte) {
+	if db.pending != nil && db.pending.addr == addr && len(code) == 0 {
+		code = db.pending.code
+	}
+	db.pending = nil
+	db.StateDB.SetCode(addr, code)
+}
@@ -27,9 +27,12 @@ import (
 // PrecompiledContracts defines a map of address -> precompiled contract
 type PrecompiledContracts map[common.Address]vm.PrecompiledContract
 
+// StatefulPrecompiledContract defines a precompiled contract which can access the EVM state and
+// the EVM context, e.g. to read the state of other modules. The caller is the address of the
+// account calling the contract, i.e. the transaction sender for the top level calls.
 type StatefulPrecompiledContract interface {
 	vm.PrecompiledContract
-	RunStateful(evm EVM, addr common.Address, input []byte, value *big.Int) (ret []byte, err error)
+	RunStateful(evm EVM, caller, addr common.Address, input []byte, value *big.Int) (ret []byte, err error)
 }
 
 // EVM defines the interface for the Ethereum Virtual Machine used by the EVM module.
@@ -37,11 +40,12 @@ type EVM interface {
 	Config() vm.Config
 	Context() vm.BlockContext
 	TxContext() vm.TxContext
+	StateDB() vm.StateDB
 
 	Reset(txCtx vm.TxContext, statedb vm.StateDB)
 	Cancel()
 	Cancelled() bool //nolint
-	Interpreter() *vm.EVMInterpreter
+	Interpreter() vm.Interpreter
 	Call(caller vm.ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error)
 	CallCode(caller vm.ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error)
 	DelegateCall(caller vm.ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error)
@@ -61,6 +65,7 @@ type EVM interface {
 	Precompile(addr common.Address) (vm.PrecompiledContract, bool)
 	RunPrecompiledContract(
 		p StatefulPrecompiledContract,
+		caller common.Address,
 		addr common.Address,
 		input []byte,
 		suppliedGas uint64,
@@ -70,7 +75,8 @@ type EVM interface {
 }
 
 // Constructor defines the function used to instantiate the EVM on
-// each state transition.
+// each state transition. A non-zero max code size replaces the EIP-170
+// limit on the runtime code of the contract creations.
 type Constructor func(
 	blockCtx vm.BlockContext,
 	txCtx vm.TxContext,
@@ -78,4 +84,5 @@ type Constructor func(
 	chainConfig *params.ChainConfig,
 	config vm.Config,
 	customPrecompiles PrecompiledContracts,
+	maxCodeSize uint64,
 ) EVM